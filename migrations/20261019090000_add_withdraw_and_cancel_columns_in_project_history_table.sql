@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE project_history ADD withdraw_reason VARCHAR(512);
+ALTER TABLE project_history ADD withdrawn_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE project_history ADD cancel_reason VARCHAR(512);
+ALTER TABLE project_history ADD cancelled_at TIMESTAMP WITH TIME ZONE;
+
+-- +goose Down
+ALTER TABLE project_history DROP COLUMN withdraw_reason;
+ALTER TABLE project_history DROP COLUMN withdrawn_at;
+ALTER TABLE project_history DROP COLUMN cancel_reason;
+ALTER TABLE project_history DROP COLUMN cancelled_at;
\ No newline at end of file
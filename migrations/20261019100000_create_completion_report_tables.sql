@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE completion_report(
+    id SERIAL PRIMARY KEY NOT NULL,
+    project_code VARCHAR(255) NOT NULL,
+    project_history_id INT REFERENCES project_history (id) NOT NULL,
+    user_id INT REFERENCES users (id) NOT NULL,
+    status VARCHAR(64) NOT NULL,
+    incidents TEXT NOT NULL,
+    total_expense BIGINT NOT NULL,
+    fund_used_amount BIGINT NOT NULL,
+    financial_summary TEXT NOT NULL,
+    files_prefix VARCHAR(255) NOT NULL,
+    admin_comment VARCHAR(512),
+    reviewed_by INT REFERENCES users (id),
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL
+);
+
+CREATE TABLE completion_report_participant(
+    id SERIAL PRIMARY KEY NOT NULL,
+    completion_report_id INT REFERENCES completion_report (id) NOT NULL,
+    distance_type VARCHAR(255) NOT NULL,
+    participant_count INT NOT NULL
+);
+
+CREATE INDEX completion_report_project_code ON completion_report (project_code);
+-- a project has at most one report that is waiting for review or accepted, concurrent submissions conflict here
+CREATE UNIQUE INDEX completion_report_open_project_code ON completion_report (project_code) WHERE status IN ('Submitted', 'Accepted');
+CREATE INDEX completion_report_participant_completion_report_id ON completion_report_participant (completion_report_id);
+
+-- +goose Down
+ALTER TABLE completion_report_participant DROP COLUMN completion_report_id;
+DROP TABLE completion_report_participant;
+DROP TABLE completion_report;
\ No newline at end of file
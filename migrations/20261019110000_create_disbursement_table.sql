@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE disbursement(
+    id SERIAL PRIMARY KEY NOT NULL,
+    project_code VARCHAR(255) NOT NULL,
+    entry_type VARCHAR(64) NOT NULL,
+    status VARCHAR(64) NOT NULL,
+    amount BIGINT NOT NULL,
+    installment_no SMALLINT,
+    scheduled_date TIMESTAMP WITH TIME ZONE,
+    paid_at TIMESTAMP WITH TIME ZONE,
+    bank_transfer_ref VARCHAR(255),
+    note VARCHAR(512),
+    files_prefix VARCHAR(255) NOT NULL,
+    created_by INT REFERENCES users (id) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL
+);
+
+CREATE INDEX disbursement_project_code ON disbursement (project_code);
+
+-- +goose Down
+DROP TABLE disbursement;
\ No newline at end of file
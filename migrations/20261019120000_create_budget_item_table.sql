@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE budget_item(
+  id SERIAL PRIMARY KEY NOT NULL,
+  project_history_id INT REFERENCES project_history (id) NOT NULL,
+  order_number SMALLINT NOT NULL,
+  category VARCHAR(64) NOT NULL,
+  description VARCHAR(512) NOT NULL,
+  quantity INT NOT NULL,
+  unit_cost INT NOT NULL,
+  funding_source VARCHAR(64) NOT NULL
+);
+CREATE INDEX budget_item_project_history_id ON budget_item (project_history_id);
+-- +goose Down
+ALTER TABLE budget_item DROP COLUMN project_history_id;
+DROP TABLE budget_item;
@@ -0,0 +1,7 @@
+-- +goose Up
+CREATE TABLE project_code_counter(
+  code_date DATE PRIMARY KEY NOT NULL,
+  last_value INT NOT NULL
+);
+-- +goose Down
+DROP TABLE project_code_counter;
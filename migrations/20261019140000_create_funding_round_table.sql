@@ -0,0 +1,40 @@
+-- +goose Up
+CREATE TABLE funding_round(
+  id SERIAL PRIMARY KEY NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  submission_open_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  submission_close_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  review_from_date TIMESTAMP WITH TIME ZONE NOT NULL,
+  review_to_date TIMESTAMP WITH TIME ZONE NOT NULL,
+  total_budget BIGINT NOT NULL,
+  applicant_criteria_version INT NOT NULL,
+  reviewer_criteria_version INT NOT NULL,
+  reviewer_threshold INT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+-- existing projects belong to an initial round that stays open until the first round an admin adds closes it
+INSERT INTO funding_round
+(name, submission_open_at, submission_close_at, review_from_date, review_to_date, total_budget,
+applicant_criteria_version, reviewer_criteria_version, reviewer_threshold)
+SELECT
+'รอบเริ่มต้น',
+COALESCE((SELECT MIN(created_at) FROM project), now()),
+'9999-12-31 00:00:00+07',
+COALESCE((SELECT from_date FROM review_period ORDER BY id DESC LIMIT 1), now()),
+'9999-12-31 00:00:00+07',
+GREATEST(COALESCE((SELECT SUM(fund_approved_amount) FROM project_history
+  INNER JOIN project ON project.project_history_id = project_history.id), 0), 1),
+1,
+1,
+4;
+
+ALTER TABLE project ADD COLUMN funding_round_id INT REFERENCES funding_round (id);
+UPDATE project SET funding_round_id = (SELECT id FROM funding_round ORDER BY id ASC LIMIT 1);
+ALTER TABLE project ALTER COLUMN funding_round_id SET NOT NULL;
+CREATE INDEX project_funding_round_id ON project (funding_round_id);
+
+-- +goose Down
+ALTER TABLE project DROP COLUMN funding_round_id;
+DROP TABLE funding_round;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE eligibility_rule(
+  id SERIAL PRIMARY KEY NOT NULL,
+  rule_type VARCHAR(64) NOT NULL,
+  params JSONB NOT NULL DEFAULT '{}',
+  message VARCHAR(512),
+  funding_round_id INT REFERENCES funding_round (id),
+  enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+CREATE INDEX project_history_organization_name ON project_history (LOWER(TRIM(organization_name)));
+-- +goose Down
+DROP INDEX project_history_organization_name;
+DROP TABLE eligibility_rule;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE project_duplicate(
+  id SERIAL PRIMARY KEY NOT NULL,
+  project_code VARCHAR(255) NOT NULL,
+  duplicate_of_project_code VARCHAR(255) NOT NULL,
+  score NUMERIC(4, 3) NOT NULL,
+  reasons VARCHAR(255) NOT NULL,
+  status VARCHAR(64) NOT NULL, -- Suspected, Linked, ClosedAsDuplicate, Merged, Dismissed
+  resolved_by INT REFERENCES users (id),
+  resolved_at TIMESTAMP WITH TIME ZONE,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  UNIQUE (project_code, duplicate_of_project_code)
+);
+CREATE INDEX project_duplicate_duplicate_of_project_code ON project_duplicate (duplicate_of_project_code);
+CREATE INDEX project_history_from_date ON project_history (from_date);
+-- +goose Down
+DROP INDEX project_history_from_date;
+DROP TABLE project_duplicate;
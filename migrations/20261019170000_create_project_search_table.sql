@@ -0,0 +1,91 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Thai is written without spaces, so Thai runs are indexed as overlapping character bigrams
+-- and other text as lower-cased alphanumeric words.
+-- +goose StatementBegin
+CREATE FUNCTION search_tokens(input TEXT) RETURNS TEXT AS $$
+DECLARE
+  word TEXT;
+  tokens TEXT[] := '{}';
+BEGIN
+  FOR word IN SELECT m[1] FROM regexp_matches(lower(coalesce(input, '')), '([\u0E00-\u0E7F]+|[a-z0-9]+)', 'g') AS m LOOP
+    IF word ~ '^[\u0E00-\u0E7F]{2,}$' THEN
+      FOR i IN 1..char_length(word) - 1 LOOP
+        tokens := tokens || substr(word, i, 2);
+      END LOOP;
+    ELSE
+      tokens := tokens || word;
+    END IF;
+  END LOOP;
+  RETURN array_to_string(tokens, ' ');
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+-- +goose StatementEnd
+
+-- every word of the query must appear, and the bigrams of a Thai word must be adjacent
+-- +goose StatementBegin
+CREATE FUNCTION search_query(input TEXT) RETURNS tsquery AS $$
+DECLARE
+  word TEXT;
+  q tsquery;
+BEGIN
+  FOR word IN SELECT m[1] FROM regexp_matches(lower(coalesce(input, '')), '([\u0E00-\u0E7F]+|[a-z0-9]+)', 'g') AS m LOOP
+    IF q IS NULL THEN
+      q := phraseto_tsquery('simple', search_tokens(word));
+    ELSE
+      q := q && phraseto_tsquery('simple', search_tokens(word));
+    END IF;
+  END LOOP;
+  RETURN coalesce(q, ''::tsquery);
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+-- +goose StatementEnd
+
+CREATE TABLE project_search(
+  project_code VARCHAR(255) PRIMARY KEY NOT NULL,
+  search_text TEXT NOT NULL,
+  document TSVECTOR NOT NULL,
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+CREATE INDEX project_search_document ON project_search USING GIN (document);
+CREATE INDEX project_search_search_text ON project_search USING GIN (search_text gin_trgm_ops);
+
+-- weights rank name and code first, then organisation, contacts and free text
+-- +goose StatementBegin
+CREATE FUNCTION refresh_project_search(code VARCHAR) RETURNS VOID AS $$
+  INSERT INTO project_search (project_code, search_text, document, updated_at)
+  SELECT
+    project.project_code,
+    lower(concat_ws(' ',
+      project.project_code, project_history.project_name, project_history.organization_name, project_history.organizer_name,
+      head.first_name, head.last_name, manager.first_name, manager.last_name,
+      coordinator.first_name, coordinator.last_name, race_director.first_name, race_director.last_name
+    )),
+    setweight(to_tsvector('simple', search_tokens(concat_ws(' ', project.project_code, project_history.project_name))), 'A') ||
+    setweight(to_tsvector('simple', search_tokens(concat_ws(' ', project_history.organization_name, project_history.organizer_name))), 'B') ||
+    setweight(to_tsvector('simple', search_tokens(concat_ws(' ',
+      head.first_name, head.last_name, manager.first_name, manager.last_name,
+      coordinator.first_name, coordinator.last_name, race_director.first_name, race_director.last_name
+    ))), 'C') ||
+    setweight(to_tsvector('simple', search_tokens(concat_ws(' ', project_history.background, project_history.objective))), 'D'),
+    now()
+  FROM project
+  INNER JOIN project_history ON project.project_history_id = project_history.id
+  LEFT JOIN contact head ON project_history.project_head_contact_id = head.id
+  LEFT JOIN contact manager ON project_history.project_manager_contact_id = manager.id
+  LEFT JOIN contact coordinator ON project_history.project_coordinator_contact_id = coordinator.id
+  LEFT JOIN contact race_director ON project_history.project_race_director_contact_id = race_director.id
+  WHERE project.project_code = code
+  ON CONFLICT (project_code) DO UPDATE
+  SET search_text = EXCLUDED.search_text, document = EXCLUDED.document, updated_at = EXCLUDED.updated_at;
+$$ LANGUAGE SQL;
+-- +goose StatementEnd
+
+SELECT refresh_project_search(project_code) FROM project;
+
+-- +goose Down
+DROP FUNCTION refresh_project_search(VARCHAR);
+DROP TABLE project_search;
+DROP FUNCTION search_query(TEXT);
+DROP FUNCTION search_tokens(TEXT);
@@ -0,0 +1,26 @@
+-- +goose Up
+ALTER TABLE project_history ADD COLUMN avg_review_score NUMERIC(6, 2);
+ALTER TABLE project_history ADD COLUMN review_count INT NOT NULL DEFAULT 0;
+
+UPDATE project_history
+SET avg_review_score = scores.avg_score, review_count = scores.review_count
+FROM (
+  SELECT project_history_id, ROUND(AVG(sum_score), 2) as avg_score, COUNT(*) as review_count
+  FROM (
+    SELECT review.project_history_id, review.id, SUM(review_details.score) as sum_score
+    FROM review
+    INNER JOIN review_details ON review.id = review_details.review_id
+    GROUP BY review.project_history_id, review.id
+  ) per_review
+  GROUP BY project_history_id
+) scores
+WHERE project_history.id = scores.project_history_id;
+
+CREATE INDEX project_created_at_id ON project (created_at, id);
+CREATE INDEX project_history_status ON project_history (status);
+
+-- +goose Down
+DROP INDEX project_history_status;
+DROP INDEX project_created_at_id;
+ALTER TABLE project_history DROP COLUMN review_count;
+ALTER TABLE project_history DROP COLUMN avg_review_score;
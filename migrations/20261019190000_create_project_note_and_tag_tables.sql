@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE project_note(
+  id SERIAL PRIMARY KEY NOT NULL,
+  project_code VARCHAR(255) NOT NULL REFERENCES project (project_code),
+  parent_id INT REFERENCES project_note (id),
+  body TEXT NOT NULL,
+  created_by INT NOT NULL REFERENCES users (id),
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+CREATE INDEX project_note_project_code ON project_note (project_code, created_at);
+
+CREATE TABLE project_tag(
+  project_code VARCHAR(255) NOT NULL REFERENCES project (project_code),
+  tag VARCHAR(64) NOT NULL,
+  created_by INT NOT NULL REFERENCES users (id),
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  PRIMARY KEY (project_code, tag)
+);
+CREATE INDEX project_tag_tag ON project_tag (tag);
+-- +goose Down
+DROP TABLE project_tag;
+DROP TABLE project_note;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE project_message(
+  id SERIAL PRIMARY KEY NOT NULL,
+  project_code VARCHAR(255) NOT NULL REFERENCES project (project_code),
+  sender_id INT NOT NULL REFERENCES users (id),
+  sender_role VARCHAR(64) NOT NULL,
+  body TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  read_by INT REFERENCES users (id),
+  read_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX project_message_project_code ON project_message (project_code, created_at);
+
+CREATE TABLE project_message_attachment(
+  id SERIAL PRIMARY KEY NOT NULL,
+  message_id INT NOT NULL REFERENCES project_message (id),
+  file_name VARCHAR(255) NOT NULL,
+  path VARCHAR(1024) NOT NULL
+);
+CREATE INDEX project_message_attachment_message_id ON project_message_attachment (message_id);
+-- +goose Down
+DROP TABLE project_message_attachment;
+DROP TABLE project_message;
@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE project_history ADD COLUMN revise_due_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE project_history ADD COLUMN revise_overdue_at TIMESTAMP WITH TIME ZONE;
+CREATE INDEX project_history_revise_due_at ON project_history (revise_due_at) WHERE status = 'Revise';
+
+CREATE TABLE project_revise_reminder(
+  project_history_id INT NOT NULL REFERENCES project_history (id),
+  due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  days_before INT NOT NULL,
+  sent_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  PRIMARY KEY (project_history_id, due_at, days_before)
+);
+
+-- NULL overdue status only flags the project, otherwise overdue projects are moved to that status
+ALTER TABLE operation_config ADD COLUMN revise_reminder_days INT[] NOT NULL DEFAULT '{7,1}';
+ALTER TABLE operation_config ADD COLUMN revise_overdue_status VARCHAR(64);
+-- +goose Down
+ALTER TABLE operation_config DROP COLUMN revise_overdue_status;
+ALTER TABLE operation_config DROP COLUMN revise_reminder_days;
+DROP TABLE project_revise_reminder;
+DROP INDEX project_history_revise_due_at;
+ALTER TABLE project_history DROP COLUMN revise_overdue_at;
+ALTER TABLE project_history DROP COLUMN revise_due_at;
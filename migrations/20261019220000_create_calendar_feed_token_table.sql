@@ -0,0 +1,8 @@
+-- +goose Up
+CREATE TABLE calendar_feed_token(
+  user_id INT PRIMARY KEY NOT NULL REFERENCES users (id),
+  token CHAR(64) UNIQUE NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+-- +goose Down
+DROP TABLE calendar_feed_token;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE project_history ADD organizer_public_consent BOOLEAN NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE project_history DROP COLUMN organizer_public_consent;
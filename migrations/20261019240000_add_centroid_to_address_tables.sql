@@ -0,0 +1,97 @@
+-- +goose Up
+ALTER TABLE province ADD latitude DOUBLE PRECISION;
+ALTER TABLE province ADD longitude DOUBLE PRECISION;
+ALTER TABLE district ADD latitude DOUBLE PRECISION;
+ALTER TABLE district ADD longitude DOUBLE PRECISION;
+ALTER TABLE subdistrict ADD latitude DOUBLE PRECISION;
+ALTER TABLE subdistrict ADD longitude DOUBLE PRECISION;
+-- district and subdistrict centroids are left empty here, the district map uses the average of a district's subdistricts
+-- and places districts without any centroid on their province, flagged approximate
+
+-- PROVINCE CENTROID
+-- the provincial seat is used as the centroid so markers land on the town rather than in a forest or the sea
+UPDATE province SET latitude = 8.0863, longitude = 98.9063 WHERE name = 'กระบี่';
+UPDATE province SET latitude = 13.7563, longitude = 100.5018 WHERE name = 'กรุงเทพมหานคร';
+UPDATE province SET latitude = 14.0228, longitude = 99.5328 WHERE name = 'กาญจนบุรี';
+UPDATE province SET latitude = 16.4322, longitude = 103.5061 WHERE name = 'กาฬสินธุ์';
+UPDATE province SET latitude = 16.4828, longitude = 99.5227 WHERE name = 'กำแพงเพชร';
+UPDATE province SET latitude = 16.4419, longitude = 102.8360 WHERE name = 'ขอนแก่น';
+UPDATE province SET latitude = 12.6114, longitude = 102.1039 WHERE name = 'จันทบุรี';
+UPDATE province SET latitude = 13.6904, longitude = 101.0780 WHERE name = 'ฉะเชิงเทรา';
+UPDATE province SET latitude = 13.3611, longitude = 100.9847 WHERE name = 'ชลบุรี';
+UPDATE province SET latitude = 15.1851, longitude = 100.1251 WHERE name = 'ชัยนาท';
+UPDATE province SET latitude = 15.8068, longitude = 102.0316 WHERE name = 'ชัยภูมิ';
+UPDATE province SET latitude = 10.4930, longitude = 99.1800 WHERE name = 'ชุมพร';
+UPDATE province SET latitude = 7.5563, longitude = 99.6114 WHERE name = 'ตรัง';
+UPDATE province SET latitude = 12.2428, longitude = 102.5175 WHERE name = 'ตราด';
+UPDATE province SET latitude = 16.8840, longitude = 99.1259 WHERE name = 'ตาก';
+UPDATE province SET latitude = 14.2069, longitude = 101.2131 WHERE name = 'นครนายก';
+UPDATE province SET latitude = 13.8199, longitude = 100.0621 WHERE name = 'นครปฐม';
+UPDATE province SET latitude = 17.3920, longitude = 104.7695 WHERE name = 'นครพนม';
+UPDATE province SET latitude = 14.9799, longitude = 102.0978 WHERE name = 'นครราชสีมา';
+UPDATE province SET latitude = 8.4304, longitude = 99.9631 WHERE name = 'นครศรีธรรมราช';
+UPDATE province SET latitude = 15.7047, longitude = 100.1372 WHERE name = 'นครสวรรค์';
+UPDATE province SET latitude = 13.8591, longitude = 100.5217 WHERE name = 'นนทบุรี';
+UPDATE province SET latitude = 6.4255, longitude = 101.8253 WHERE name = 'นราธิวาส';
+UPDATE province SET latitude = 18.7756, longitude = 100.7730 WHERE name = 'น่าน';
+UPDATE province SET latitude = 18.3609, longitude = 103.6464 WHERE name = 'บึงกาฬ';
+UPDATE province SET latitude = 14.9930, longitude = 103.1029 WHERE name = 'บุรีรัมย์';
+UPDATE province SET latitude = 14.0208, longitude = 100.5250 WHERE name = 'ปทุมธานี';
+UPDATE province SET latitude = 11.8126, longitude = 99.7957 WHERE name = 'ประจวบคีรีขันธ์';
+UPDATE province SET latitude = 14.0509, longitude = 101.3717 WHERE name = 'ปราจีนบุรี';
+UPDATE province SET latitude = 6.8692, longitude = 101.2502 WHERE name = 'ปัตตานี';
+UPDATE province SET latitude = 14.3532, longitude = 100.5689 WHERE name = 'พระนครศรีอยุธยา';
+UPDATE province SET latitude = 19.1666, longitude = 99.9019 WHERE name = 'พะเยา';
+UPDATE province SET latitude = 8.4509, longitude = 98.5256 WHERE name = 'พังงา';
+UPDATE province SET latitude = 7.6167, longitude = 100.0740 WHERE name = 'พัทลุง';
+UPDATE province SET latitude = 16.4419, longitude = 100.3488 WHERE name = 'พิจิตร';
+UPDATE province SET latitude = 16.8211, longitude = 100.2659 WHERE name = 'พิษณุโลก';
+UPDATE province SET latitude = 7.8804, longitude = 98.3923 WHERE name = 'ภูเก็ต';
+UPDATE province SET latitude = 16.1851, longitude = 103.3007 WHERE name = 'มหาสารคาม';
+UPDATE province SET latitude = 16.5453, longitude = 104.7235 WHERE name = 'มุกดาหาร';
+UPDATE province SET latitude = 6.5411, longitude = 101.2804 WHERE name = 'ยะลา';
+UPDATE province SET latitude = 15.7944, longitude = 104.1451 WHERE name = 'ยโสธร';
+UPDATE province SET latitude = 9.9658, longitude = 98.6348 WHERE name = 'ระนอง';
+UPDATE province SET latitude = 12.6814, longitude = 101.2816 WHERE name = 'ระยอง';
+UPDATE province SET latitude = 13.5283, longitude = 99.8134 WHERE name = 'ราชบุรี';
+UPDATE province SET latitude = 16.0538, longitude = 103.6520 WHERE name = 'ร้อยเอ็ด';
+UPDATE province SET latitude = 14.7995, longitude = 100.6534 WHERE name = 'ลพบุรี';
+UPDATE province SET latitude = 18.2888, longitude = 99.4909 WHERE name = 'ลำปาง';
+UPDATE province SET latitude = 18.5745, longitude = 99.0087 WHERE name = 'ลำพูน';
+UPDATE province SET latitude = 15.1186, longitude = 104.3220 WHERE name = 'ศรีสะเกษ';
+UPDATE province SET latitude = 17.1545, longitude = 104.1348 WHERE name = 'สกลนคร';
+UPDATE province SET latitude = 7.1898, longitude = 100.5954 WHERE name = 'สงขลา';
+UPDATE province SET latitude = 6.6238, longitude = 100.0674 WHERE name = 'สตูล';
+UPDATE province SET latitude = 13.5991, longitude = 100.5998 WHERE name = 'สมุทรปราการ';
+UPDATE province SET latitude = 13.4098, longitude = 100.0023 WHERE name = 'สมุทรสงคราม';
+UPDATE province SET latitude = 13.5475, longitude = 100.2744 WHERE name = 'สมุทรสาคร';
+UPDATE province SET latitude = 14.5289, longitude = 100.9101 WHERE name = 'สระบุรี';
+UPDATE province SET latitude = 13.8240, longitude = 102.0646 WHERE name = 'สระแก้ว';
+UPDATE province SET latitude = 14.8936, longitude = 100.3967 WHERE name = 'สิงห์บุรี';
+UPDATE province SET latitude = 14.4745, longitude = 100.1177 WHERE name = 'สุพรรณบุรี';
+UPDATE province SET latitude = 9.1382, longitude = 99.3217 WHERE name = 'สุราษฎร์ธานี';
+UPDATE province SET latitude = 14.8818, longitude = 103.4936 WHERE name = 'สุรินทร์';
+UPDATE province SET latitude = 17.0056, longitude = 99.8264 WHERE name = 'สุโขทัย';
+UPDATE province SET latitude = 17.8783, longitude = 102.7420 WHERE name = 'หนองคาย';
+UPDATE province SET latitude = 17.2218, longitude = 102.4260 WHERE name = 'หนองบัวลำภู';
+UPDATE province SET latitude = 15.8657, longitude = 104.6258 WHERE name = 'อำนาจเจริญ';
+UPDATE province SET latitude = 17.4138, longitude = 102.7872 WHERE name = 'อุดรธานี';
+UPDATE province SET latitude = 17.6201, longitude = 100.0993 WHERE name = 'อุตรดิตถ์';
+UPDATE province SET latitude = 15.3835, longitude = 100.0246 WHERE name = 'อุทัยธานี';
+UPDATE province SET latitude = 15.2287, longitude = 104.8564 WHERE name = 'อุบลราชธานี';
+UPDATE province SET latitude = 14.5896, longitude = 100.4551 WHERE name = 'อ่างทอง';
+UPDATE province SET latitude = 19.9105, longitude = 99.8406 WHERE name = 'เชียงราย';
+UPDATE province SET latitude = 18.7883, longitude = 98.9853 WHERE name = 'เชียงใหม่';
+UPDATE province SET latitude = 13.1119, longitude = 99.9447 WHERE name = 'เพชรบุรี';
+UPDATE province SET latitude = 16.4190, longitude = 101.1606 WHERE name = 'เพชรบูรณ์';
+UPDATE province SET latitude = 17.4860, longitude = 101.7223 WHERE name = 'เลย';
+UPDATE province SET latitude = 18.1446, longitude = 100.1403 WHERE name = 'แพร่';
+UPDATE province SET latitude = 19.3020, longitude = 97.9654 WHERE name = 'แม่ฮ่องสอน';
+
+-- +goose Down
+ALTER TABLE subdistrict DROP COLUMN longitude;
+ALTER TABLE subdistrict DROP COLUMN latitude;
+ALTER TABLE district DROP COLUMN longitude;
+ALTER TABLE district DROP COLUMN latitude;
+ALTER TABLE province DROP COLUMN longitude;
+ALTER TABLE province DROP COLUMN latitude;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE project_route_track(
+  id SERIAL PRIMARY KEY NOT NULL,
+  project_history_id INT NOT NULL REFERENCES project_history (id),
+  file_name VARCHAR(255) NOT NULL,
+  track_name VARCHAR(255) NOT NULL,
+  length_km DOUBLE PRECISION NOT NULL,
+  elevation_gain_m DOUBLE PRECISION,
+  min_lat DOUBLE PRECISION NOT NULL,
+  min_lon DOUBLE PRECISION NOT NULL,
+  max_lat DOUBLE PRECISION NOT NULL,
+  max_lon DOUBLE PRECISION NOT NULL
+);
+CREATE INDEX project_route_track_project_history_id ON project_route_track (project_history_id);
+
+-- +goose Down
+DROP TABLE project_route_track;
@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE distance_catalogue(
+  code VARCHAR(32) PRIMARY KEY NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  km DOUBLE PRECISION,
+  km_required BOOLEAN NOT NULL DEFAULT false,
+  order_number SMALLINT NOT NULL
+);
+INSERT INTO distance_catalogue (code, name, km, km_required, order_number) VALUES
+('fun', 'Fun run (ระยะทางไม่เกิน 10 km)', NULL, false, 1),
+('mini', 'Mini Marathon (10 km)', 10, false, 2),
+('half', 'Half Marathon (21.1 km)', 21.0975, false, 3),
+('full', 'Marathon (42.195 km)', 42.195, false, 4),
+('ultra', 'Ultra Marathon (มากกว่า 42.195 km)', NULL, true, 5),
+('custom', 'ระยะอื่น ๆ', NULL, true, 6);
+
+-- legacy rows keep their free text type, catalogue_code stays empty until the mapping tool has run
+ALTER TABLE distance ADD catalogue_code VARCHAR(32) REFERENCES distance_catalogue (code);
+ALTER TABLE distance ADD km DOUBLE PRECISION;
+CREATE INDEX distance_catalogue_code ON distance (catalogue_code);
+
+-- +goose Down
+ALTER TABLE distance DROP COLUMN km;
+ALTER TABLE distance DROP COLUMN catalogue_code;
+DROP TABLE distance_catalogue;
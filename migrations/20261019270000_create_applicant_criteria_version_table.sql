@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE applicant_criteria_version(
+  criteria_version SMALLINT PRIMARY KEY NOT NULL,
+  status VARCHAR(16) NOT NULL DEFAULT 'draft',
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  published_at TIMESTAMP WITH TIME ZONE
+);
+-- versions seeded by earlier migrations are already in use
+INSERT INTO applicant_criteria_version (criteria_version, status, created_at, updated_at, published_at)
+SELECT DISTINCT criteria_version, 'published', now(), now(), now() FROM applicant_criteria;
+
+ALTER TABLE applicant_criteria ADD CONSTRAINT applicant_criteria_version_fk
+FOREIGN KEY (criteria_version) REFERENCES applicant_criteria_version (criteria_version);
+CREATE INDEX applicant_criteria_criteria_version ON applicant_criteria (criteria_version);
+
+-- +goose Down
+DROP INDEX applicant_criteria_criteria_version;
+ALTER TABLE applicant_criteria DROP CONSTRAINT applicant_criteria_version_fk;
+DROP TABLE applicant_criteria_version;
@@ -0,0 +1,154 @@
+package completionReport
+
+import "fmt"
+
+type ParticipantsRequiredError struct{}
+
+func (e *ParticipantsRequiredError) Error() string {
+	return "participants are required"
+}
+
+type DistanceTypeRequiredError struct{}
+
+func (e *DistanceTypeRequiredError) Error() string {
+	return "distanceType is required"
+}
+
+type DistanceTypeDuplicatedError struct {
+	DistanceType string
+}
+
+func (e *DistanceTypeDuplicatedError) Error() string {
+	return fmt.Sprintf("distanceType %s is duplicated", e.DistanceType)
+}
+
+type ParticipantCountNegativeError struct{}
+
+func (e *ParticipantCountNegativeError) Error() string {
+	return "participantCount is negative"
+}
+
+type IncidentsTooLongError struct {
+	Length int
+}
+
+func (e *IncidentsTooLongError) Error() string {
+	return fmt.Sprintf("incidents length is over 4096 characters, got %d", e.Length)
+}
+
+type TotalExpenseRequiredError struct{}
+
+func (e *TotalExpenseRequiredError) Error() string {
+	return "totalExpense is required"
+}
+
+type TotalExpenseNegativeError struct{}
+
+func (e *TotalExpenseNegativeError) Error() string {
+	return "totalExpense is negative"
+}
+
+type FundUsedAmountRequiredError struct{}
+
+func (e *FundUsedAmountRequiredError) Error() string {
+	return "fundUsedAmount is required"
+}
+
+type FundUsedAmountNegativeError struct{}
+
+func (e *FundUsedAmountNegativeError) Error() string {
+	return "fundUsedAmount is negative"
+}
+
+type FundUsedExceedTotalExpenseError struct{}
+
+func (e *FundUsedExceedTotalExpenseError) Error() string {
+	return "fundUsedAmount is greater than totalExpense"
+}
+
+type FundUsedExceedApprovedAmountError struct {
+	FundApprovedAmount int64
+}
+
+func (e *FundUsedExceedApprovedAmountError) Error() string {
+	return fmt.Sprintf("fundUsedAmount is greater than fundApprovedAmount %d", e.FundApprovedAmount)
+}
+
+type ReceiptFilesRequiredError struct{}
+
+func (e *ReceiptFilesRequiredError) Error() string {
+	return "receiptFiles are required"
+}
+
+type PhotoFilesRequiredError struct{}
+
+func (e *PhotoFilesRequiredError) Error() string {
+	return "photoFiles are required"
+}
+
+type ProjectNotFoundError struct{}
+
+func (e *ProjectNotFoundError) Error() string {
+	return "project is not found"
+}
+
+type ProjectNotStartedError struct{}
+
+func (e *ProjectNotStartedError) Error() string {
+	return "completion report can only be submitted when project status is Start"
+}
+
+type ReportAlreadySubmittedError struct{}
+
+func (e *ReportAlreadySubmittedError) Error() string {
+	return "completion report is already submitted"
+}
+
+type ReportNotFoundError struct{}
+
+func (e *ReportNotFoundError) Error() string {
+	return "completion report is not found"
+}
+
+type ReportStatusInvalidError struct{}
+
+func (e *ReportStatusInvalidError) Error() string {
+	return "status must be Accepted or Rejected"
+}
+
+type ReportAlreadyReviewedError struct{}
+
+func (e *ReportAlreadyReviewedError) Error() string {
+	return "completion report is already reviewed"
+}
+
+type AdminCommentTooLongError struct {
+	Length int
+}
+
+func (e *AdminCommentTooLongError) Error() string {
+	return fmt.Sprintf("adminComment length is over 512 characters, got %d", e.Length)
+}
+
+type TooManyFilesError struct{}
+
+func (e *TooManyFilesError) Error() string {
+	return fmt.Sprintf("at most %d files can be uploaded", REPORT_FILES_MAX)
+}
+
+type FileTooLargeError struct {
+	FileName string
+}
+
+func (e *FileTooLargeError) Error() string {
+	return fmt.Sprintf("file %s is larger than 10MB", e.FileName)
+}
+
+type FileTypeNotAllowedError struct {
+	FileName    string
+	ContentType string
+}
+
+func (e *FileTypeNotAllowedError) Error() string {
+	return fmt.Sprintf("file %s is %s which is not allowed", e.FileName, e.ContentType)
+}
@@ -0,0 +1,153 @@
+package completionReport
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+type CompletionReportStore interface {
+	GetReportProject(projectCode string) (ReportProject, error)
+	HasOpenReport(projectCode string) (bool, error)
+	SubmitReport(payload SubmitCompletionReportRequest, projectCode string, project ReportProject, photoFiles, receiptFiles []*multipart.FileHeader) (int, error)
+	GetReportsByProjectCode(projectCode string) ([]CompletionReport, error)
+	ReviewReport(reportId int, payload ReviewCompletionReportRequest, adminId int) error
+}
+
+type CompletionReportHandler struct {
+	store CompletionReportStore
+}
+
+func NewCompletionReportHandler(s CompletionReportStore) *CompletionReportHandler {
+	return &CompletionReportHandler{
+		store: s,
+	}
+}
+
+func (h *CompletionReportHandler) SubmitReport(w http.ResponseWriter, r *http.Request) {
+	userId, err := utils.GetUserIdFromRequestHeader(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, "userId", http.StatusForbidden)
+		return
+	}
+	projectCode := chi.URLParam(r, "projectCode")
+
+	if err := r.ParseMultipartForm(50 << 20); err != nil {
+		utils.ErrorJSON(w, err, "", http.StatusBadRequest)
+		return
+	}
+	payload := SubmitCompletionReportRequest{}
+	err = json.Unmarshal([]byte(r.FormValue("form")), &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "form")
+		return
+	}
+	photoFiles := r.MultipartForm.File["photoFiles"]
+	receiptFiles := r.MultipartForm.File["receiptFiles"]
+
+	project, err := h.store.GetReportProject(projectCode)
+	if err != nil || project.UserId != userId {
+		utils.ErrorJSON(w, &ProjectNotFoundError{}, "projectCode", http.StatusNotFound)
+		return
+	}
+	if project.ProjectStatus != "Start" {
+		utils.ErrorJSON(w, &ProjectNotStartedError{}, "projectStatus", http.StatusBadRequest)
+		return
+	}
+	hasOpenReport, err := h.store.HasOpenReport(projectCode)
+	if err != nil {
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	if hasOpenReport {
+		utils.ErrorJSON(w, &ReportAlreadySubmittedError{}, "projectCode", http.StatusConflict)
+		return
+	}
+
+	errField, err := validateSubmitPayload(payload, project.FundApprovedAmount, photoFiles, receiptFiles)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	reportId, err := h.store.SubmitReport(payload, projectCode, project, photoFiles, receiptFiles)
+	if err != nil {
+		var submittedErr *ReportAlreadySubmittedError
+		if errors.As(err, &submittedErr) {
+			utils.ErrorJSON(w, err, "projectCode", http.StatusConflict)
+			return
+		}
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusCreated, reportId)
+}
+
+func (h *CompletionReportHandler) GetReports(w http.ResponseWriter, r *http.Request) {
+	userId, err := utils.GetUserIdFromRequestHeader(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, "userId", http.StatusForbidden)
+		return
+	}
+	userRole := utils.GetUserRoleFromRequestHeader(r)
+	if userRole != "admin" && userRole != "applicant" {
+		utils.ErrorJSON(w, errors.New("access denied. No permission"), "userRole", http.StatusForbidden)
+		return
+	}
+	projectCode := chi.URLParam(r, "projectCode")
+	if userRole == "applicant" {
+		project, err := h.store.GetReportProject(projectCode)
+		if err != nil || project.UserId != userId {
+			utils.ErrorJSON(w, &ProjectNotFoundError{}, "projectCode", http.StatusNotFound)
+			return
+		}
+	}
+
+	reports, err := h.store.GetReportsByProjectCode(projectCode)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, reports)
+}
+
+func (h *CompletionReportHandler) AdminReviewReport(w http.ResponseWriter, r *http.Request) {
+	adminId, err := utils.GetUserIdFromRequestHeader(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, "userId", http.StatusForbidden)
+		return
+	}
+	reportId, err := strconv.Atoi(chi.URLParam(r, "reportId"))
+	if err != nil {
+		utils.ErrorJSON(w, &ReportNotFoundError{}, "reportId", http.StatusNotFound)
+		return
+	}
+
+	var payload ReviewCompletionReportRequest
+	err = utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errField, err := validateReviewPayload(payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	err = h.store.ReviewReport(reportId, payload, adminId)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusBadRequest)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, reportId)
+}
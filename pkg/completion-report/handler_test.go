@@ -0,0 +1,297 @@
+package completionReport_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	completionReport "github.com/poomipat-k/running-fund/pkg/completion-report"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+)
+
+type ErrorBody struct {
+	Error   bool
+	Message string
+	Name    string
+}
+
+var pngContent = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+var textContent = []byte("just some text pretending to be a receipt")
+
+type uploadFile struct {
+	field   string
+	name    string
+	content []byte
+}
+
+func newInt64(v int64) *int64 {
+	return &v
+}
+
+func startedProject(projectCode string) (completionReport.ReportProject, error) {
+	return completionReport.ReportProject{ProjectHistoryId: 7, UserId: 1, ProjectStatus: "Start", FundApprovedAmount: newInt64(50000)}, nil
+}
+
+func noOpenReport(projectCode string) (bool, error) {
+	return false, nil
+}
+
+var submitOkPayload = completionReport.SubmitCompletionReportRequest{
+	Participants:   []completionReport.DistanceParticipant{{DistanceType: "10 km", ParticipantCount: 300}},
+	TotalExpense:   newInt64(80000),
+	FundUsedAmount: newInt64(40000),
+}
+
+var submitOkFiles = []uploadFile{
+	{field: "photoFiles", name: "start.png", content: pngContent},
+	{field: "receiptFiles", name: "receipt.png", content: pngContent},
+}
+
+func TestSubmitReport(t *testing.T) {
+	tests := []struct {
+		name           string
+		payload        completionReport.SubmitCompletionReportRequest
+		files          []uploadFile
+		store          *mock.MockCompletionReportStore
+		expectedStatus int
+		expectedError  error
+	}{
+		{
+			name:    "should error when project is owned by another applicant",
+			payload: submitOkPayload,
+			files:   submitOkFiles,
+			store: &mock.MockCompletionReportStore{
+				GetReportProjectFunc: func(projectCode string) (completionReport.ReportProject, error) {
+					return completionReport.ReportProject{UserId: 2, ProjectStatus: "Start"}, nil
+				},
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  &completionReport.ProjectNotFoundError{},
+		},
+		{
+			name:    "should error when project has not started",
+			payload: submitOkPayload,
+			files:   submitOkFiles,
+			store: &mock.MockCompletionReportStore{
+				GetReportProjectFunc: func(projectCode string) (completionReport.ReportProject, error) {
+					return completionReport.ReportProject{UserId: 1, ProjectStatus: "Approved"}, nil
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &completionReport.ProjectNotStartedError{},
+		},
+		{
+			name:    "should error when a report is already open",
+			payload: submitOkPayload,
+			files:   submitOkFiles,
+			store: &mock.MockCompletionReportStore{
+				GetReportProjectFunc: startedProject,
+				HasOpenReportFunc: func(projectCode string) (bool, error) {
+					return true, nil
+				},
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  &completionReport.ReportAlreadySubmittedError{},
+		},
+		{
+			name:    "should error when a concurrent submission wins the insert",
+			payload: submitOkPayload,
+			files:   submitOkFiles,
+			store: &mock.MockCompletionReportStore{
+				GetReportProjectFunc: startedProject,
+				HasOpenReportFunc:    noOpenReport,
+				SubmitReportFunc: func(payload completionReport.SubmitCompletionReportRequest, projectCode string, project completionReport.ReportProject, photoFiles, receiptFiles []*multipart.FileHeader) (int, error) {
+					return 0, &completionReport.ReportAlreadySubmittedError{}
+				},
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  &completionReport.ReportAlreadySubmittedError{},
+		},
+		{
+			name:    "should error when fund used is over the approved amount",
+			payload: completionReport.SubmitCompletionReportRequest{Participants: submitOkPayload.Participants, TotalExpense: newInt64(80000), FundUsedAmount: newInt64(60000)},
+			files:   submitOkFiles,
+			store: &mock.MockCompletionReportStore{
+				GetReportProjectFunc: startedProject,
+				HasOpenReportFunc:    noOpenReport,
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &completionReport.FundUsedExceedApprovedAmountError{FundApprovedAmount: 50000},
+		},
+		{
+			name:    "should error when a photo is not an image",
+			payload: submitOkPayload,
+			files: []uploadFile{
+				{field: "photoFiles", name: "start.png", content: textContent},
+				{field: "receiptFiles", name: "receipt.png", content: pngContent},
+			},
+			store: &mock.MockCompletionReportStore{
+				GetReportProjectFunc: startedProject,
+				HasOpenReportFunc:    noOpenReport,
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &completionReport.FileTypeNotAllowedError{FileName: "start.png", ContentType: "text/plain; charset=utf-8"},
+		},
+		{
+			name:    "should error when receipts are missing",
+			payload: submitOkPayload,
+			files:   submitOkFiles[:1],
+			store: &mock.MockCompletionReportStore{
+				GetReportProjectFunc: startedProject,
+				HasOpenReportFunc:    noOpenReport,
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &completionReport.ReceiptFilesRequiredError{},
+		},
+		{
+			name:    "should submit a report",
+			payload: submitOkPayload,
+			files:   submitOkFiles,
+			store: &mock.MockCompletionReportStore{
+				GetReportProjectFunc: startedProject,
+				HasOpenReportFunc:    noOpenReport,
+				SubmitReportFunc: func(payload completionReport.SubmitCompletionReportRequest, projectCode string, project completionReport.ReportProject, photoFiles, receiptFiles []*multipart.FileHeader) (int, error) {
+					return 3, nil
+				},
+			},
+			expectedStatus: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := completionReport.NewCompletionReportHandler(tt.store)
+
+			body := &bytes.Buffer{}
+			multipartWriter := multipart.NewWriter(body)
+			form, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = multipartWriter.WriteField("form", string(form))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, f := range tt.files {
+				fw, err := multipartWriter.CreateFormFile(f.field, f.name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				fw.Write(f.content)
+			}
+			multipartWriter.Close()
+
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/project/completion-report/APR67_0501", body)
+			req.Header.Set("userId", "1")
+			req.Header.Set("content-type", multipartWriter.FormDataContentType())
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("projectCode", "APR67_0501")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			handler.SubmitReport(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				assertErrorMessage(t, getErrorResponse(t, res).Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
+
+func TestAdminReviewReport(t *testing.T) {
+	tests := []struct {
+		name           string
+		reportId       string
+		payload        completionReport.ReviewCompletionReportRequest
+		reviewErr      error
+		expectedStatus int
+		expectedError  error
+	}{
+		{
+			name:           "should error when reportId is not a number",
+			reportId:       "abc",
+			payload:        completionReport.ReviewCompletionReportRequest{Status: "Accepted"},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  &completionReport.ReportNotFoundError{},
+		},
+		{
+			name:           "should error when status is invalid",
+			reportId:       "3",
+			payload:        completionReport.ReviewCompletionReportRequest{Status: "Submitted"},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &completionReport.ReportStatusInvalidError{},
+		},
+		{
+			name:           "should error when report is already reviewed",
+			reportId:       "3",
+			payload:        completionReport.ReviewCompletionReportRequest{Status: "Rejected"},
+			reviewErr:      &completionReport.ReportAlreadyReviewedError{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &completionReport.ReportAlreadyReviewedError{},
+		},
+		{
+			name:           "should accept a report",
+			reportId:       "3",
+			payload:        completionReport.ReviewCompletionReportRequest{Status: "Accepted"},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mock.MockCompletionReportStore{
+				ReviewReportFunc: func(reportId int, payload completionReport.ReviewCompletionReportRequest, adminId int) error {
+					return tt.reviewErr
+				},
+			}
+			handler := completionReport.NewCompletionReportHandler(store)
+
+			body, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/completion-report/"+tt.reportId+"/review", bytes.NewReader(body))
+			req.Header.Set("userId", "9")
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("reportId", tt.reportId)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			handler.AdminReviewReport(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				assertErrorMessage(t, getErrorResponse(t, res).Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
+
+func getErrorResponse(t testing.TB, res *httptest.ResponseRecorder) ErrorBody {
+	t.Helper()
+	var body ErrorBody
+	err := json.Unmarshal(res.Body.Bytes(), &body)
+	if err != nil {
+		t.Fatalf("Unable to parse response from server %q into ErrorBody, '%v'", res.Body, err)
+	}
+	return body
+}
+
+func assertErrorMessage(t testing.TB, got, want string) {
+	t.Helper()
+	if got != want {
+		t.Errorf("did not get correct error, got %v, want %v", got, want)
+	}
+}
+
+func assertStatus(t testing.TB, got, want int) {
+	t.Helper()
+	if got != want {
+		t.Errorf("did not get correct status, got %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,48 @@
+package completionReport
+
+import "time"
+
+type SubmitCompletionReportRequest struct {
+	Participants     []DistanceParticipant `json:"participants,omitempty"`
+	Incidents        string                `json:"incidents,omitempty"`
+	TotalExpense     *int64                `json:"totalExpense,omitempty"`
+	FundUsedAmount   *int64                `json:"fundUsedAmount,omitempty"`
+	FinancialSummary string                `json:"financialSummary,omitempty"`
+}
+
+type DistanceParticipant struct {
+	DistanceType     string `json:"distanceType,omitempty"`
+	ParticipantCount int    `json:"participantCount"`
+}
+
+type ReviewCompletionReportRequest struct {
+	Status       string  `json:"status,omitempty"`
+	AdminComment *string `json:"adminComment,omitempty"`
+}
+
+type CompletionReport struct {
+	Id                 int                   `json:"id"`
+	ProjectCode        string                `json:"projectCode"`
+	ProjectHistoryId   int                   `json:"projectHistoryId"`
+	UserId             int                   `json:"userId"`
+	Status             string                `json:"status"`
+	Participants       []DistanceParticipant `json:"participants"`
+	Incidents          string                `json:"incidents"`
+	TotalExpense       int64                 `json:"totalExpense"`
+	FundUsedAmount     int64                 `json:"fundUsedAmount"`
+	FundApprovedAmount int64                 `json:"fundApprovedAmount"`
+	FundRemaining      int64                 `json:"fundRemaining"`
+	FinancialSummary   string                `json:"financialSummary"`
+	FilesPrefix        string                `json:"filesPrefix"`
+	AdminComment       *string               `json:"adminComment,omitempty"`
+	ReviewedBy         *int                  `json:"reviewedBy,omitempty"`
+	ReviewedAt         *time.Time            `json:"reviewedAt,omitempty"`
+	CreatedAt          time.Time             `json:"createdAt"`
+}
+
+type ReportProject struct {
+	ProjectHistoryId   int
+	UserId             int
+	ProjectStatus      string
+	FundApprovedAmount *int64
+}
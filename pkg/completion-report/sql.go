@@ -0,0 +1,70 @@
+package completionReport
+
+const getReportProjectSQL = `
+SELECT
+project.project_history_id,
+project.user_id,
+project_history.status,
+project_history.fund_approved_amount
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+WHERE project.project_code = $1;
+`
+
+const countOpenReportsSQL = `
+SELECT COUNT(*) FROM completion_report
+WHERE project_code = $1 AND status IN ('Submitted', 'Accepted');
+`
+
+const insertReportSQL = `
+INSERT INTO completion_report
+(project_code, project_history_id, user_id, status, incidents, total_expense, fund_used_amount, financial_summary, files_prefix, created_at)
+VALUES ($1, $2, $3, 'Submitted', $4, $5, $6, $7, $8, $9) RETURNING id;
+`
+
+const insertManyParticipantsSQL = `
+INSERT INTO completion_report_participant (completion_report_id, distance_type, participant_count) VALUES 
+`
+
+const getReportsByProjectCodeSQL = `
+SELECT
+completion_report.id,
+completion_report.project_code,
+completion_report.project_history_id,
+completion_report.user_id,
+completion_report.status,
+completion_report.incidents,
+completion_report.total_expense,
+completion_report.fund_used_amount,
+project_history.fund_approved_amount,
+completion_report.financial_summary,
+completion_report.files_prefix,
+completion_report.admin_comment,
+completion_report.reviewed_by,
+completion_report.reviewed_at,
+completion_report.created_at
+FROM completion_report
+INNER JOIN project_history ON completion_report.project_history_id = project_history.id
+WHERE completion_report.project_code = $1
+ORDER BY completion_report.created_at DESC;
+`
+
+const getParticipantsByReportIdSQL = `
+SELECT distance_type, participant_count FROM completion_report_participant
+WHERE completion_report_id = $1
+ORDER BY id ASC;
+`
+
+const reviewReportSQL = `
+UPDATE completion_report
+SET status = $2, admin_comment = $3, reviewed_by = $4, reviewed_at = $5
+WHERE id = $1 AND status = 'Submitted'
+RETURNING project_history_id;
+`
+
+const completeProjectSQL = `
+UPDATE project_history
+SET status = 'Completed', updated_at = $2
+WHERE id = $1 AND status = 'Start'
+RETURNING id;
+`
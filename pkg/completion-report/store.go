@@ -0,0 +1,242 @@
+package completionReport
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime/multipart"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+type store struct {
+	db           *sql.DB
+	awsS3Service s3Service.S3Service
+}
+
+func NewStore(db *sql.DB, awsS3Service s3Service.S3Service) *store {
+	return &store{
+		db:           db,
+		awsS3Service: awsS3Service,
+	}
+}
+
+func (s *store) GetReportProject(projectCode string) (ReportProject, error) {
+	var p ReportProject
+	err := s.db.QueryRow(getReportProjectSQL, projectCode).Scan(&p.ProjectHistoryId, &p.UserId, &p.ProjectStatus, &p.FundApprovedAmount)
+	switch err {
+	case sql.ErrNoRows:
+		return ReportProject{}, &ProjectNotFoundError{}
+	case nil:
+		return p, nil
+	default:
+		slog.Error(err.Error())
+		return ReportProject{}, err
+	}
+}
+
+func (s *store) HasOpenReport(projectCode string) (bool, error) {
+	var count int
+	err := s.db.QueryRow(countOpenReportsSQL, projectCode).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (s *store) SubmitReport(
+	payload SubmitCompletionReportRequest,
+	projectCode string,
+	project ReportProject,
+	photoFiles, receiptFiles []*multipart.FileHeader,
+) (int, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return failSubmit("tx", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	filesPrefix := fmt.Sprintf("applicant/user_%d/%s/completion-report/%d", project.UserId, projectCode, now.Unix())
+	var reportId int
+	err = tx.QueryRowContext(
+		ctx,
+		insertReportSQL,
+		projectCode,
+		project.ProjectHistoryId,
+		project.UserId,
+		payload.Incidents,
+		payload.TotalExpense,
+		payload.FundUsedAmount,
+		payload.FinancialSummary,
+		filesPrefix,
+		now,
+	).Scan(&reportId)
+	if isOpenReportConflict(err) {
+		return 0, &ReportAlreadySubmittedError{}
+	}
+	if err != nil {
+		return failSubmit("reportId", err)
+	}
+
+	valuesStrPlaceholder := []string{}
+	values := []any{}
+	for i, p := range payload.Participants {
+		valuesStrPlaceholder = append(valuesStrPlaceholder, fmt.Sprintf("($%d, $%d, $%d)", 3*i+1, 3*i+2, 3*i+3))
+		values = append(values, reportId, strings.TrimSpace(p.DistanceType), p.ParticipantCount)
+	}
+	customSQL := insertManyParticipantsSQL + strings.Join(valuesStrPlaceholder, ",") + ";"
+	_, err = tx.ExecContext(ctx, customSQL, values...)
+	if err != nil {
+		return failSubmit("participants", err)
+	}
+
+	bucketName := os.Getenv("AWS_S3_STORE_BUCKET_NAME")
+	err = s.awsS3Service.UploadFilesToS3(photoFiles, bucketName, fmt.Sprintf("%s/photos", filesPrefix))
+	if err != nil {
+		return failSubmit("photoFiles", err)
+	}
+	err = s.awsS3Service.UploadFilesToS3(receiptFiles, bucketName, fmt.Sprintf("%s/receipts", filesPrefix))
+	if err != nil {
+		return failSubmit("receiptFiles", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return failSubmit("tx.Commit()", err)
+	}
+	slog.Info("success submitting a completion report", "projectCode", projectCode, "reportId", reportId)
+	return reportId, nil
+}
+
+func (s *store) GetReportsByProjectCode(projectCode string) ([]CompletionReport, error) {
+	rows, err := s.db.Query(getReportsByProjectCodeSQL, projectCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var data []CompletionReport
+	for rows.Next() {
+		var row CompletionReport
+		var fundApprovedAmount sql.NullInt64
+		err = rows.Scan(
+			&row.Id,
+			&row.ProjectCode,
+			&row.ProjectHistoryId,
+			&row.UserId,
+			&row.Status,
+			&row.Incidents,
+			&row.TotalExpense,
+			&row.FundUsedAmount,
+			&fundApprovedAmount,
+			&row.FinancialSummary,
+			&row.FilesPrefix,
+			&row.AdminComment,
+			&row.ReviewedBy,
+			&row.ReviewedAt,
+			&row.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		row.FundApprovedAmount = fundApprovedAmount.Int64
+		row.FundRemaining = row.FundApprovedAmount - row.FundUsedAmount
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range data {
+		participants, err := s.getParticipantsByReportId(data[i].Id)
+		if err != nil {
+			return nil, err
+		}
+		data[i].Participants = participants
+	}
+	return data, nil
+}
+
+func (s *store) getParticipantsByReportId(reportId int) ([]DistanceParticipant, error) {
+	rows, err := s.db.Query(getParticipantsByReportIdSQL, reportId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var data []DistanceParticipant
+	for rows.Next() {
+		var row DistanceParticipant
+		err = rows.Scan(&row.DistanceType, &row.ParticipantCount)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ReviewReport accepts or rejects a submitted report. Accepting moves the project to Completed.
+func (s *store) ReviewReport(reportId int, payload ReviewCompletionReportRequest, adminId int) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	var projectHistoryId int
+	err = tx.QueryRowContext(ctx, reviewReportSQL, reportId, payload.Status, payload.AdminComment, adminId, now).Scan(&projectHistoryId)
+	if err == sql.ErrNoRows {
+		return &ReportAlreadyReviewedError{}
+	}
+	if err != nil {
+		return err
+	}
+
+	if payload.Status == "Accepted" {
+		var id int
+		err = tx.QueryRowContext(ctx, completeProjectSQL, projectHistoryId, now).Scan(&id)
+		if err == sql.ErrNoRows {
+			return &ProjectNotStartedError{}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	slog.Info("success reviewing a completion report", "reportId", reportId, "status", payload.Status)
+	return nil
+}
+
+// isOpenReportConflict reports a submission that lost the race against another open report of the same project
+func isOpenReportConflict(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "completion_report_open_project_code"
+}
+
+func failSubmit(name string, err error) (int, error) {
+	return 0, fmt.Errorf("submitCompletionReport name: %s, error: %w", name, err)
+}
@@ -0,0 +1,135 @@
+package completionReport
+
+import (
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+const INCIDENTS_MAX_LENGTH = 4096
+const ADMIN_COMMENT_MAX_LENGTH = 512
+
+const (
+	REPORT_FILES_MAX     = 20
+	REPORT_FILE_MAX_SIZE = 10 << 20 // 10 mb
+)
+
+var PHOTO_CONTENT_TYPES = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+}
+
+var RECEIPT_CONTENT_TYPES = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"application/pdf": true,
+}
+
+var REVIEW_STATUS = map[string]bool{
+	"Accepted": true,
+	"Rejected": true,
+}
+
+func validateSubmitPayload(payload SubmitCompletionReportRequest, fundApprovedAmount *int64, photoFiles, receiptFiles []*multipart.FileHeader) (string, error) {
+	if len(payload.Participants) == 0 {
+		return "participants", &ParticipantsRequiredError{}
+	}
+	seen := map[string]bool{}
+	for _, p := range payload.Participants {
+		distanceType := strings.TrimSpace(p.DistanceType)
+		if distanceType == "" {
+			return "distanceType", &DistanceTypeRequiredError{}
+		}
+		if seen[distanceType] {
+			return "distanceType", &DistanceTypeDuplicatedError{DistanceType: distanceType}
+		}
+		seen[distanceType] = true
+		if p.ParticipantCount < 0 {
+			return "participantCount", &ParticipantCountNegativeError{}
+		}
+	}
+	if utf8.RuneCountInString(payload.Incidents) > INCIDENTS_MAX_LENGTH {
+		return "incidents", &IncidentsTooLongError{utf8.RuneCountInString(payload.Incidents)}
+	}
+	if payload.TotalExpense == nil {
+		return "totalExpense", &TotalExpenseRequiredError{}
+	}
+	if *payload.TotalExpense < 0 {
+		return "totalExpense", &TotalExpenseNegativeError{}
+	}
+	if payload.FundUsedAmount == nil {
+		return "fundUsedAmount", &FundUsedAmountRequiredError{}
+	}
+	if *payload.FundUsedAmount < 0 {
+		return "fundUsedAmount", &FundUsedAmountNegativeError{}
+	}
+	if *payload.FundUsedAmount > *payload.TotalExpense {
+		return "fundUsedAmount", &FundUsedExceedTotalExpenseError{}
+	}
+	var approved int64
+	if fundApprovedAmount != nil {
+		approved = *fundApprovedAmount
+	}
+	if *payload.FundUsedAmount > approved {
+		return "fundUsedAmount", &FundUsedExceedApprovedAmountError{FundApprovedAmount: approved}
+	}
+	if len(photoFiles) == 0 {
+		return "photoFiles", &PhotoFilesRequiredError{}
+	}
+	if err := validateReportFiles(photoFiles, PHOTO_CONTENT_TYPES); err != nil {
+		return "photoFiles", err
+	}
+	if *payload.FundUsedAmount > 0 && len(receiptFiles) == 0 {
+		return "receiptFiles", &ReceiptFilesRequiredError{}
+	}
+	if err := validateReportFiles(receiptFiles, RECEIPT_CONTENT_TYPES); err != nil {
+		return "receiptFiles", err
+	}
+	return "", nil
+}
+
+// validateReportFiles sniffs the content the same way the s3 upload does, the header content type is not trusted
+func validateReportFiles(files []*multipart.FileHeader, allowed map[string]bool) error {
+	if len(files) > REPORT_FILES_MAX {
+		return &TooManyFilesError{}
+	}
+	for _, fileHeader := range files {
+		if fileHeader.Size > REPORT_FILE_MAX_SIZE {
+			return &FileTooLargeError{FileName: fileHeader.Filename}
+		}
+		contentType, err := detectContentType(fileHeader)
+		if err != nil {
+			return err
+		}
+		if !allowed[contentType] {
+			return &FileTypeNotAllowedError{FileName: fileHeader.Filename, ContentType: contentType}
+		}
+	}
+	return nil
+}
+
+func detectContentType(fileHeader *multipart.FileHeader) (string, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	buff := make([]byte, 512)
+	n, err := file.Read(buff)
+	if err != nil {
+		return "", err
+	}
+	return http.DetectContentType(buff[:n]), nil
+}
+
+func validateReviewPayload(payload ReviewCompletionReportRequest) (string, error) {
+	if !REVIEW_STATUS[payload.Status] {
+		return "status", &ReportStatusInvalidError{}
+	}
+	if payload.AdminComment != nil && utf8.RuneCountInString(*payload.AdminComment) > ADMIN_COMMENT_MAX_LENGTH {
+		return "adminComment", &AdminCommentTooLongError{utf8.RuneCountInString(*payload.AdminComment)}
+	}
+	return "", nil
+}
@@ -0,0 +1,117 @@
+package disbursement
+
+import "fmt"
+
+type EntryTypeInvalidError struct{}
+
+func (e *EntryTypeInvalidError) Error() string {
+	return "entryType must be Installment or Refund"
+}
+
+type AmountInvalidError struct{}
+
+func (e *AmountInvalidError) Error() string {
+	return "amount must be greater than 0"
+}
+
+type ScheduledDateRequiredError struct{}
+
+func (e *ScheduledDateRequiredError) Error() string {
+	return "scheduledDate is required for an installment"
+}
+
+type PaidAtRequiredError struct{}
+
+func (e *PaidAtRequiredError) Error() string {
+	return "paidAt is required"
+}
+
+type BankTransferRefRequiredError struct{}
+
+func (e *BankTransferRefRequiredError) Error() string {
+	return "bankTransferRef is required"
+}
+
+type NoteTooLongError struct {
+	Length int
+}
+
+func (e *NoteTooLongError) Error() string {
+	return fmt.Sprintf("note length is over 512 characters, got %d", e.Length)
+}
+
+type ProofFilesRequiredError struct{}
+
+func (e *ProofFilesRequiredError) Error() string {
+	return "proofFiles are required"
+}
+
+type ProjectNotFoundError struct{}
+
+func (e *ProjectNotFoundError) Error() string {
+	return "project is not found"
+}
+
+type ProjectNotApprovedError struct{}
+
+func (e *ProjectNotApprovedError) Error() string {
+	return "project has no approved fund"
+}
+
+type ExceedApprovedAmountError struct {
+	Remaining int64
+}
+
+func (e *ExceedApprovedAmountError) Error() string {
+	return fmt.Sprintf("installments exceed fundApprovedAmount, remaining %d", e.Remaining)
+}
+
+type ExceedNetPaidAmountError struct {
+	NetPaid int64
+}
+
+func (e *ExceedNetPaidAmountError) Error() string {
+	return fmt.Sprintf("refund exceeds net paid amount %d", e.NetPaid)
+}
+
+type EntryNotFoundError struct{}
+
+func (e *EntryNotFoundError) Error() string {
+	return "disbursement entry is not found"
+}
+
+type EntryAlreadyPaidError struct{}
+
+func (e *EntryAlreadyPaidError) Error() string {
+	return "disbursement entry is already paid"
+}
+
+type FromYearRequiredError struct{}
+
+func (e *FromYearRequiredError) Error() string {
+	return "fromYear is required"
+}
+
+type ToYearRequiredError struct{}
+
+func (e *ToYearRequiredError) Error() string {
+	return "toYear is required"
+}
+
+type MonthOutOfBoundError struct{}
+
+func (e *MonthOutOfBoundError) Error() string {
+	return "month must be between 1 and 12"
+}
+
+type DayOutOfBoundError struct{}
+
+func (e *DayOutOfBoundError) Error() string {
+	return "day is not a valid day of the month"
+}
+
+type FromDateExceedToDateError struct{}
+
+func (e *FromDateExceedToDateError) Error() string {
+	return "fromDate is later than toDate"
+}
@@ -0,0 +1,178 @@
+package disbursement
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+type DisbursementStore interface {
+	GetLedgerProject(projectCode string) (LedgerProject, error)
+	GetLedger(project LedgerProject) (Ledger, error)
+	AddEntry(projectCode string, payload AddEntryRequest, adminId int, proofFiles []*multipart.FileHeader) (int, error)
+	GetEntryById(entryId int) (Entry, error)
+	MarkEntryPaid(entry Entry, payload MarkPaidRequest, proofFiles []*multipart.FileHeader) error
+	GenerateReconciliationReport(fromDate, toDate time.Time) (*bytes.Buffer, error)
+}
+
+type DisbursementHandler struct {
+	store DisbursementStore
+}
+
+func NewDisbursementHandler(s DisbursementStore) *DisbursementHandler {
+	return &DisbursementHandler{
+		store: s,
+	}
+}
+
+func (h *DisbursementHandler) GetLedger(w http.ResponseWriter, r *http.Request) {
+	project, err := h.store.GetLedgerProject(chi.URLParam(r, "projectCode"))
+	if err != nil {
+		utils.ErrorJSON(w, err, "projectCode", http.StatusNotFound)
+		return
+	}
+	ledger, err := h.store.GetLedger(project)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, ledger)
+}
+
+func (h *DisbursementHandler) AddEntry(w http.ResponseWriter, r *http.Request) {
+	adminId, err := utils.GetUserIdFromRequestHeader(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, "userId", http.StatusForbidden)
+		return
+	}
+	if err := r.ParseMultipartForm(25 << 20); err != nil {
+		utils.ErrorJSON(w, err, "", http.StatusBadRequest)
+		return
+	}
+	payload := AddEntryRequest{}
+	err = json.Unmarshal([]byte(r.FormValue("form")), &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "form")
+		return
+	}
+	proofFiles := r.MultipartForm.File["proofFiles"]
+	errField, err := validateAddEntryPayload(payload, proofFiles)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	project, err := h.store.GetLedgerProject(chi.URLParam(r, "projectCode"))
+	if err != nil {
+		utils.ErrorJSON(w, err, "projectCode", http.StatusNotFound)
+		return
+	}
+	if !FUNDED_STATUS[project.ProjectStatus] || project.FundApprovedAmount == nil {
+		utils.ErrorJSON(w, &ProjectNotApprovedError{}, "projectStatus", http.StatusBadRequest)
+		return
+	}
+	ledger, err := h.store.GetLedger(project)
+	if err != nil {
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	errField, err = validateBalance(payload, ledger.Balance)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	id, err := h.store.AddEntry(project.ProjectCode, payload, adminId, proofFiles)
+	if err != nil {
+		var approvedErr *ExceedApprovedAmountError
+		var netPaidErr *ExceedNetPaidAmountError
+		if errors.As(err, &approvedErr) || errors.As(err, &netPaidErr) {
+			utils.ErrorJSON(w, err, "amount", http.StatusBadRequest)
+			return
+		}
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusCreated, id)
+}
+
+func (h *DisbursementHandler) MarkEntryPaid(w http.ResponseWriter, r *http.Request) {
+	entryId, err := strconv.Atoi(chi.URLParam(r, "entryId"))
+	if err != nil {
+		utils.ErrorJSON(w, &EntryNotFoundError{}, "entryId", http.StatusNotFound)
+		return
+	}
+	if err := r.ParseMultipartForm(25 << 20); err != nil {
+		utils.ErrorJSON(w, err, "", http.StatusBadRequest)
+		return
+	}
+	payload := MarkPaidRequest{}
+	err = json.Unmarshal([]byte(r.FormValue("form")), &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "form")
+		return
+	}
+	proofFiles := r.MultipartForm.File["proofFiles"]
+	errField, err := validateMarkPaidPayload(payload, proofFiles)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	entry, err := h.store.GetEntryById(entryId)
+	if err != nil {
+		utils.ErrorJSON(w, err, "entryId", http.StatusNotFound)
+		return
+	}
+	if entry.Status != "Scheduled" {
+		utils.ErrorJSON(w, &EntryAlreadyPaidError{}, "entryId", http.StatusBadRequest)
+		return
+	}
+
+	err = h.store.MarkEntryPaid(entry, payload, proofFiles)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusBadRequest)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, entry.Id)
+}
+
+func (h *DisbursementHandler) GenerateReconciliationReport(w http.ResponseWriter, r *http.Request) {
+	var payload ReconciliationReportRequest
+	err := utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	loc, err := utils.GetTimeLocation()
+	if err != nil {
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	errField, err := validateReconciliationReportPayload(payload, loc)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+	// fromDate <= project.created_at < toDate
+	fromDate := time.Date(payload.FromYear, time.Month(payload.FromMonth), payload.FromDay, 0, 0, 0, 0, loc)
+	toDate := time.Date(payload.ToYear, time.Month(payload.ToMonth), payload.ToDay+1, 0, 0, 0, 0, loc)
+
+	buffer, err := h.store.GenerateReconciliationReport(fromDate, toDate)
+	if err != nil {
+		utils.ErrorJSON(w, err, "report", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, buffer.String())
+}
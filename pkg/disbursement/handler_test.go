@@ -0,0 +1,353 @@
+package disbursement_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/disbursement"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+)
+
+type ErrorBody struct {
+	Error   bool
+	Message string
+	Name    string
+}
+
+type uploadFile struct {
+	field string
+	name  string
+}
+
+func newInt64(v int64) *int64 {
+	return &v
+}
+
+func newString(v string) *string {
+	return &v
+}
+
+func newTime(t time.Time) *time.Time {
+	return &t
+}
+
+func approvedProject(projectCode string) (disbursement.LedgerProject, error) {
+	return disbursement.LedgerProject{ProjectCode: projectCode, ProjectStatus: "Approved", FundApprovedAmount: newInt64(100000)}, nil
+}
+
+// partlyPaidLedger has 60,000 of the approved 100,000 paid or scheduled and 40,000 of it paid
+func partlyPaidLedger(project disbursement.LedgerProject) (disbursement.Ledger, error) {
+	return disbursement.Ledger{
+		ProjectCode: project.ProjectCode,
+		Balance: disbursement.Balance{
+			FundApprovedAmount: 100000,
+			ScheduledAmount:    20000,
+			PaidAmount:         40000,
+			NetPaidAmount:      40000,
+			OutstandingAmount:  60000,
+		},
+	}, nil
+}
+
+func newMultipartRequest(t testing.TB, method, target string, form any, files []uploadFile) *http.Request {
+	t.Helper()
+	body := &bytes.Buffer{}
+	multipartWriter := multipart.NewWriter(body)
+	raw, err := json.Marshal(form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = multipartWriter.WriteField("form", string(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		fw, err := multipartWriter.CreateFormFile(f.field, f.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw.Write([]byte("%PDF-1.4"))
+	}
+	multipartWriter.Close()
+
+	req := httptest.NewRequest(method, target, body)
+	req.Header.Set("userId", "9")
+	req.Header.Set("content-type", multipartWriter.FormDataContentType())
+	return req
+}
+
+func withURLParam(req *http.Request, key, value string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add(key, value)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestAddEntry(t *testing.T) {
+	scheduledDate := newTime(time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name           string
+		payload        disbursement.AddEntryRequest
+		files          []uploadFile
+		store          *mock.MockDisbursementStore
+		expectedStatus int
+		expectedError  error
+	}{
+		{
+			name:    "should error when project has no approved fund",
+			payload: disbursement.AddEntryRequest{EntryType: "Installment", Amount: 10000, ScheduledDate: scheduledDate},
+			store: &mock.MockDisbursementStore{
+				GetLedgerProjectFunc: func(projectCode string) (disbursement.LedgerProject, error) {
+					return disbursement.LedgerProject{ProjectCode: projectCode, ProjectStatus: "Reviewing"}, nil
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &disbursement.ProjectNotApprovedError{},
+		},
+		{
+			name:    "should error when installment is over the remaining approved amount",
+			payload: disbursement.AddEntryRequest{EntryType: "Installment", Amount: 40001, ScheduledDate: scheduledDate},
+			store: &mock.MockDisbursementStore{
+				GetLedgerProjectFunc: approvedProject,
+				GetLedgerFunc:        partlyPaidLedger,
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &disbursement.ExceedApprovedAmountError{Remaining: 40000},
+		},
+		{
+			name: "should error when refund is over the net paid amount",
+			payload: disbursement.AddEntryRequest{
+				EntryType:       "Refund",
+				Amount:          40001,
+				PaidAt:          scheduledDate,
+				BankTransferRef: newString("REF-1"),
+			},
+			files: []uploadFile{{field: "proofFiles", name: "slip.pdf"}},
+			store: &mock.MockDisbursementStore{
+				GetLedgerProjectFunc: approvedProject,
+				GetLedgerFunc:        partlyPaidLedger,
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &disbursement.ExceedNetPaidAmountError{NetPaid: 40000},
+		},
+		{
+			name:    "should error when a concurrent installment used the balance first",
+			payload: disbursement.AddEntryRequest{EntryType: "Installment", Amount: 40000, ScheduledDate: scheduledDate},
+			store: &mock.MockDisbursementStore{
+				GetLedgerProjectFunc: approvedProject,
+				GetLedgerFunc:        partlyPaidLedger,
+				AddEntryFunc: func(projectCode string, payload disbursement.AddEntryRequest, adminId int, proofFiles []*multipart.FileHeader) (int, error) {
+					return 0, &disbursement.ExceedApprovedAmountError{Remaining: 10000}
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &disbursement.ExceedApprovedAmountError{Remaining: 10000},
+		},
+		{
+			name:    "should add an installment that uses the whole remaining amount",
+			payload: disbursement.AddEntryRequest{EntryType: "Installment", Amount: 40000, ScheduledDate: scheduledDate},
+			store: &mock.MockDisbursementStore{
+				GetLedgerProjectFunc: approvedProject,
+				GetLedgerFunc:        partlyPaidLedger,
+				AddEntryFunc: func(projectCode string, payload disbursement.AddEntryRequest, adminId int, proofFiles []*multipart.FileHeader) (int, error) {
+					return 5, nil
+				},
+			},
+			expectedStatus: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := disbursement.NewDisbursementHandler(tt.store)
+			req := newMultipartRequest(t, http.MethodPost, "/admin/disbursement/APR67_0501", tt.payload, tt.files)
+			req = withURLParam(req, "projectCode", "APR67_0501")
+			res := httptest.NewRecorder()
+
+			handler.AddEntry(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				assertErrorMessage(t, getErrorResponse(t, res).Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
+
+func TestMarkEntryPaid(t *testing.T) {
+	paidAt := newTime(time.Date(2026, 11, 2, 10, 0, 0, 0, time.UTC))
+	okPayload := disbursement.MarkPaidRequest{PaidAt: paidAt, BankTransferRef: "REF-2"}
+	okFiles := []uploadFile{{field: "proofFiles", name: "slip.pdf"}}
+	scheduledEntry := func(entryId int) (disbursement.Entry, error) {
+		return disbursement.Entry{Id: entryId, Status: "Scheduled"}, nil
+	}
+
+	tests := []struct {
+		name           string
+		entryId        string
+		payload        disbursement.MarkPaidRequest
+		files          []uploadFile
+		store          *mock.MockDisbursementStore
+		expectedStatus int
+		expectedError  error
+	}{
+		{
+			name:           "should error when entryId is not a number",
+			entryId:        "abc",
+			payload:        okPayload,
+			files:          okFiles,
+			store:          &mock.MockDisbursementStore{},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  &disbursement.EntryNotFoundError{},
+		},
+		{
+			name:           "should error when proof is missing",
+			entryId:        "5",
+			payload:        okPayload,
+			store:          &mock.MockDisbursementStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &disbursement.ProofFilesRequiredError{},
+		},
+		{
+			name:    "should error when entry is already paid",
+			entryId: "5",
+			payload: okPayload,
+			files:   okFiles,
+			store: &mock.MockDisbursementStore{
+				GetEntryByIdFunc: func(entryId int) (disbursement.Entry, error) {
+					return disbursement.Entry{Id: entryId, Status: "Paid"}, nil
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &disbursement.EntryAlreadyPaidError{},
+		},
+		{
+			name:    "should mark a scheduled entry paid",
+			entryId: "5",
+			payload: okPayload,
+			files:   okFiles,
+			store: &mock.MockDisbursementStore{
+				GetEntryByIdFunc: scheduledEntry,
+				MarkEntryPaidFunc: func(entry disbursement.Entry, payload disbursement.MarkPaidRequest, proofFiles []*multipart.FileHeader) error {
+					if entry.Id != 5 || payload.BankTransferRef != "REF-2" || len(proofFiles) != 1 {
+						t.Errorf("unexpected mark paid params: entry %d, ref %s, files %d", entry.Id, payload.BankTransferRef, len(proofFiles))
+					}
+					return nil
+				},
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := disbursement.NewDisbursementHandler(tt.store)
+			req := newMultipartRequest(t, http.MethodPut, "/admin/disbursement/entry/"+tt.entryId+"/paid", tt.payload, tt.files)
+			req = withURLParam(req, "entryId", tt.entryId)
+			res := httptest.NewRecorder()
+
+			handler.MarkEntryPaid(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				assertErrorMessage(t, getErrorResponse(t, res).Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
+
+func TestGenerateReconciliationReport(t *testing.T) {
+	tests := []struct {
+		name           string
+		payload        disbursement.ReconciliationReportRequest
+		expectedStatus int
+		expectedError  error
+	}{
+		{
+			name:           "should error when fromYear is missing",
+			payload:        disbursement.ReconciliationReportRequest{FromMonth: 1, FromDay: 1, ToYear: 2026, ToMonth: 1, ToDay: 31},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &disbursement.FromYearRequiredError{},
+		},
+		{
+			name:           "should error when month is out of range",
+			payload:        disbursement.ReconciliationReportRequest{FromYear: 2026, FromMonth: 13, FromDay: 1, ToYear: 2026, ToMonth: 12, ToDay: 31},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &disbursement.MonthOutOfBoundError{},
+		},
+		{
+			name:           "should error when day does not exist in the month",
+			payload:        disbursement.ReconciliationReportRequest{FromYear: 2026, FromMonth: 1, FromDay: 1, ToYear: 2026, ToMonth: 2, ToDay: 30},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &disbursement.DayOutOfBoundError{},
+		},
+		{
+			name:           "should error when fromDate is after toDate",
+			payload:        disbursement.ReconciliationReportRequest{FromYear: 2026, FromMonth: 6, FromDay: 1, ToYear: 2026, ToMonth: 5, ToDay: 31},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &disbursement.FromDateExceedToDateError{},
+		},
+		{
+			name:           "should generate a report for a single day",
+			payload:        disbursement.ReconciliationReportRequest{FromYear: 2026, FromMonth: 2, FromDay: 28, ToYear: 2026, ToMonth: 2, ToDay: 28},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFrom, gotTo time.Time
+			store := &mock.MockDisbursementStore{
+				GenerateReconciliationReportFunc: func(fromDate, toDate time.Time) (*bytes.Buffer, error) {
+					gotFrom, gotTo = fromDate, toDate
+					return bytes.NewBufferString("csv"), nil
+				},
+			}
+			handler := disbursement.NewDisbursementHandler(store)
+			body, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/disbursement/report", bytes.NewReader(body))
+
+			handler.GenerateReconciliationReport(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				assertErrorMessage(t, getErrorResponse(t, res).Message, tt.expectedError.Error())
+				return
+			}
+			if gotTo.Sub(gotFrom) != 24*time.Hour {
+				t.Errorf("got range %v - %v, want one day", gotFrom, gotTo)
+			}
+		})
+	}
+}
+
+func getErrorResponse(t testing.TB, res *httptest.ResponseRecorder) ErrorBody {
+	t.Helper()
+	var body ErrorBody
+	err := json.Unmarshal(res.Body.Bytes(), &body)
+	if err != nil {
+		t.Fatalf("Unable to parse response from server %q into ErrorBody, '%v'", res.Body, err)
+	}
+	return body
+}
+
+func assertErrorMessage(t testing.TB, got, want string) {
+	t.Helper()
+	if got != want {
+		t.Errorf("did not get correct error, got %v, want %v", got, want)
+	}
+}
+
+func assertStatus(t testing.TB, got, want int) {
+	t.Helper()
+	if got != want {
+		t.Errorf("did not get correct status, got %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,75 @@
+package disbursement
+
+import "time"
+
+type AddEntryRequest struct {
+	EntryType       string     `json:"entryType,omitempty"`
+	Amount          int64      `json:"amount,omitempty"`
+	InstallmentNo   *int       `json:"installmentNo,omitempty"`
+	ScheduledDate   *time.Time `json:"scheduledDate,omitempty"`
+	PaidAt          *time.Time `json:"paidAt,omitempty"`
+	BankTransferRef *string    `json:"bankTransferRef,omitempty"`
+	Note            *string    `json:"note,omitempty"`
+}
+
+type MarkPaidRequest struct {
+	PaidAt          *time.Time `json:"paidAt,omitempty"`
+	BankTransferRef string     `json:"bankTransferRef,omitempty"`
+	Note            *string    `json:"note,omitempty"`
+}
+
+type ReconciliationReportRequest struct {
+	FromYear  int `json:"fromYear,omitempty"`
+	FromMonth int `json:"fromMonth,omitempty"`
+	FromDay   int `json:"fromDay,omitempty"`
+	ToYear    int `json:"toYear,omitempty"`
+	ToMonth   int `json:"toMonth,omitempty"`
+	ToDay     int `json:"toDay,omitempty"`
+}
+
+type Entry struct {
+	Id              int        `json:"id"`
+	ProjectCode     string     `json:"projectCode"`
+	EntryType       string     `json:"entryType"`
+	Status          string     `json:"status"`
+	Amount          int64      `json:"amount"`
+	InstallmentNo   *int       `json:"installmentNo,omitempty"`
+	ScheduledDate   *time.Time `json:"scheduledDate,omitempty"`
+	PaidAt          *time.Time `json:"paidAt,omitempty"`
+	BankTransferRef *string    `json:"bankTransferRef,omitempty"`
+	Note            *string    `json:"note,omitempty"`
+	FilesPrefix     string     `json:"filesPrefix"`
+	CreatedBy       int        `json:"createdBy"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+type Balance struct {
+	FundApprovedAmount int64 `json:"fundApprovedAmount"`
+	ScheduledAmount    int64 `json:"scheduledAmount"`
+	PaidAmount         int64 `json:"paidAmount"`
+	RefundedAmount     int64 `json:"refundedAmount"`
+	NetPaidAmount      int64 `json:"netPaidAmount"`
+	OutstandingAmount  int64 `json:"outstandingAmount"`
+}
+
+type Ledger struct {
+	ProjectCode string  `json:"projectCode"`
+	Balance     Balance `json:"balance"`
+	Entries     []Entry `json:"entries"`
+}
+
+type LedgerProject struct {
+	ProjectCode        string
+	ProjectStatus      string
+	FundApprovedAmount *int64
+}
+
+type ReconciliationRow struct {
+	ProjectCode        string
+	ProjectName        string
+	FundApprovedAmount *int64
+	ScheduledAmount    int64
+	PaidAmount         int64
+	RefundedAmount     int64
+}
@@ -0,0 +1,62 @@
+package disbursement
+
+const getLedgerProjectSQL = `
+SELECT project.project_code, project_history.status, project_history.fund_approved_amount
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+WHERE project.project_code = $1;
+`
+
+// lockLedgerProjectSQL holds the project row until the entry is committed so concurrent entries see each other
+const lockLedgerProjectSQL = `
+SELECT project.project_code, project_history.status, project_history.fund_approved_amount
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+WHERE project.project_code = $1
+FOR UPDATE OF project;
+`
+
+const getEntriesByProjectCodeSQL = `
+SELECT id, project_code, entry_type, status, amount, installment_no, scheduled_date, paid_at,
+bank_transfer_ref, note, files_prefix, created_by, created_at, updated_at
+FROM disbursement
+WHERE project_code = $1
+ORDER BY COALESCE(paid_at, scheduled_date) ASC, id ASC;
+`
+
+const getEntryByIdSQL = `
+SELECT id, project_code, entry_type, status, amount, installment_no, scheduled_date, paid_at,
+bank_transfer_ref, note, files_prefix, created_by, created_at, updated_at
+FROM disbursement
+WHERE id = $1;
+`
+
+const insertEntrySQL = `
+INSERT INTO disbursement
+(project_code, entry_type, status, amount, installment_no, scheduled_date, paid_at, bank_transfer_ref, note, files_prefix, created_by, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $12) RETURNING id;
+`
+
+const markEntryPaidSQL = `
+UPDATE disbursement
+SET status = 'Paid', paid_at = $2, bank_transfer_ref = $3, note = COALESCE($4, note), updated_at = $5
+WHERE id = $1 AND status = 'Scheduled'
+RETURNING id;
+`
+
+const getReconciliationSQL = `
+SELECT
+project.project_code,
+project_history.project_name,
+project_history.fund_approved_amount,
+COALESCE(SUM(disbursement.amount) FILTER (WHERE disbursement.entry_type = 'Installment' AND disbursement.status = 'Scheduled'), 0) as scheduled_amount,
+COALESCE(SUM(disbursement.amount) FILTER (WHERE disbursement.entry_type = 'Installment' AND disbursement.status = 'Paid'), 0) as paid_amount,
+COALESCE(SUM(disbursement.amount) FILTER (WHERE disbursement.entry_type = 'Refund'), 0) as refunded_amount
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+LEFT JOIN disbursement ON disbursement.project_code = project.project_code
+WHERE project.created_at >= $1 AND project.created_at < $2
+AND (project_history.fund_approved_amount IS NOT NULL OR disbursement.id IS NOT NULL)
+GROUP BY project.project_code, project_history.project_name, project_history.fund_approved_amount
+ORDER BY project.project_code ASC;
+`
@@ -0,0 +1,294 @@
+package disbursement
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime/multipart"
+	"os"
+	"time"
+
+	myCsv "github.com/poomipat-k/running-fund/pkg/csv-app"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+type store struct {
+	db           *sql.DB
+	awsS3Service s3Service.S3Service
+}
+
+func NewStore(db *sql.DB, awsS3Service s3Service.S3Service) *store {
+	return &store{
+		db:           db,
+		awsS3Service: awsS3Service,
+	}
+}
+
+func (s *store) GetLedgerProject(projectCode string) (LedgerProject, error) {
+	var p LedgerProject
+	err := s.db.QueryRow(getLedgerProjectSQL, projectCode).Scan(&p.ProjectCode, &p.ProjectStatus, &p.FundApprovedAmount)
+	switch err {
+	case sql.ErrNoRows:
+		return LedgerProject{}, &ProjectNotFoundError{}
+	case nil:
+		return p, nil
+	default:
+		slog.Error(err.Error())
+		return LedgerProject{}, err
+	}
+}
+
+func (s *store) GetLedger(project LedgerProject) (Ledger, error) {
+	entries, err := getEntries(s.db, project.ProjectCode)
+	if err != nil {
+		return Ledger{}, err
+	}
+	return Ledger{
+		ProjectCode: project.ProjectCode,
+		Balance:     calculateBalance(project.FundApprovedAmount, entries),
+		Entries:     entries,
+	}, nil
+}
+
+type queryer interface {
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+func getEntries(db queryer, projectCode string) ([]Entry, error) {
+	rows, err := db.Query(getEntriesByProjectCodeSQL, projectCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []Entry{}
+	for rows.Next() {
+		row, err := scanEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// AddEntry checks the balance again with the project locked, the handler check alone can race another entry
+func (s *store) AddEntry(projectCode string, payload AddEntryRequest, adminId int, proofFiles []*multipart.FileHeader) (int, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var project LedgerProject
+	err = tx.QueryRowContext(ctx, lockLedgerProjectSQL, projectCode).Scan(&project.ProjectCode, &project.ProjectStatus, &project.FundApprovedAmount)
+	if err == sql.ErrNoRows {
+		return 0, &ProjectNotFoundError{}
+	}
+	if err != nil {
+		return 0, err
+	}
+	entries, err := getEntries(tx, projectCode)
+	if err != nil {
+		return 0, err
+	}
+	_, err = validateBalance(payload, calculateBalance(project.FundApprovedAmount, entries))
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	status := "Scheduled"
+	if payload.PaidAt != nil {
+		status = "Paid"
+	}
+	filesPrefix := fmt.Sprintf("disbursement/%s/%d", projectCode, now.UnixNano())
+	var id int
+	err = tx.QueryRowContext(
+		ctx,
+		insertEntrySQL,
+		projectCode,
+		payload.EntryType,
+		status,
+		payload.Amount,
+		payload.InstallmentNo,
+		payload.ScheduledDate,
+		payload.PaidAt,
+		payload.BankTransferRef,
+		payload.Note,
+		filesPrefix,
+		adminId,
+		now,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.awsS3Service.UploadFilesToS3(proofFiles, os.Getenv("AWS_S3_STORE_BUCKET_NAME"), filesPrefix)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+	slog.Info("success adding a disbursement entry", "projectCode", projectCode, "entryId", id)
+	return id, nil
+}
+
+func (s *store) GetEntryById(entryId int) (Entry, error) {
+	row := s.db.QueryRow(getEntryByIdSQL, entryId)
+	entry, err := scanEntry(row)
+	if err == sql.ErrNoRows {
+		return Entry{}, &EntryNotFoundError{}
+	}
+	if err != nil {
+		return Entry{}, err
+	}
+	return entry, nil
+}
+
+func (s *store) MarkEntryPaid(entry Entry, payload MarkPaidRequest, proofFiles []*multipart.FileHeader) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var id int
+	err = tx.QueryRowContext(ctx, markEntryPaidSQL, entry.Id, payload.PaidAt, payload.BankTransferRef, payload.Note, time.Now()).Scan(&id)
+	if err == sql.ErrNoRows {
+		return &EntryAlreadyPaidError{}
+	}
+	if err != nil {
+		return err
+	}
+
+	err = s.awsS3Service.UploadFilesToS3(proofFiles, os.Getenv("AWS_S3_STORE_BUCKET_NAME"), entry.FilesPrefix)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	slog.Info("success marking a disbursement entry paid", "entryId", entry.Id)
+	return nil
+}
+
+func (s *store) GenerateReconciliationReport(fromDate, toDate time.Time) (*bytes.Buffer, error) {
+	rows, err := s.db.Query(getReconciliationSQL, fromDate, toDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := [][]string{
+		{"ลำดับ", "รหัสโครงการ", "ชื่อโครงการ", "จำนวนเงินที่อนุมัติ", "รอโอน", "โอนแล้ว", "คืนเงิน", "คงเหลือ"},
+	}
+	count := 1
+	for rows.Next() {
+		var row ReconciliationRow
+		err = rows.Scan(
+			&row.ProjectCode,
+			&row.ProjectName,
+			&row.FundApprovedAmount,
+			&row.ScheduledAmount,
+			&row.PaidAmount,
+			&row.RefundedAmount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		var approved int64
+		if row.FundApprovedAmount != nil {
+			approved = *row.FundApprovedAmount
+		}
+		outstanding := approved - (row.PaidAmount - row.RefundedAmount)
+		items = append(items, []string{
+			fmt.Sprint(count),
+			row.ProjectCode,
+			row.ProjectName,
+			fmt.Sprint(approved),
+			fmt.Sprint(row.ScheduledAmount),
+			fmt.Sprint(row.PaidAmount),
+			fmt.Sprint(row.RefundedAmount),
+			fmt.Sprint(outstanding),
+		})
+		count++
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	buffer, err := myCsv.GenCsvBuffer(items)
+	if err != nil {
+		return nil, err
+	}
+	if buffer == nil {
+		return nil, errors.New("buffer cannot be nil")
+	}
+	return buffer, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanEntry(row rowScanner) (Entry, error) {
+	var e Entry
+	err := row.Scan(
+		&e.Id,
+		&e.ProjectCode,
+		&e.EntryType,
+		&e.Status,
+		&e.Amount,
+		&e.InstallmentNo,
+		&e.ScheduledDate,
+		&e.PaidAt,
+		&e.BankTransferRef,
+		&e.Note,
+		&e.FilesPrefix,
+		&e.CreatedBy,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+	)
+	return e, err
+}
+
+func calculateBalance(fundApprovedAmount *int64, entries []Entry) Balance {
+	var b Balance
+	if fundApprovedAmount != nil {
+		b.FundApprovedAmount = *fundApprovedAmount
+	}
+	for _, e := range entries {
+		if e.EntryType == "Refund" {
+			b.RefundedAmount += e.Amount
+			continue
+		}
+		if e.Status == "Paid" {
+			b.PaidAmount += e.Amount
+		} else {
+			b.ScheduledAmount += e.Amount
+		}
+	}
+	b.NetPaidAmount = b.PaidAmount - b.RefundedAmount
+	b.OutstandingAmount = b.FundApprovedAmount - b.NetPaidAmount
+	return b
+}
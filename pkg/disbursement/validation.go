@@ -0,0 +1,118 @@
+package disbursement
+
+import (
+	"mime/multipart"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const NOTE_MAX_LENGTH = 512
+
+const minReportYear = 2023
+
+var ENTRY_TYPE = map[string]bool{
+	"Installment": true,
+	"Refund":      true,
+}
+
+// Projects with a fund that can be paid out
+var FUNDED_STATUS = map[string]bool{
+	"Approved":  true,
+	"Start":     true,
+	"Completed": true,
+	"Cancelled": true,
+}
+
+func validateAddEntryPayload(payload AddEntryRequest, proofFiles []*multipart.FileHeader) (string, error) {
+	if !ENTRY_TYPE[payload.EntryType] {
+		return "entryType", &EntryTypeInvalidError{}
+	}
+	if payload.Amount <= 0 {
+		return "amount", &AmountInvalidError{}
+	}
+	// a refund is always recorded after the money is received back
+	if payload.EntryType == "Refund" || payload.PaidAt != nil {
+		if payload.PaidAt == nil {
+			return "paidAt", &PaidAtRequiredError{}
+		}
+		if payload.BankTransferRef == nil || strings.TrimSpace(*payload.BankTransferRef) == "" {
+			return "bankTransferRef", &BankTransferRefRequiredError{}
+		}
+		if len(proofFiles) == 0 {
+			return "proofFiles", &ProofFilesRequiredError{}
+		}
+	} else if payload.ScheduledDate == nil {
+		return "scheduledDate", &ScheduledDateRequiredError{}
+	}
+	if payload.Note != nil && utf8.RuneCountInString(*payload.Note) > NOTE_MAX_LENGTH {
+		return "note", &NoteTooLongError{utf8.RuneCountInString(*payload.Note)}
+	}
+	return "", nil
+}
+
+func validateMarkPaidPayload(payload MarkPaidRequest, proofFiles []*multipart.FileHeader) (string, error) {
+	if payload.PaidAt == nil {
+		return "paidAt", &PaidAtRequiredError{}
+	}
+	if strings.TrimSpace(payload.BankTransferRef) == "" {
+		return "bankTransferRef", &BankTransferRefRequiredError{}
+	}
+	if payload.Note != nil && utf8.RuneCountInString(*payload.Note) > NOTE_MAX_LENGTH {
+		return "note", &NoteTooLongError{utf8.RuneCountInString(*payload.Note)}
+	}
+	if len(proofFiles) == 0 {
+		return "proofFiles", &ProofFilesRequiredError{}
+	}
+	return "", nil
+}
+
+// validateReconciliationReportPayload follows validateFormDateToDate of the admin reports
+func validateReconciliationReportPayload(payload ReconciliationReportRequest, loc *time.Location) (string, error) {
+	if payload.FromYear < minReportYear {
+		return "fromYear", &FromYearRequiredError{}
+	}
+	if payload.FromMonth < 1 || payload.FromMonth > 12 {
+		return "fromMonth", &MonthOutOfBoundError{}
+	}
+	if !isValidDate(payload.FromYear, payload.FromMonth, payload.FromDay, loc) {
+		return "fromDay", &DayOutOfBoundError{}
+	}
+	if payload.ToYear < minReportYear {
+		return "toYear", &ToYearRequiredError{}
+	}
+	if payload.ToMonth < 1 || payload.ToMonth > 12 {
+		return "toMonth", &MonthOutOfBoundError{}
+	}
+	if !isValidDate(payload.ToYear, payload.ToMonth, payload.ToDay, loc) {
+		return "toDay", &DayOutOfBoundError{}
+	}
+	fromDate := time.Date(payload.FromYear, time.Month(payload.FromMonth), payload.FromDay, 0, 0, 0, 0, loc)
+	toDate := time.Date(payload.ToYear, time.Month(payload.ToMonth), payload.ToDay, 0, 0, 0, 0, loc)
+	if fromDate.After(toDate) {
+		return "fromDate", &FromDateExceedToDateError{}
+	}
+	return "", nil
+}
+
+func isValidDate(year, month, day int, loc *time.Location) bool {
+	if day < 1 {
+		return false
+	}
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc).Day() == day
+}
+
+// validateBalance checks the new entry against the current ledger balance
+func validateBalance(payload AddEntryRequest, balance Balance) (string, error) {
+	if payload.EntryType == "Installment" {
+		remaining := balance.FundApprovedAmount - balance.ScheduledAmount - balance.PaidAmount
+		if payload.Amount > remaining {
+			return "amount", &ExceedApprovedAmountError{Remaining: remaining}
+		}
+		return "", nil
+	}
+	if payload.Amount > balance.NetPaidAmount {
+		return "amount", &ExceedNetPaidAmountError{NetPaid: balance.NetPaidAmount}
+	}
+	return "", nil
+}
@@ -107,3 +107,61 @@ func (es *EmailService) BuildResetPasswordEmail(to, resetPasswordLink string) em
 	}
 	return mail
 }
+
+func (es *EmailService) BuildProjectMessageEmail(to []string, projectCode, projectName, messageLink string) email.Email {
+	html := fmt.Sprintf(`<p>เรียน ผู้เกี่ยวข้องกับโครงการ %s</p>
+	<br>
+	<p>มีข้อความใหม่ในโครงการ "%s"</p>
+	<p>กรุณากดลิงก์เพื่ออ่านข้อความ <a href="%s">%s</a></p>
+	<br>
+	<p>ขอแสดงความนับถือ</p>
+	<p>ผู้ดูแลระบบ</p>
+	<p>มูลนิธิสมาพันธ์ชมรมเดิน-วิ่งเพื่อสุขภาพไทย</p>
+	`, projectCode, projectName, messageLink, messageLink)
+	text := fmt.Sprintf(`เรียน ผู้เกี่ยวข้องกับโครงการ %s
+
+	มีข้อความใหม่ในโครงการ "%s"
+	กรุณากดลิงก์เพื่ออ่านข้อความ %s
+
+	ขอแสดงความนับถือ
+	ผู้ดูแลระบบ
+	มูลนิธิสมาพันธ์ชมรมเดิน-วิ่งเพื่อสุขภาพไทย`, projectCode, projectName, messageLink)
+
+	mail := email.Email{
+		From:    os.Getenv("EMAIL_SENDER"),
+		To:      to,
+		Subject: fmt.Sprintf("ข้อความใหม่ในโครงการ %s", projectCode),
+		Text:    []byte(text),
+		HTML:    []byte(html),
+	}
+	return mail
+}
+
+func (es *EmailService) BuildReviseReminderEmail(to, projectCode, projectName, dueDate, projectLink string) email.Email {
+	html := fmt.Sprintf(`<p>เรียน ผู้เสนอโครงการ %s</p>
+	<br>
+	<p>โครงการ "%s" อยู่ระหว่างการแก้ไขตามคำขอของเจ้าหน้าที่ กรุณาส่งการแก้ไขภายใน <b>%s</b></p>
+	<p>รายละเอียดโครงการ <a href="%s">%s</a></p>
+	<br>
+	<p>ขอแสดงความนับถือ</p>
+	<p>ผู้ดูแลระบบ</p>
+	<p>มูลนิธิสมาพันธ์ชมรมเดิน-วิ่งเพื่อสุขภาพไทย</p>
+	`, projectCode, projectName, dueDate, projectLink, projectLink)
+	text := fmt.Sprintf(`เรียน ผู้เสนอโครงการ %s
+
+	โครงการ "%s" อยู่ระหว่างการแก้ไขตามคำขอของเจ้าหน้าที่ กรุณาส่งการแก้ไขภายใน %s
+	รายละเอียดโครงการ %s
+
+	ขอแสดงความนับถือ
+	ผู้ดูแลระบบ
+	มูลนิธิสมาพันธ์ชมรมเดิน-วิ่งเพื่อสุขภาพไทย`, projectCode, projectName, dueDate, projectLink)
+
+	mail := email.Email{
+		From:    os.Getenv("EMAIL_SENDER"),
+		To:      []string{to},
+		Subject: fmt.Sprintf("แจ้งเตือนกำหนดส่งการแก้ไขโครงการ %s", projectCode),
+		Text:    []byte(text),
+		HTML:    []byte(html),
+	}
+	return mail
+}
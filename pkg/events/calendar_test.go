@@ -0,0 +1,173 @@
+package events_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/events"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+)
+
+func newString(v string) *string {
+	return &v
+}
+
+func calendarEvent() events.CalendarEvent {
+	return events.CalendarEvent{
+		ProjectCode:     "CR69_1001_01",
+		ProjectName:     "วิ่งเพื่อสุขภาพ, ครั้งที่ 3; เช้าวันอาทิตย์ ริมแม่น้ำเจ้าพระยา จังหวัดนนทบุรี",
+		ProjectStatus:   "Approved",
+		FromDate:        time.Date(2026, 11, 1, 5, 0, 0, 0, time.FixedZone("ICT", 7*3600)),
+		ToDate:          time.Date(2026, 11, 1, 10, 0, 0, 0, time.FixedZone("ICT", 7*3600)),
+		Address:         newString("สวนสาธารณะ"),
+		SubdistrictName: newString("บางกระสอ"),
+		DistrictName:    newString("เมืองนนทบุรี"),
+		ProvinceName:    newString("นนทบุรี"),
+		UpdatedAt:       time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// unfold reverses RFC 5545 line folding
+func unfold(body string) []string {
+	return strings.Split(strings.ReplaceAll(body, "\r\n ", ""), "\r\n")
+}
+
+func TestGetCalendarFeed(t *testing.T) {
+	tests := []struct {
+		name            string
+		url             string
+		store           *mock.MockEventStore
+		expectedStatus  int
+		expectedFilter  *events.CalendarFilter
+		expectedLines   []string
+		unexpectedLines []string
+	}{
+		{
+			name: "should reject an unknown token",
+			url:  "/calendar/abc.ics",
+			store: &mock.MockEventStore{
+				GetCalendarFeedUserIdFunc: func(token string) (int, error) {
+					return 0, &events.CalendarFeedTokenInvalidError{}
+				},
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "should reject a status that is not published",
+			url:  "/calendar/abc.ics?status=Reviewing",
+			store: &mock.MockEventStore{
+				GetCalendarFeedUserIdFunc: func(token string) (int, error) { return 1, nil },
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "should reject an invalid province",
+			url:  "/calendar/abc.ics?provinceId=x",
+			store: &mock.MockEventStore{
+				GetCalendarFeedUserIdFunc: func(token string) (int, error) { return 1, nil },
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "should render events as VEVENTs",
+			url:  "/calendar/abc.ics?provinceId=22&provinceId=3&status=Start",
+			store: &mock.MockEventStore{
+				GetCalendarFeedUserIdFunc: func(token string) (int, error) { return 1, nil },
+				GetCalendarEventsFunc: func(filter events.CalendarFilter, now time.Time) ([]events.CalendarEvent, error) {
+					return []events.CalendarEvent{calendarEvent()}, nil
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedFilter: &events.CalendarFilter{ProvinceIds: []int{22, 3}, Statuses: []string{"Start"}},
+			expectedLines: []string{
+				"BEGIN:VCALENDAR",
+				"VERSION:2.0",
+				"BEGIN:VEVENT",
+				"UID:CR69_1001_01@running-fund",
+				"DTSTART:20261031T220000Z",
+				"DTEND:20261101T030000Z",
+				`SUMMARY:วิ่งเพื่อสุขภาพ\, ครั้งที่ 3\; เช้าวันอาทิตย์ ริมแม่น้ำเจ้าพระยา จังหวัดนนทบุรี`,
+				`LOCATION:สวนสาธารณะ\, บางกระสอ\, เมืองนนทบุรี\, นนทบุรี`,
+				"END:VEVENT",
+				"END:VCALENDAR",
+			},
+		},
+		{
+			name: "should leave out DTEND when the event has no duration",
+			url:  "/calendar/abc.ics",
+			store: &mock.MockEventStore{
+				GetCalendarFeedUserIdFunc: func(token string) (int, error) { return 1, nil },
+				GetCalendarEventsFunc: func(filter events.CalendarFilter, now time.Time) ([]events.CalendarEvent, error) {
+					e := calendarEvent()
+					e.ToDate = e.FromDate
+					return []events.CalendarEvent{e}, nil
+				},
+			},
+			expectedStatus:  http.StatusOK,
+			expectedFilter:  &events.CalendarFilter{ProvinceIds: []int{}, Statuses: []string{"Approved", "Start"}},
+			expectedLines:   []string{"DTSTART:20261031T220000Z"},
+			unexpectedLines: []string{"DTEND:20261101T030000Z", "DTEND:20261031T220000Z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFilter events.CalendarFilter
+			if tt.store.GetCalendarEventsFunc != nil {
+				getEvents := tt.store.GetCalendarEventsFunc
+				tt.store.GetCalendarEventsFunc = func(filter events.CalendarFilter, now time.Time) ([]events.CalendarEvent, error) {
+					gotFilter = filter
+					return getEvents(filter, now)
+				}
+			}
+			handler := events.NewEventHandler(tt.store)
+
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("token", "abc")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			handler.GetCalendarFeed(res, req)
+			if res.Code != tt.expectedStatus {
+				t.Fatalf("got status %d want %d, body %s", res.Code, tt.expectedStatus, res.Body.String())
+			}
+			if tt.expectedFilter != nil && !reflect.DeepEqual(gotFilter, *tt.expectedFilter) {
+				t.Errorf("got filter %+v want %+v", gotFilter, *tt.expectedFilter)
+			}
+			if res.Code != http.StatusOK {
+				return
+			}
+			if got := res.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/calendar") {
+				t.Errorf("got Content-Type %q want text/calendar", got)
+			}
+			body := res.Body.String()
+			for _, line := range strings.Split(body, "\r\n") {
+				if len(line) > 75 {
+					t.Errorf("line is longer than 75 octets: %q", line)
+				}
+			}
+			lines := unfold(body)
+			has := map[string]bool{}
+			for _, line := range lines {
+				has[line] = true
+			}
+			for _, want := range tt.expectedLines {
+				if !has[want] {
+					t.Errorf("missing line %q in\n%s", want, strings.Join(lines, "\n"))
+				}
+			}
+			for _, unwanted := range tt.unexpectedLines {
+				if has[unwanted] {
+					t.Errorf("unexpected line %q", unwanted)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package events
+
+import "fmt"
+
+type StatusNotPublishedError struct {
+	Status string
+}
+
+func (e *StatusNotPublishedError) Error() string {
+	return fmt.Sprintf("status %s is not published", e.Status)
+}
+
+type ProvinceIdInvalidError struct{}
+
+func (e *ProvinceIdInvalidError) Error() string {
+	return "provinceId must be a positive number"
+}
+
+type TooManyFilterValuesError struct {
+	Name string
+}
+
+func (e *TooManyFilterValuesError) Error() string {
+	return fmt.Sprintf("%s must not have more than %d values", e.Name, FILTER_MAX_VALUES)
+}
+
+type CalendarFeedTokenInvalidError struct{}
+
+func (e *CalendarFeedTokenInvalidError) Error() string {
+	return "calendar feed token is invalid"
+}
+
+type CategoryInvalidError struct {
+	Category string
+}
+
+func (e *CategoryInvalidError) Error() string {
+	return fmt.Sprintf("category %s is invalid", e.Category)
+}
+
+type DateInvalidError struct {
+	Name string
+}
+
+func (e *DateInvalidError) Error() string {
+	return fmt.Sprintf("%s must be a date in YYYY-MM-DD format", e.Name)
+}
+
+type DateRangeInvalidError struct{}
+
+func (e *DateRangeInvalidError) Error() string {
+	return "to must not be before from"
+}
+
+type PageInvalidError struct{}
+
+func (e *PageInvalidError) Error() string {
+	return "page must be a positive number"
+}
+
+type PageSizeInvalidError struct{}
+
+func (e *PageSizeInvalidError) Error() string {
+	return fmt.Sprintf("pageSize must be between 1 and %d", PUBLIC_EVENTS_PAGE_SIZE_MAX)
+}
+
+type MapLevelInvalidError struct{}
+
+func (e *MapLevelInvalidError) Error() string {
+	return "level must be province or district"
+}
+
+type YearInvalidError struct{}
+
+func (e *YearInvalidError) Error() string {
+	return "year is invalid"
+}
+
+type StatusInvalidError struct {
+	Status string
+}
+
+func (e *StatusInvalidError) Error() string {
+	return fmt.Sprintf("status %s is invalid", e.Status)
+}
@@ -0,0 +1,137 @@
+package events_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/poomipat-k/running-fund/pkg/events"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+)
+
+func newFloat64(v float64) *float64 {
+	return &v
+}
+
+func TestGetEventMap(t *testing.T) {
+	year := 2026
+	tests := []struct {
+		name             string
+		url              string
+		areas            []events.EventMapArea
+		expectedStatus   int
+		expectedFilter   *events.EventMapFilter
+		expectedFeatures []events.Feature
+	}{
+		{
+			name:           "should reject an unknown level",
+			url:            "/admin/map?level=subdistrict",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "should reject an invalid year",
+			url:            "/admin/map?year=abc",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "should reject an unknown status",
+			url:            "/admin/map?status=Done",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "should aggregate by province with funded statuses by default",
+			url:            "/admin/map",
+			expectedStatus: http.StatusOK,
+			expectedFilter: &events.EventMapFilter{Level: "province", Statuses: []string{"Approved", "Start", "Completed"}},
+			areas: []events.EventMapArea{
+				{Id: 2, Name: "กรุงเทพมหานคร", ProvinceName: "กรุงเทพมหานคร", Latitude: newFloat64(13.7563), Longitude: newFloat64(100.5018), ProjectCount: 3, FundApprovedTotal: 150000},
+			},
+			expectedFeatures: []events.Feature{
+				{
+					Type:       "Feature",
+					Id:         2,
+					Geometry:   &events.Point{Type: "Point", Coordinates: [2]float64{100.5018, 13.7563}},
+					Properties: events.FeatureProperties{Level: "province", Name: "กรุงเทพมหานคร", ProjectCount: 3, FundApprovedTotal: 150000},
+				},
+			},
+		},
+		{
+			name:           "should flag districts placed on their province as approximate",
+			url:            "/admin/map?level=district",
+			expectedStatus: http.StatusOK,
+			expectedFilter: &events.EventMapFilter{Level: "district", Statuses: []string{"Approved", "Start", "Completed"}},
+			areas: []events.EventMapArea{
+				{Id: 9, Name: "คลองท่อม", ProvinceName: "กระบี่", Latitude: newFloat64(8.0863), Longitude: newFloat64(98.9063), Approximate: true, ProjectCount: 1},
+				{Id: 10, Name: "อ่าวลึก", ProvinceName: "กระบี่", Latitude: newFloat64(8.3747), Longitude: newFloat64(98.7317), ProjectCount: 2},
+			},
+			expectedFeatures: []events.Feature{
+				{
+					Type:       "Feature",
+					Id:         9,
+					Geometry:   &events.Point{Type: "Point", Coordinates: [2]float64{98.9063, 8.0863}},
+					Properties: events.FeatureProperties{Level: "district", Name: "คลองท่อม", ProvinceName: "กระบี่", ProjectCount: 1, Approximate: true},
+				},
+				{
+					Type:       "Feature",
+					Id:         10,
+					Geometry:   &events.Point{Type: "Point", Coordinates: [2]float64{98.7317, 8.3747}},
+					Properties: events.FeatureProperties{Level: "district", Name: "อ่าวลึก", ProvinceName: "กระบี่", ProjectCount: 2},
+				},
+			},
+		},
+		{
+			name:           "should keep districts without coordinates with a null geometry",
+			url:            "/admin/map?level=district&year=2026&status=Reviewing",
+			expectedStatus: http.StatusOK,
+			expectedFilter: &events.EventMapFilter{Level: "district", Year: &year, Statuses: []string{"Reviewing"}},
+			areas: []events.EventMapArea{
+				{Id: 9, Name: "คลองท่อม", ProvinceName: "กระบี่", ProjectCount: 1},
+			},
+			expectedFeatures: []events.Feature{
+				{
+					Type:       "Feature",
+					Id:         9,
+					Properties: events.FeatureProperties{Level: "district", Name: "คลองท่อม", ProvinceName: "กระบี่", ProjectCount: 1},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFilter events.EventMapFilter
+			store := &mock.MockEventStore{
+				GetEventMapFunc: func(filter events.EventMapFilter) ([]events.EventMapArea, error) {
+					gotFilter = filter
+					return tt.areas, nil
+				},
+			}
+			handler := events.NewEventHandler(store)
+			res := httptest.NewRecorder()
+			handler.GetEventMap(res, httptest.NewRequest(http.MethodGet, tt.url, nil))
+
+			if res.Code != tt.expectedStatus {
+				t.Fatalf("got status %d want %d, body %s", res.Code, tt.expectedStatus, res.Body.String())
+			}
+			if tt.expectedFilter == nil {
+				return
+			}
+			if !reflect.DeepEqual(gotFilter, *tt.expectedFilter) {
+				t.Errorf("got filter %+v want %+v", gotFilter, *tt.expectedFilter)
+			}
+			var got events.FeatureCollection
+			err := json.Unmarshal(res.Body.Bytes(), &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Type != "FeatureCollection" {
+				t.Errorf("got type %q want FeatureCollection", got.Type)
+			}
+			if !reflect.DeepEqual(got.Features, tt.expectedFeatures) {
+				t.Errorf("got features %+v want %+v", got.Features, tt.expectedFeatures)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package events
+
+// toFeatureCollection turns map areas into point features, areas without coordinates keep a null geometry
+func toFeatureCollection(level string, areas []EventMapArea) FeatureCollection {
+	collection := FeatureCollection{Type: "FeatureCollection", Features: []Feature{}}
+	for _, a := range areas {
+		f := Feature{
+			Type: "Feature",
+			Id:   a.Id,
+			Properties: FeatureProperties{
+				Level:             level,
+				Name:              a.Name,
+				ProjectCount:      a.ProjectCount,
+				FundApprovedTotal: a.FundApprovedTotal,
+			},
+		}
+		if level == "district" {
+			f.Properties.ProvinceName = a.ProvinceName
+			f.Properties.Approximate = a.Approximate
+		}
+		if a.Latitude != nil && a.Longitude != nil {
+			f.Geometry = &Point{Type: "Point", Coordinates: [2]float64{*a.Longitude, *a.Latitude}}
+		}
+		collection.Features = append(collection.Features, f)
+	}
+	return collection
+}
@@ -0,0 +1,151 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+const calendarName = "โครงการวิ่งเพื่อสุขภาพ"
+
+// PUBLIC_EVENTS_MAX_AGE is how long browsers and proxies may reuse a public listing, in seconds
+const PUBLIC_EVENTS_MAX_AGE = 300
+
+type EventStore interface {
+	GetCalendarEvents(filter CalendarFilter, now time.Time) ([]CalendarEvent, error)
+	GetCalendarFeedUserId(token string) (int, error)
+	GetCalendarFeedToken(userId int) (string, error)
+	RotateCalendarFeedToken(userId int) (string, error)
+	GetPublicEvents(filter PublicEventFilter) (PublicEventPage, error)
+	GetEventMap(filter EventMapFilter) ([]EventMapArea, error)
+}
+
+type EventHandler struct {
+	store EventStore
+}
+
+func NewEventHandler(s EventStore) *EventHandler {
+	return &EventHandler{
+		store: s,
+	}
+}
+
+// GetCalendar serves the feed to a signed in admin
+func (h *EventHandler) GetCalendar(w http.ResponseWriter, r *http.Request) {
+	h.writeCalendar(w, r)
+}
+
+// GetCalendarFeed serves the feed to calendar apps, they cannot send the auth cookie so the token is in the url
+func (h *EventHandler) GetCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	_, err := h.store.GetCalendarFeedUserId(chi.URLParam(r, "token"))
+	if err != nil {
+		var invalid *CalendarFeedTokenInvalidError
+		if errors.As(err, &invalid) {
+			utils.ErrorJSON(w, err, "token", http.StatusNotFound)
+			return
+		}
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	h.writeCalendar(w, r)
+}
+
+func (h *EventHandler) GetCalendarFeedToken(w http.ResponseWriter, r *http.Request) {
+	userId, err := utils.GetUserIdFromRequestHeader(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, "userId", http.StatusForbidden)
+		return
+	}
+	token, err := h.store.GetCalendarFeedToken(userId)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, CalendarFeedToken{Token: token, FeedUrl: calendarFeedUrl(r, token)})
+}
+
+func (h *EventHandler) RotateCalendarFeedToken(w http.ResponseWriter, r *http.Request) {
+	userId, err := utils.GetUserIdFromRequestHeader(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, "userId", http.StatusForbidden)
+		return
+	}
+	token, err := h.store.RotateCalendarFeedToken(userId)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, CalendarFeedToken{Token: token, FeedUrl: calendarFeedUrl(r, token)})
+}
+
+// GetPublicEvents lists funded events for the landing page, it needs no sign in
+func (h *EventHandler) GetPublicEvents(w http.ResponseWriter, r *http.Request) {
+	filter, errName, err := parsePublicEventFilter(r.URL.Query())
+	if err != nil {
+		utils.ErrorJSON(w, err, errName, http.StatusBadRequest)
+		return
+	}
+	page, err := h.store.GetPublicEvents(filter)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", PUBLIC_EVENTS_MAX_AGE))
+	utils.WriteJSON(w, http.StatusOK, page)
+}
+
+// GetEventMap returns a GeoJSON point per province or district with project counts and approved funding
+func (h *EventHandler) GetEventMap(w http.ResponseWriter, r *http.Request) {
+	filter, errName, err := parseEventMapFilter(r.URL.Query())
+	if err != nil {
+		utils.ErrorJSON(w, err, errName, http.StatusBadRequest)
+		return
+	}
+	areas, err := h.store.GetEventMap(filter)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, toFeatureCollection(filter.Level, areas))
+}
+
+func (h *EventHandler) writeCalendar(w http.ResponseWriter, r *http.Request) {
+	filter, errName, err := parseCalendarFilter(r.URL.Query())
+	if err != nil {
+		utils.ErrorJSON(w, err, errName, http.StatusBadRequest)
+		return
+	}
+	now := time.Now()
+	events, err := h.store.GetCalendarEvents(filter, now)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="running-events.ics"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(renderICalendar(calendarName, events, now))
+}
+
+// calendarFeedUrl points at the host the admin used, behind the proxy the scheme comes from X-Forwarded-Proto
+func calendarFeedUrl(r *http.Request, token string) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return fmt.Sprintf("%s://%s/api/v1/calendar/%s.ics", scheme, r.Host, token)
+}
@@ -0,0 +1,84 @@
+package events
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+const icalTimeFormat = "20060102T150405Z"
+
+// RFC 5545 limits content lines to 75 octets excluding the line break
+const icalLineMaxOctets = 75
+
+var icalTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func renderICalendar(calendarName string, events []CalendarEvent, now time.Time) []byte {
+	var buf bytes.Buffer
+	writeICalLine(&buf, "BEGIN:VCALENDAR")
+	writeICalLine(&buf, "VERSION:2.0")
+	writeICalLine(&buf, "PRODID:-//Running Fund//Events//TH")
+	writeICalLine(&buf, "CALSCALE:GREGORIAN")
+	writeICalLine(&buf, "METHOD:PUBLISH")
+	writeICalLine(&buf, "X-WR-CALNAME:"+icalText(calendarName))
+	writeICalLine(&buf, "X-WR-TIMEZONE:"+utils.TIMEZONE)
+	stamp := now.UTC().Format(icalTimeFormat)
+	for _, e := range events {
+		writeICalLine(&buf, "BEGIN:VEVENT")
+		writeICalLine(&buf, fmt.Sprintf("UID:%s@running-fund", e.ProjectCode))
+		writeICalLine(&buf, "DTSTAMP:"+stamp)
+		writeICalLine(&buf, "LAST-MODIFIED:"+e.UpdatedAt.UTC().Format(icalTimeFormat))
+		writeICalLine(&buf, "DTSTART:"+e.FromDate.UTC().Format(icalTimeFormat))
+		// DTEND must be after DTSTART, an event without a duration is written without it
+		if e.ToDate.After(e.FromDate) {
+			writeICalLine(&buf, "DTEND:"+e.ToDate.UTC().Format(icalTimeFormat))
+		}
+		writeICalLine(&buf, "SUMMARY:"+icalText(e.ProjectName))
+		if location := eventLocation(e); location != "" {
+			writeICalLine(&buf, "LOCATION:"+icalText(location))
+		}
+		writeICalLine(&buf, "DESCRIPTION:"+icalText(fmt.Sprintf("รหัสโครงการ %s\nสถานะ %s", e.ProjectCode, e.ProjectStatus)))
+		writeICalLine(&buf, "CATEGORIES:"+icalText(e.ProjectStatus))
+		writeICalLine(&buf, "STATUS:CONFIRMED")
+		writeICalLine(&buf, "END:VEVENT")
+	}
+	writeICalLine(&buf, "END:VCALENDAR")
+	return buf.Bytes()
+}
+
+func eventLocation(e CalendarEvent) string {
+	var parts []string
+	for _, p := range []*string{e.Address, e.SubdistrictName, e.DistrictName, e.ProvinceName} {
+		if p != nil && strings.TrimSpace(*p) != "" {
+			parts = append(parts, strings.TrimSpace(*p))
+		}
+	}
+	if e.Postcode != nil {
+		parts = append(parts, fmt.Sprint(*e.Postcode))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func icalText(s string) string {
+	return icalTextEscaper.Replace(s)
+}
+
+// writeICalLine folds long lines without splitting a multi-byte character, Thai text is 3 octets per character
+func writeICalLine(buf *bytes.Buffer, line string) {
+	octets := 0
+	for _, r := range line {
+		size := utf8.RuneLen(r)
+		if octets+size > icalLineMaxOctets {
+			buf.WriteString("\r\n ")
+			// the leading space counts towards the folded line
+			octets = 1
+		}
+		buf.WriteRune(r)
+		octets += size
+	}
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,103 @@
+package events
+
+import "time"
+
+type CalendarEvent struct {
+	ProjectCode     string
+	ProjectName     string
+	ProjectStatus   string
+	FromDate        time.Time
+	ToDate          time.Time
+	Address         *string
+	SubdistrictName *string
+	DistrictName    *string
+	ProvinceName    *string
+	Postcode        *int
+	UpdatedAt       time.Time
+}
+
+type CalendarFilter struct {
+	ProvinceIds []int
+	Statuses    []string
+}
+
+type CalendarFeedToken struct {
+	Token   string `json:"token"`
+	FeedUrl string `json:"feedUrl"`
+}
+
+// PublicEvent only carries fields that may be shown on the landing page, never contact details
+type PublicEvent struct {
+	ProjectName   string    `json:"projectName"`
+	ProjectStatus string    `json:"projectStatus"`
+	FromDate      time.Time `json:"fromDate"`
+	ToDate        time.Time `json:"toDate"`
+	ProvinceName  *string   `json:"provinceName"`
+	Categories    []string  `json:"categories"`
+	OtherCategory string    `json:"otherCategory,omitempty"`
+	Distances     []string  `json:"distances"`
+	OrganizerName *string   `json:"organizerName,omitempty"`
+}
+
+type PublicEventFilter struct {
+	ProvinceIds []int
+	Statuses    []string
+	Categories  []string
+	From        *time.Time
+	To          *time.Time
+	Page        int
+	PageSize    int
+}
+
+type PublicEventPage struct {
+	Items    []PublicEvent `json:"items"`
+	Total    int           `json:"total"`
+	Page     int           `json:"page"`
+	PageSize int           `json:"pageSize"`
+}
+
+type EventMapFilter struct {
+	Level    string
+	Year     *int
+	Statuses []string
+}
+
+// EventMapArea is one province or district with the projects held in it
+type EventMapArea struct {
+	Id                int
+	Name              string
+	ProvinceName      string
+	Latitude          *float64
+	Longitude         *float64
+	ProjectCount      int
+	FundApprovedTotal int64
+	// Approximate is set when the coordinates are the province's, the district has no centroid yet
+	Approximate bool
+}
+
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+type Feature struct {
+	Type       string            `json:"type"`
+	Id         int               `json:"id"`
+	Geometry   *Point            `json:"geometry"`
+	Properties FeatureProperties `json:"properties"`
+}
+
+// Point coordinates are [longitude, latitude] as GeoJSON requires
+type Point struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+type FeatureProperties struct {
+	Level             string `json:"level"`
+	Name              string `json:"name"`
+	ProvinceName      string `json:"provinceName,omitempty"`
+	ProjectCount      int    `json:"projectCount"`
+	FundApprovedTotal int64  `json:"fundApprovedTotal"`
+	Approximate       bool   `json:"approximate,omitempty"`
+}
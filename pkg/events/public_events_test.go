@@ -0,0 +1,169 @@
+package events_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/poomipat-k/running-fund/pkg/events"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+)
+
+func TestGetPublicEvents(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Bangkok")
+	from := time.Date(2026, 11, 1, 0, 0, 0, 0, loc)
+	to := time.Date(2026, 12, 1, 0, 0, 0, 0, loc)
+
+	tests := []struct {
+		name           string
+		url            string
+		expectedStatus int
+		expectedFilter *events.PublicEventFilter
+	}{
+		{
+			name:           "should reject a status that is not published",
+			url:            "/events?status=Reviewing",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "should reject an unknown category",
+			url:            "/events?category=ultra",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "should reject an invalid date",
+			url:            "/events?from=01/11/2026",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "should reject to before from",
+			url:            "/events?from=2026-11-02&to=2026-11-01",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "should reject a page size over the maximum",
+			url:            "/events?pageSize=51",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "should reject page zero",
+			url:            "/events?page=0",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "should default to every published status and the first page",
+			url:            "/events",
+			expectedStatus: http.StatusOK,
+			expectedFilter: &events.PublicEventFilter{
+				ProvinceIds: []int{},
+				Statuses:    []string{"Approved", "Start", "Completed"},
+				Categories:  []string{},
+				Page:        1,
+				PageSize:    20,
+			},
+		},
+		{
+			name:           "should pass filters through with an inclusive to date",
+			url:            "/events?provinceId=10&category=trailRunning&status=Completed&from=2026-11-01&to=2026-11-30&page=2&pageSize=5",
+			expectedStatus: http.StatusOK,
+			expectedFilter: &events.PublicEventFilter{
+				ProvinceIds: []int{10},
+				Statuses:    []string{"Completed"},
+				Categories:  []string{"trailRunning"},
+				From:        &from,
+				To:          &to,
+				Page:        2,
+				PageSize:    5,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFilter events.PublicEventFilter
+			store := &mock.MockEventStore{
+				GetPublicEventsFunc: func(filter events.PublicEventFilter) (events.PublicEventPage, error) {
+					gotFilter = filter
+					return events.PublicEventPage{Items: []events.PublicEvent{}, Page: filter.Page, PageSize: filter.PageSize}, nil
+				},
+			}
+			handler := events.NewEventHandler(store)
+
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			handler.GetPublicEvents(res, req)
+
+			if res.Code != tt.expectedStatus {
+				t.Fatalf("got status %d want %d, body %s", res.Code, tt.expectedStatus, res.Body.String())
+			}
+			if tt.expectedFilter == nil {
+				return
+			}
+			if res.Header().Get("Cache-Control") == "" {
+				t.Error("expected a Cache-Control header")
+			}
+			if !equalFilter(gotFilter, *tt.expectedFilter) {
+				t.Errorf("got filter %+v want %+v", gotFilter, *tt.expectedFilter)
+			}
+		})
+	}
+}
+
+func TestGetPublicEventsHidesPrivateFields(t *testing.T) {
+	store := &mock.MockEventStore{
+		GetPublicEventsFunc: func(filter events.PublicEventFilter) (events.PublicEventPage, error) {
+			return events.PublicEventPage{
+				Items: []events.PublicEvent{{
+					ProjectName:   "วิ่งริมโขง",
+					ProjectStatus: "Approved",
+					Categories:    []string{"roadRace"},
+					Distances:     []string{"10 km"},
+				}},
+				Total:    1,
+				Page:     1,
+				PageSize: 20,
+			}, nil
+		},
+	}
+	handler := events.NewEventHandler(store)
+	res := httptest.NewRecorder()
+	handler.GetPublicEvents(res, httptest.NewRequest(http.MethodGet, "/events", nil))
+
+	var body struct {
+		Items []map[string]any `json:"items"`
+	}
+	err := json.Unmarshal(res.Body.Bytes(), &body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(body.Items) != 1 {
+		t.Fatalf("got %d items want 1", len(body.Items))
+	}
+	if _, found := body.Items[0]["organizerName"]; found {
+		t.Error("organizerName should be left out without consent")
+	}
+	for _, key := range []string{"projectCode", "email", "phoneNumber", "contact"} {
+		if _, found := body.Items[0][key]; found {
+			t.Errorf("%s must not be exposed", key)
+		}
+	}
+}
+
+func equalFilter(a, b events.PublicEventFilter) bool {
+	sameTime := func(x, y *time.Time) bool {
+		if x == nil || y == nil {
+			return x == y
+		}
+		return x.Equal(*y)
+	}
+	return reflect.DeepEqual(a.ProvinceIds, b.ProvinceIds) &&
+		reflect.DeepEqual(a.Statuses, b.Statuses) &&
+		reflect.DeepEqual(a.Categories, b.Categories) &&
+		sameTime(a.From, b.From) &&
+		sameTime(a.To, b.To) &&
+		a.Page == b.Page &&
+		a.PageSize == b.PageSize
+}
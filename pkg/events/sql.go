@@ -0,0 +1,135 @@
+package events
+
+const getCalendarEventsSQL = `
+SELECT
+project.project_code,
+project_history.project_name,
+project_history.status,
+project_history.from_date,
+project_history.to_date,
+address.address,
+subdistrict.name,
+district.name,
+province.name,
+postcode.code,
+project_history.updated_at
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+LEFT JOIN address ON project_history.address_id = address.id
+LEFT JOIN postcode ON address.postcode_id = postcode.id
+LEFT JOIN subdistrict ON postcode.subdistrict_id = subdistrict.id
+LEFT JOIN district ON subdistrict.district_id = district.id
+LEFT JOIN province ON district.province_id = province.id
+WHERE project_history.status = ANY($1)
+AND (cardinality($2::int[]) = 0 OR province.id = ANY($2))
+AND project_history.to_date >= $3
+ORDER BY project_history.from_date ASC, project.project_code ASC;
+`
+
+const getCalendarFeedUserIdSQL = `
+SELECT calendar_feed_token.user_id
+FROM calendar_feed_token
+INNER JOIN users ON calendar_feed_token.user_id = users.id
+WHERE calendar_feed_token.token = $1 AND users.user_role = 'admin' AND users.activated = true;
+`
+
+const getCalendarFeedTokenSQL = `
+SELECT token FROM calendar_feed_token WHERE user_id = $1;
+`
+
+const upsertCalendarFeedTokenSQL = `
+INSERT INTO calendar_feed_token (user_id, token, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE SET token = EXCLUDED.token, created_at = EXCLUDED.created_at;
+`
+
+const publicEventsFromSQL = `
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+LEFT JOIN address ON project_history.address_id = address.id
+LEFT JOIN postcode ON address.postcode_id = postcode.id
+LEFT JOIN subdistrict ON postcode.subdistrict_id = subdistrict.id
+LEFT JOIN district ON subdistrict.district_id = district.id
+LEFT JOIN province ON district.province_id = province.id
+WHERE project_history.status = ANY($1)
+AND (cardinality($2::int[]) = 0 OR province.id = ANY($2))
+AND (
+	cardinality($3::text[]) = 0
+	OR ('roadRace' = ANY($3) AND project_history.cat_road_race)
+	OR ('trailRunning' = ANY($3) AND project_history.cat_trail_running)
+	OR ('other' = ANY($3) AND project_history.cat_has_other)
+)
+AND ($4::timestamptz IS NULL OR project_history.to_date >= $4)
+AND ($5::timestamptz IS NULL OR project_history.from_date < $5)
+`
+
+const countPublicEventsSQL = `SELECT COUNT(*)` + publicEventsFromSQL + `;`
+
+// organizer_name is only selected when the applicant agreed to publish it
+const getPublicEventsSQL = `
+SELECT
+project_history.project_name,
+project_history.status,
+project_history.from_date,
+project_history.to_date,
+province.name,
+project_history.cat_road_race,
+project_history.cat_trail_running,
+project_history.cat_has_other,
+project_history.cat_other_type,
+ARRAY(SELECT distance.type FROM distance WHERE distance.project_history_id = project_history.id ORDER BY distance.id),
+CASE WHEN project_history.has_organizer AND project_history.organizer_public_consent THEN project_history.organizer_name END` +
+	publicEventsFromSQL + `
+ORDER BY project_history.from_date ASC, project.id ASC
+LIMIT $6 OFFSET $7;
+`
+
+// $3 is the timezone the event year is counted in
+const eventMapWhereSQL = `
+WHERE project_history.status = ANY($1)
+AND ($2::int IS NULL OR EXTRACT(YEAR FROM project_history.from_date AT TIME ZONE $3) = $2)
+`
+
+const eventMapFromSQL = `
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+INNER JOIN address ON project_history.address_id = address.id
+INNER JOIN postcode ON address.postcode_id = postcode.id
+INNER JOIN subdistrict ON postcode.subdistrict_id = subdistrict.id
+INNER JOIN district ON subdistrict.district_id = district.id
+INNER JOIN province ON district.province_id = province.id
+`
+
+const getEventMapByProvinceSQL = `
+SELECT
+province.id,
+province.name,
+province.name,
+province.latitude,
+province.longitude,
+COUNT(*),
+COALESCE(SUM(project_history.fund_approved_amount), 0),
+false` +
+	eventMapFromSQL +
+	eventMapWhereSQL + `
+GROUP BY province.id
+ORDER BY province.id ASC;
+`
+
+// districts without their own centroid use the mean of their subdistricts, districts without either are
+// placed on their province and flagged approximate until district centroids are imported
+const getEventMapByDistrictSQL = `
+SELECT
+district.id,
+district.name,
+province.name,
+COALESCE(district.latitude, (SELECT AVG(s.latitude) FROM subdistrict s WHERE s.district_id = district.id AND s.longitude IS NOT NULL), province.latitude),
+COALESCE(district.longitude, (SELECT AVG(s.longitude) FROM subdistrict s WHERE s.district_id = district.id AND s.latitude IS NOT NULL), province.longitude),
+COUNT(*),
+COALESCE(SUM(project_history.fund_approved_amount), 0),
+district.latitude IS NULL AND NOT EXISTS (SELECT 1 FROM subdistrict s WHERE s.district_id = district.id AND s.latitude IS NOT NULL AND s.longitude IS NOT NULL)` +
+	eventMapFromSQL +
+	eventMapWhereSQL + `
+GROUP BY district.id, province.id
+ORDER BY district.id ASC;
+`
@@ -0,0 +1,240 @@
+package events
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/patrickmn/go-cache"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+// past events stay in the feed for a year so calendars keep recent history
+const CALENDAR_HISTORY_DAYS = 365
+
+const publicEventsCachePrefix = "public_events"
+
+type store struct {
+	db *sql.DB
+	c  *cache.Cache
+}
+
+func NewStore(db *sql.DB, c *cache.Cache) *store {
+	return &store{
+		db: db,
+		c:  c,
+	}
+}
+
+func (s *store) GetCalendarEvents(filter CalendarFilter, now time.Time) ([]CalendarEvent, error) {
+	since := now.AddDate(0, 0, -CALENDAR_HISTORY_DAYS)
+	rows, err := s.db.Query(getCalendarEventsSQL, pq.Array(filter.Statuses), pq.Array(filter.ProvinceIds), since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []CalendarEvent{}
+	for rows.Next() {
+		var e CalendarEvent
+		err := rows.Scan(
+			&e.ProjectCode,
+			&e.ProjectName,
+			&e.ProjectStatus,
+			&e.FromDate,
+			&e.ToDate,
+			&e.Address,
+			&e.SubdistrictName,
+			&e.DistrictName,
+			&e.ProvinceName,
+			&e.Postcode,
+			&e.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (s *store) GetCalendarFeedUserId(token string) (int, error) {
+	var userId int
+	err := s.db.QueryRow(getCalendarFeedUserIdSQL, token).Scan(&userId)
+	if err == sql.ErrNoRows {
+		return 0, &CalendarFeedTokenInvalidError{}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return userId, nil
+}
+
+// GetCalendarFeedToken returns the user's token, creating one on first use
+func (s *store) GetCalendarFeedToken(userId int) (string, error) {
+	var token string
+	err := s.db.QueryRow(getCalendarFeedTokenSQL, userId).Scan(&token)
+	if err == nil {
+		return token, nil
+	}
+	if err != sql.ErrNoRows {
+		return "", err
+	}
+	return s.RotateCalendarFeedToken(userId)
+}
+
+// RotateCalendarFeedToken replaces the token so a leaked feed url stops working
+func (s *store) RotateCalendarFeedToken(userId int) (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+	_, err = s.db.Exec(upsertCalendarFeedTokenSQL, userId, token, time.Now())
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// GetPublicEvents is served to anonymous visitors so pages are cached for the cache's default expiration
+func (s *store) GetPublicEvents(filter PublicEventFilter) (PublicEventPage, error) {
+	cacheKey := publicEventsCacheKey(filter)
+	raw, found := s.c.Get(cacheKey)
+	if found {
+		cachedData, ok := raw.(PublicEventPage)
+		if ok {
+			return cachedData, nil
+		}
+	}
+
+	args := []any{
+		pq.Array(filter.Statuses),
+		pq.Array(filter.ProvinceIds),
+		pq.Array(filter.Categories),
+		filter.From,
+		filter.To,
+	}
+	page := PublicEventPage{Items: []PublicEvent{}, Page: filter.Page, PageSize: filter.PageSize}
+	err := s.db.QueryRow(countPublicEventsSQL, args...).Scan(&page.Total)
+	if err != nil {
+		return PublicEventPage{}, err
+	}
+	offset := (filter.Page - 1) * filter.PageSize
+	if page.Total > offset {
+		rows, err := s.db.Query(getPublicEventsSQL, append(args, filter.PageSize, offset)...)
+		if err != nil {
+			return PublicEventPage{}, err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var e PublicEvent
+			var roadRace, trailRunning, hasOther bool
+			var otherType string
+			err := rows.Scan(
+				&e.ProjectName,
+				&e.ProjectStatus,
+				&e.FromDate,
+				&e.ToDate,
+				&e.ProvinceName,
+				&roadRace,
+				&trailRunning,
+				&hasOther,
+				&otherType,
+				pq.Array(&e.Distances),
+				&e.OrganizerName,
+			)
+			if err != nil {
+				return PublicEventPage{}, err
+			}
+			e.Categories = []string{}
+			if roadRace {
+				e.Categories = append(e.Categories, "roadRace")
+			}
+			if trailRunning {
+				e.Categories = append(e.Categories, "trailRunning")
+			}
+			if hasOther {
+				e.Categories = append(e.Categories, "other")
+				e.OtherCategory = otherType
+			}
+			if e.Distances == nil {
+				e.Distances = []string{}
+			}
+			page.Items = append(page.Items, e)
+		}
+		err = rows.Err()
+		if err != nil {
+			return PublicEventPage{}, err
+		}
+	}
+
+	s.c.Set(cacheKey, page, cache.DefaultExpiration)
+	return page, nil
+}
+
+func publicEventsCacheKey(filter PublicEventFilter) string {
+	formatDate := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(time.DateOnly)
+	}
+	return fmt.Sprintf(
+		"%s_%v_%s_%s_%s_%s_%d_%d",
+		publicEventsCachePrefix,
+		filter.ProvinceIds,
+		strings.Join(filter.Statuses, ","),
+		strings.Join(filter.Categories, ","),
+		formatDate(filter.From),
+		formatDate(filter.To),
+		filter.Page,
+		filter.PageSize,
+	)
+}
+
+func (s *store) GetEventMap(filter EventMapFilter) ([]EventMapArea, error) {
+	query := getEventMapByProvinceSQL
+	if filter.Level == "district" {
+		query = getEventMapByDistrictSQL
+	}
+	rows, err := s.db.Query(query, pq.Array(filter.Statuses), filter.Year, utils.TIMEZONE)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	areas := []EventMapArea{}
+	for rows.Next() {
+		var a EventMapArea
+		err := rows.Scan(
+			&a.Id,
+			&a.Name,
+			&a.ProvinceName,
+			&a.Latitude,
+			&a.Longitude,
+			&a.ProjectCount,
+			&a.FundApprovedTotal,
+			&a.Approximate,
+		)
+		if err != nil {
+			return nil, err
+		}
+		areas = append(areas, a)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return areas, nil
+}
@@ -0,0 +1,180 @@
+package events
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+const FILTER_MAX_VALUES = 100
+
+const PUBLIC_EVENTS_PAGE_SIZE = 20
+const PUBLIC_EVENTS_PAGE_SIZE_MAX = 50
+
+// Only funded events are published, Completed is kept so past events stay listed
+var PUBLISHED_STATUS = map[string]bool{
+	"Approved":  true,
+	"Start":     true,
+	"Completed": true,
+}
+
+var defaultCalendarStatuses = []string{"Approved", "Start"}
+
+var defaultPublicEventStatuses = []string{"Approved", "Start", "Completed"}
+
+var MAP_LEVEL = map[string]bool{
+	"province": true,
+	"district": true,
+}
+
+var EVENT_CATEGORY = map[string]bool{
+	"roadRace":     true,
+	"trailRunning": true,
+	"other":        true,
+}
+
+// parseCalendarFilter reads repeated provinceId and status query parameters
+func parseCalendarFilter(query url.Values) (CalendarFilter, string, error) {
+	provinceIds, errName, err := parseProvinceIds(query)
+	if err != nil {
+		return CalendarFilter{}, errName, err
+	}
+	statuses, errName, err := parseStatuses(query, defaultCalendarStatuses)
+	if err != nil {
+		return CalendarFilter{}, errName, err
+	}
+	return CalendarFilter{ProvinceIds: provinceIds, Statuses: statuses}, "", nil
+}
+
+// parsePublicEventFilter reads the calendar parameters plus category, from, to (YYYY-MM-DD), page and pageSize
+func parsePublicEventFilter(query url.Values) (PublicEventFilter, string, error) {
+	provinceIds, errName, err := parseProvinceIds(query)
+	if err != nil {
+		return PublicEventFilter{}, errName, err
+	}
+	statuses, errName, err := parseStatuses(query, defaultPublicEventStatuses)
+	if err != nil {
+		return PublicEventFilter{}, errName, err
+	}
+	filter := PublicEventFilter{
+		ProvinceIds: provinceIds,
+		Statuses:    statuses,
+		Categories:  []string{},
+		Page:        1,
+		PageSize:    PUBLIC_EVENTS_PAGE_SIZE,
+	}
+
+	if len(query["category"]) > FILTER_MAX_VALUES {
+		return PublicEventFilter{}, "category", &TooManyFilterValuesError{Name: "category"}
+	}
+	for _, category := range query["category"] {
+		if !EVENT_CATEGORY[category] {
+			return PublicEventFilter{}, "category", &CategoryInvalidError{Category: category}
+		}
+		filter.Categories = append(filter.Categories, category)
+	}
+
+	loc, err := time.LoadLocation(utils.TIMEZONE)
+	if err != nil {
+		return PublicEventFilter{}, "", err
+	}
+	if raw := query.Get("from"); raw != "" {
+		from, err := time.ParseInLocation(time.DateOnly, raw, loc)
+		if err != nil {
+			return PublicEventFilter{}, "from", &DateInvalidError{Name: "from"}
+		}
+		filter.From = &from
+	}
+	if raw := query.Get("to"); raw != "" {
+		to, err := time.ParseInLocation(time.DateOnly, raw, loc)
+		if err != nil {
+			return PublicEventFilter{}, "to", &DateInvalidError{Name: "to"}
+		}
+		// to is inclusive, events starting any time on that day are listed
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return PublicEventFilter{}, "to", &DateRangeInvalidError{}
+	}
+
+	if raw := query.Get("page"); raw != "" {
+		page, err := strconv.Atoi(raw)
+		if err != nil || page < 1 {
+			return PublicEventFilter{}, "page", &PageInvalidError{}
+		}
+		filter.Page = page
+	}
+	if raw := query.Get("pageSize"); raw != "" {
+		pageSize, err := strconv.Atoi(raw)
+		if err != nil || pageSize < 1 || pageSize > PUBLIC_EVENTS_PAGE_SIZE_MAX {
+			return PublicEventFilter{}, "pageSize", &PageSizeInvalidError{}
+		}
+		filter.PageSize = pageSize
+	}
+	return filter, "", nil
+}
+
+func parseProvinceIds(query url.Values) ([]int, string, error) {
+	if len(query["provinceId"]) > FILTER_MAX_VALUES {
+		return nil, "provinceId", &TooManyFilterValuesError{Name: "provinceId"}
+	}
+	provinceIds := []int{}
+	for _, raw := range query["provinceId"] {
+		id, err := strconv.Atoi(raw)
+		if err != nil || id <= 0 {
+			return nil, "provinceId", &ProvinceIdInvalidError{}
+		}
+		provinceIds = append(provinceIds, id)
+	}
+	return provinceIds, "", nil
+}
+
+func parseStatuses(query url.Values, defaults []string) ([]string, string, error) {
+	statuses := query["status"]
+	if len(statuses) == 0 {
+		return defaults, "", nil
+	}
+	if len(statuses) > FILTER_MAX_VALUES {
+		return nil, "status", &TooManyFilterValuesError{Name: "status"}
+	}
+	for _, status := range statuses {
+		if !PUBLISHED_STATUS[status] {
+			return nil, "status", &StatusNotPublishedError{Status: status}
+		}
+	}
+	return statuses, "", nil
+}
+
+// parseEventMapFilter reads level, year and repeated status, admins may map any project status
+func parseEventMapFilter(query url.Values) (EventMapFilter, string, error) {
+	filter := EventMapFilter{Level: "province", Statuses: defaultPublicEventStatuses}
+	if level := query.Get("level"); level != "" {
+		if !MAP_LEVEL[level] {
+			return EventMapFilter{}, "level", &MapLevelInvalidError{}
+		}
+		filter.Level = level
+	}
+	if raw := query.Get("year"); raw != "" {
+		year, err := strconv.Atoi(raw)
+		if err != nil || year < 1 {
+			return EventMapFilter{}, "year", &YearInvalidError{}
+		}
+		filter.Year = &year
+	}
+	if statuses := query["status"]; len(statuses) > 0 {
+		if len(statuses) > FILTER_MAX_VALUES {
+			return EventMapFilter{}, "status", &TooManyFilterValuesError{Name: "status"}
+		}
+		for _, status := range statuses {
+			if projects.PROJECT_STATUS[status] == 0 {
+				return EventMapFilter{}, "status", &StatusInvalidError{Status: status}
+			}
+		}
+		filter.Statuses = statuses
+	}
+	return filter, "", nil
+}
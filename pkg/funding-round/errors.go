@@ -0,0 +1,103 @@
+package fundingRound
+
+import "fmt"
+
+type NameRequiredError struct{}
+
+func (e *NameRequiredError) Error() string {
+	return "name is required"
+}
+
+type NameTooLongError struct{}
+
+func (e *NameTooLongError) Error() string {
+	return fmt.Sprintf("name must not exceed %d characters", NAME_MAX_LENGTH)
+}
+
+type SubmissionOpenAtRequiredError struct{}
+
+func (e *SubmissionOpenAtRequiredError) Error() string {
+	return "submissionOpenAt is required"
+}
+
+type SubmissionCloseAtRequiredError struct{}
+
+func (e *SubmissionCloseAtRequiredError) Error() string {
+	return "submissionCloseAt is required"
+}
+
+type SubmissionWindowInvalidError struct{}
+
+func (e *SubmissionWindowInvalidError) Error() string {
+	return "submissionCloseAt must be after submissionOpenAt"
+}
+
+type ReviewFromDateRequiredError struct{}
+
+func (e *ReviewFromDateRequiredError) Error() string {
+	return "reviewFromDate is required"
+}
+
+type ReviewToDateRequiredError struct{}
+
+func (e *ReviewToDateRequiredError) Error() string {
+	return "reviewToDate is required"
+}
+
+type ReviewWindowInvalidError struct{}
+
+func (e *ReviewWindowInvalidError) Error() string {
+	return "reviewToDate must be after reviewFromDate and reviewFromDate must not be before submissionOpenAt"
+}
+
+type TotalBudgetInvalidError struct{}
+
+func (e *TotalBudgetInvalidError) Error() string {
+	return "totalBudget must be greater than 0"
+}
+
+type ApplicantCriteriaVersionInvalidError struct{}
+
+func (e *ApplicantCriteriaVersionInvalidError) Error() string {
+	return "applicantCriteriaVersion is invalid"
+}
+
+type ReviewerCriteriaVersionInvalidError struct{}
+
+func (e *ReviewerCriteriaVersionInvalidError) Error() string {
+	return "reviewerCriteriaVersion is invalid"
+}
+
+type ReviewerThresholdInvalidError struct{}
+
+func (e *ReviewerThresholdInvalidError) Error() string {
+	return "reviewerThreshold must be greater than 0"
+}
+
+type SubmissionWindowOverlapError struct {
+	RoundName string
+}
+
+func (e *SubmissionWindowOverlapError) Error() string {
+	return fmt.Sprintf("submission window overlaps with round %s", e.RoundName)
+}
+
+type FundingRoundNotFoundError struct{}
+
+func (e *FundingRoundNotFoundError) Error() string {
+	return "funding round is not found"
+}
+
+type ReviewWindowClosedError struct {
+	RoundName string
+}
+
+func (e *ReviewWindowClosedError) Error() string {
+	return fmt.Sprintf("review period of round %s is closed", e.RoundName)
+}
+
+type NoOpenFundingRoundError struct{}
+
+func (e *NoOpenFundingRoundError) Error() string {
+	return "no funding round is open for submission"
+}
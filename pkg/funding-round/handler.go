@@ -0,0 +1,124 @@
+package fundingRound
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+type FundingRoundStore interface {
+	GetOpenFundingRound(now time.Time) (FundingRound, error)
+	GetFundingRoundById(roundId int) (FundingRound, error)
+	GetFundingRounds() ([]FundingRoundListItem, error)
+	GetOverlappingRoundName(openAt, closeAt time.Time, excludeRoundId int) (string, error)
+	HasCriteriaVersions(applicantVersion, reviewerVersion int) (bool, bool, error)
+	AddFundingRound(payload FundingRoundRequest) (int, error)
+	UpdateFundingRound(roundId int, payload FundingRoundRequest) error
+}
+
+type FundingRoundHandler struct {
+	store FundingRoundStore
+}
+
+func NewFundingRoundHandler(s FundingRoundStore) *FundingRoundHandler {
+	return &FundingRoundHandler{
+		store: s,
+	}
+}
+
+func (h *FundingRoundHandler) GetCurrentFundingRound(w http.ResponseWriter, r *http.Request) {
+	round, err := h.store.GetOpenFundingRound(time.Now())
+	if err != nil {
+		utils.ErrorJSON(w, err, "fundingRound", http.StatusNotFound)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, round)
+}
+
+func (h *FundingRoundHandler) GetFundingRounds(w http.ResponseWriter, r *http.Request) {
+	rounds, err := h.store.GetFundingRounds()
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, rounds)
+}
+
+func (h *FundingRoundHandler) AddFundingRound(w http.ResponseWriter, r *http.Request) {
+	var payload FundingRoundRequest
+	err := utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errField, err := h.validatePayload(payload, 0)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	id, err := h.store.AddFundingRound(payload)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusCreated, id)
+}
+
+func (h *FundingRoundHandler) UpdateFundingRound(w http.ResponseWriter, r *http.Request) {
+	roundId, err := strconv.Atoi(chi.URLParam(r, "roundId"))
+	if err != nil {
+		utils.ErrorJSON(w, &FundingRoundNotFoundError{}, "roundId", http.StatusNotFound)
+		return
+	}
+	var payload FundingRoundRequest
+	err = utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errField, err := h.validatePayload(payload, roundId)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	err = h.store.UpdateFundingRound(roundId, payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "roundId", http.StatusNotFound)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, roundId)
+}
+
+func (h *FundingRoundHandler) validatePayload(payload FundingRoundRequest, roundId int) (string, error) {
+	errField, err := validateFundingRoundPayload(payload)
+	if err != nil {
+		return errField, err
+	}
+	hasApplicantCriteria, hasReviewerCriteria, err := h.store.HasCriteriaVersions(payload.ApplicantCriteriaVersion, payload.ReviewerCriteriaVersion)
+	if err != nil {
+		return "", err
+	}
+	if !hasApplicantCriteria {
+		return "applicantCriteriaVersion", &ApplicantCriteriaVersionInvalidError{}
+	}
+	if !hasReviewerCriteria {
+		return "reviewerCriteriaVersion", &ReviewerCriteriaVersionInvalidError{}
+	}
+	// only one round can be open for submission at a time
+	overlapping, err := h.store.GetOverlappingRoundName(*payload.SubmissionOpenAt, *payload.SubmissionCloseAt, roundId)
+	if err != nil {
+		return "", err
+	}
+	if overlapping != "" {
+		return "submissionOpenAt", &SubmissionWindowOverlapError{RoundName: overlapping}
+	}
+	return "", nil
+}
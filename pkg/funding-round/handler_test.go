@@ -0,0 +1,247 @@
+package fundingRound_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	fundingRound "github.com/poomipat-k/running-fund/pkg/funding-round"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+)
+
+type ErrorBody struct {
+	Error   bool
+	Message string
+	Name    string
+}
+
+func newTime(v time.Time) *time.Time {
+	return &v
+}
+
+var okPayload = fundingRound.FundingRoundRequest{
+	Name:                     "รอบที่ 2",
+	SubmissionOpenAt:         newTime(time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	SubmissionCloseAt:        newTime(time.Date(2027, time.March, 1, 0, 0, 0, 0, time.UTC)),
+	ReviewFromDate:           newTime(time.Date(2027, time.March, 1, 0, 0, 0, 0, time.UTC)),
+	ReviewToDate:             newTime(time.Date(2027, time.April, 1, 0, 0, 0, 0, time.UTC)),
+	TotalBudget:              1000000,
+	ApplicantCriteriaVersion: 2,
+	ReviewerCriteriaVersion:  1,
+	ReviewerThreshold:        3,
+}
+
+func hasAllCriteria(applicantVersion, reviewerVersion int) (bool, bool, error) {
+	return true, true, nil
+}
+
+func noOverlap(openAt, closeAt time.Time, excludeRoundId int) (string, error) {
+	return "", nil
+}
+
+func TestAddFundingRound(t *testing.T) {
+	tests := []struct {
+		name           string
+		payload        fundingRound.FundingRoundRequest
+		store          *mock.MockFundingRoundStore
+		expectedStatus int
+		expectedName   string
+		expectedError  error
+	}{
+		{
+			name: "should error when review window starts before submission opens",
+			payload: fundingRound.FundingRoundRequest{
+				Name:              okPayload.Name,
+				SubmissionOpenAt:  okPayload.SubmissionOpenAt,
+				SubmissionCloseAt: okPayload.SubmissionCloseAt,
+				ReviewFromDate:    newTime(time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC)),
+				ReviewToDate:      okPayload.ReviewToDate,
+			},
+			store:          &mock.MockFundingRoundStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedName:   "reviewToDate",
+			expectedError:  &fundingRound.ReviewWindowInvalidError{},
+		},
+		{
+			name:    "should error when applicant criteria version is not published",
+			payload: okPayload,
+			store: &mock.MockFundingRoundStore{
+				HasCriteriaVersionsFunc: func(applicantVersion, reviewerVersion int) (bool, bool, error) {
+					return false, true, nil
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedName:   "applicantCriteriaVersion",
+			expectedError:  &fundingRound.ApplicantCriteriaVersionInvalidError{},
+		},
+		{
+			name:    "should error when reviewer criteria version does not exist",
+			payload: okPayload,
+			store: &mock.MockFundingRoundStore{
+				HasCriteriaVersionsFunc: func(applicantVersion, reviewerVersion int) (bool, bool, error) {
+					return true, false, nil
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedName:   "reviewerCriteriaVersion",
+			expectedError:  &fundingRound.ReviewerCriteriaVersionInvalidError{},
+		},
+		{
+			name:    "should error when submission window overlaps another round",
+			payload: okPayload,
+			store: &mock.MockFundingRoundStore{
+				HasCriteriaVersionsFunc: hasAllCriteria,
+				GetOverlappingRoundNameFunc: func(openAt, closeAt time.Time, excludeRoundId int) (string, error) {
+					return "รอบเริ่มต้น", nil
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedName:   "submissionOpenAt",
+			expectedError:  &fundingRound.SubmissionWindowOverlapError{RoundName: "รอบเริ่มต้น"},
+		},
+		{
+			name:    "should add a funding round",
+			payload: okPayload,
+			store: &mock.MockFundingRoundStore{
+				HasCriteriaVersionsFunc:     hasAllCriteria,
+				GetOverlappingRoundNameFunc: noOverlap,
+				AddFundingRoundFunc: func(payload fundingRound.FundingRoundRequest) (int, error) {
+					return 2, nil
+				},
+			},
+			expectedStatus: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := fundingRound.NewFundingRoundHandler(tt.store)
+
+			body, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/funding-round", bytes.NewReader(body))
+
+			handler.AddFundingRound(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+				if errBody.Name != tt.expectedName {
+					t.Errorf("got error name %q, want %q", errBody.Name, tt.expectedName)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateFundingRound(t *testing.T) {
+	tests := []struct {
+		name           string
+		roundId        string
+		store          *mock.MockFundingRoundStore
+		expectedStatus int
+		expectedError  error
+	}{
+		{
+			name:           "should error when roundId is not a number",
+			roundId:        "abc",
+			store:          &mock.MockFundingRoundStore{},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  &fundingRound.FundingRoundNotFoundError{},
+		},
+		{
+			name:    "should error when submission window overlaps another round",
+			roundId: "2",
+			store: &mock.MockFundingRoundStore{
+				HasCriteriaVersionsFunc: hasAllCriteria,
+				GetOverlappingRoundNameFunc: func(openAt, closeAt time.Time, excludeRoundId int) (string, error) {
+					if excludeRoundId != 2 {
+						t.Errorf("round being updated should be excluded, got %d", excludeRoundId)
+					}
+					return "รอบที่ 3", nil
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &fundingRound.SubmissionWindowOverlapError{RoundName: "รอบที่ 3"},
+		},
+		{
+			name:    "should error when round does not exist",
+			roundId: "9",
+			store: &mock.MockFundingRoundStore{
+				HasCriteriaVersionsFunc:     hasAllCriteria,
+				GetOverlappingRoundNameFunc: noOverlap,
+				UpdateFundingRoundFunc: func(roundId int, payload fundingRound.FundingRoundRequest) error {
+					return &fundingRound.FundingRoundNotFoundError{}
+				},
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  &fundingRound.FundingRoundNotFoundError{},
+		},
+		{
+			name:    "should update a funding round",
+			roundId: "2",
+			store: &mock.MockFundingRoundStore{
+				HasCriteriaVersionsFunc:     hasAllCriteria,
+				GetOverlappingRoundNameFunc: noOverlap,
+				UpdateFundingRoundFunc: func(roundId int, payload fundingRound.FundingRoundRequest) error {
+					return nil
+				},
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := fundingRound.NewFundingRoundHandler(tt.store)
+
+			body, err := json.Marshal(okPayload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, "/admin/funding-round/"+tt.roundId, bytes.NewReader(body))
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("roundId", tt.roundId)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			handler.UpdateFundingRound(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				assertErrorMessage(t, getErrorResponse(t, res).Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
+
+func getErrorResponse(t testing.TB, res *httptest.ResponseRecorder) ErrorBody {
+	t.Helper()
+	var body ErrorBody
+	err := json.Unmarshal(res.Body.Bytes(), &body)
+	if err != nil {
+		t.Fatalf("Unable to parse response from server %q into ErrorBody, '%v'", res.Body, err)
+	}
+	return body
+}
+
+func assertErrorMessage(t testing.TB, got, want string) {
+	t.Helper()
+	if got != want {
+		t.Errorf("did not get correct error, got %v, want %v", got, want)
+	}
+}
+
+func assertStatus(t testing.TB, got, want int) {
+	t.Helper()
+	if got != want {
+		t.Errorf("did not get correct status, got %d, want %d", got, want)
+	}
+}
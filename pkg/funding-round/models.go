@@ -0,0 +1,41 @@
+package fundingRound
+
+import "time"
+
+type FundingRound struct {
+	Id                       int       `json:"id"`
+	Name                     string    `json:"name"`
+	SubmissionOpenAt         time.Time `json:"submissionOpenAt"`
+	SubmissionCloseAt        time.Time `json:"submissionCloseAt"`
+	ReviewFromDate           time.Time `json:"reviewFromDate"`
+	ReviewToDate             time.Time `json:"reviewToDate"`
+	TotalBudget              int64     `json:"totalBudget"`
+	ApplicantCriteriaVersion int       `json:"applicantCriteriaVersion"`
+	ReviewerCriteriaVersion  int       `json:"reviewerCriteriaVersion"`
+	ReviewerThreshold        int       `json:"reviewerThreshold"`
+	CreatedAt                time.Time `json:"createdAt"`
+	UpdatedAt                time.Time `json:"updatedAt"`
+}
+
+// IsReviewOpen reports whether now falls within the round's review window
+func (r FundingRound) IsReviewOpen(now time.Time) bool {
+	return !now.Before(r.ReviewFromDate) && now.Before(r.ReviewToDate)
+}
+
+type FundingRoundListItem struct {
+	FundingRound
+	ProjectCount       int   `json:"projectCount"`
+	FundApprovedAmount int64 `json:"fundApprovedAmount"`
+}
+
+type FundingRoundRequest struct {
+	Name                     string     `json:"name,omitempty"`
+	SubmissionOpenAt         *time.Time `json:"submissionOpenAt,omitempty"`
+	SubmissionCloseAt        *time.Time `json:"submissionCloseAt,omitempty"`
+	ReviewFromDate           *time.Time `json:"reviewFromDate,omitempty"`
+	ReviewToDate             *time.Time `json:"reviewToDate,omitempty"`
+	TotalBudget              int64      `json:"totalBudget,omitempty"`
+	ApplicantCriteriaVersion int        `json:"applicantCriteriaVersion,omitempty"`
+	ReviewerCriteriaVersion  int        `json:"reviewerCriteriaVersion,omitempty"`
+	ReviewerThreshold        int        `json:"reviewerThreshold,omitempty"`
+}
@@ -0,0 +1,67 @@
+package fundingRound
+
+const fundingRoundColumns = `
+funding_round.id, funding_round.name, funding_round.submission_open_at, funding_round.submission_close_at,
+funding_round.review_from_date, funding_round.review_to_date, funding_round.total_budget,
+funding_round.applicant_criteria_version, funding_round.reviewer_criteria_version, funding_round.reviewer_threshold,
+funding_round.created_at, funding_round.updated_at`
+
+const getOpenFundingRoundSQL = `SELECT` + fundingRoundColumns + `
+FROM funding_round
+WHERE funding_round.submission_open_at <= $1 AND funding_round.submission_close_at > $1
+ORDER BY funding_round.submission_open_at DESC LIMIT 1;
+`
+
+const getFundingRoundByIdSQL = `SELECT` + fundingRoundColumns + `
+FROM funding_round WHERE funding_round.id = $1;
+`
+
+const getFundingRoundsSQL = `SELECT` + fundingRoundColumns + `,
+COUNT(project.id) as project_count,
+COALESCE(SUM(project_history.fund_approved_amount), 0) as fund_approved_amount
+FROM funding_round
+LEFT JOIN project ON project.funding_round_id = funding_round.id
+LEFT JOIN project_history ON project.project_history_id = project_history.id
+GROUP BY funding_round.id
+ORDER BY funding_round.submission_open_at DESC;
+`
+
+// the initial round seeded by the migration never closes on its own, a round opening after it ends it instead
+const openEndedFundingRoundSQL = `submission_close_at >= '9999-12-31 00:00:00+07'`
+
+const getOverlappingFundingRoundSQL = `
+SELECT name FROM funding_round
+WHERE id <> $3 AND submission_open_at < $2 AND submission_close_at > $1
+AND NOT (` + openEndedFundingRoundSQL + ` AND submission_open_at < $1)
+LIMIT 1;
+`
+
+const closeOpenEndedFundingRoundSQL = `
+UPDATE funding_round SET submission_close_at = $1, updated_at = $2
+WHERE ` + openEndedFundingRoundSQL + ` AND submission_open_at < $1;
+`
+
+// drafts can not be used by a round until they are published
+const countApplicantCriteriaVersionSQL = `
+SELECT COUNT(*) FROM applicant_criteria
+INNER JOIN applicant_criteria_version ON applicant_criteria.criteria_version = applicant_criteria_version.criteria_version
+WHERE applicant_criteria.criteria_version = $1 AND applicant_criteria_version.status = 'published';
+`
+
+const countReviewerCriteriaVersionSQL = `
+SELECT COUNT(*) FROM review_criteria WHERE criteria_version = $1;
+`
+
+const addFundingRoundSQL = `
+INSERT INTO funding_round
+(name, submission_open_at, submission_close_at, review_from_date, review_to_date, total_budget,
+applicant_criteria_version, reviewer_criteria_version, reviewer_threshold, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10) RETURNING id;
+`
+
+const updateFundingRoundSQL = `
+UPDATE funding_round
+SET name = $2, submission_open_at = $3, submission_close_at = $4, review_from_date = $5, review_to_date = $6,
+total_budget = $7, applicant_criteria_version = $8, reviewer_criteria_version = $9, reviewer_threshold = $10, updated_at = $11
+WHERE id = $1 RETURNING id;
+`
@@ -0,0 +1,193 @@
+package fundingRound
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+type store struct {
+	db *sql.DB
+}
+
+func NewStore(db *sql.DB) *store {
+	return &store{
+		db: db,
+	}
+}
+
+func (s *store) GetOpenFundingRound(now time.Time) (FundingRound, error) {
+	round, err := scanFundingRound(s.db.QueryRow(getOpenFundingRoundSQL, now))
+	if err == sql.ErrNoRows {
+		return FundingRound{}, &NoOpenFundingRoundError{}
+	}
+	if err != nil {
+		slog.Error(err.Error())
+		return FundingRound{}, err
+	}
+	return round, nil
+}
+
+func (s *store) GetFundingRoundById(roundId int) (FundingRound, error) {
+	round, err := scanFundingRound(s.db.QueryRow(getFundingRoundByIdSQL, roundId))
+	if err == sql.ErrNoRows {
+		return FundingRound{}, &FundingRoundNotFoundError{}
+	}
+	if err != nil {
+		slog.Error(err.Error())
+		return FundingRound{}, err
+	}
+	return round, nil
+}
+
+func (s *store) GetFundingRounds() ([]FundingRoundListItem, error) {
+	rows, err := s.db.Query(getFundingRoundsSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []FundingRoundListItem{}
+	for rows.Next() {
+		var row FundingRoundListItem
+		err = rows.Scan(
+			&row.Id,
+			&row.Name,
+			&row.SubmissionOpenAt,
+			&row.SubmissionCloseAt,
+			&row.ReviewFromDate,
+			&row.ReviewToDate,
+			&row.TotalBudget,
+			&row.ApplicantCriteriaVersion,
+			&row.ReviewerCriteriaVersion,
+			&row.ReviewerThreshold,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+			&row.ProjectCount,
+			&row.FundApprovedAmount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetOverlappingRoundName returns the name of another round whose submission window overlaps [openAt, closeAt)
+func (s *store) GetOverlappingRoundName(openAt, closeAt time.Time, excludeRoundId int) (string, error) {
+	var name string
+	err := s.db.QueryRow(getOverlappingFundingRoundSQL, openAt, closeAt, excludeRoundId).Scan(&name)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func (s *store) HasCriteriaVersions(applicantVersion, reviewerVersion int) (bool, bool, error) {
+	var applicantCount, reviewerCount int
+	err := s.db.QueryRow(countApplicantCriteriaVersionSQL, applicantVersion).Scan(&applicantCount)
+	if err != nil {
+		return false, false, err
+	}
+	err = s.db.QueryRow(countReviewerCriteriaVersionSQL, reviewerVersion).Scan(&reviewerCount)
+	if err != nil {
+		return false, false, err
+	}
+	return applicantCount > 0, reviewerCount > 0, nil
+}
+
+func (s *store) AddFundingRound(payload FundingRoundRequest) (int, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	_, err = tx.ExecContext(ctx, closeOpenEndedFundingRoundSQL, payload.SubmissionOpenAt, now)
+	if err != nil {
+		return 0, err
+	}
+	var id int
+	err = tx.QueryRowContext(
+		ctx,
+		addFundingRoundSQL,
+		strings.TrimSpace(payload.Name),
+		payload.SubmissionOpenAt,
+		payload.SubmissionCloseAt,
+		payload.ReviewFromDate,
+		payload.ReviewToDate,
+		payload.TotalBudget,
+		payload.ApplicantCriteriaVersion,
+		payload.ReviewerCriteriaVersion,
+		payload.ReviewerThreshold,
+		now,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+	slog.Info("funding round added", "fundingRoundId", id)
+	return id, nil
+}
+
+func (s *store) UpdateFundingRound(roundId int, payload FundingRoundRequest) error {
+	var id int
+	err := s.db.QueryRow(
+		updateFundingRoundSQL,
+		roundId,
+		strings.TrimSpace(payload.Name),
+		payload.SubmissionOpenAt,
+		payload.SubmissionCloseAt,
+		payload.ReviewFromDate,
+		payload.ReviewToDate,
+		payload.TotalBudget,
+		payload.ApplicantCriteriaVersion,
+		payload.ReviewerCriteriaVersion,
+		payload.ReviewerThreshold,
+		time.Now(),
+	).Scan(&id)
+	if err == sql.ErrNoRows {
+		return &FundingRoundNotFoundError{}
+	}
+	if err != nil {
+		return err
+	}
+	slog.Info("funding round updated", "fundingRoundId", id)
+	return nil
+}
+
+func scanFundingRound(row *sql.Row) (FundingRound, error) {
+	var round FundingRound
+	err := row.Scan(
+		&round.Id,
+		&round.Name,
+		&round.SubmissionOpenAt,
+		&round.SubmissionCloseAt,
+		&round.ReviewFromDate,
+		&round.ReviewToDate,
+		&round.TotalBudget,
+		&round.ApplicantCriteriaVersion,
+		&round.ReviewerCriteriaVersion,
+		&round.ReviewerThreshold,
+		&round.CreatedAt,
+		&round.UpdatedAt,
+	)
+	return round, err
+}
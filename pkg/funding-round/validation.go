@@ -0,0 +1,49 @@
+package fundingRound
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const NAME_MAX_LENGTH = 255
+
+func validateFundingRoundPayload(payload FundingRoundRequest) (string, error) {
+	name := strings.TrimSpace(payload.Name)
+	if name == "" {
+		return "name", &NameRequiredError{}
+	}
+	if utf8.RuneCountInString(name) > NAME_MAX_LENGTH {
+		return "name", &NameTooLongError{}
+	}
+	if payload.SubmissionOpenAt == nil {
+		return "submissionOpenAt", &SubmissionOpenAtRequiredError{}
+	}
+	if payload.SubmissionCloseAt == nil {
+		return "submissionCloseAt", &SubmissionCloseAtRequiredError{}
+	}
+	if !payload.SubmissionCloseAt.After(*payload.SubmissionOpenAt) {
+		return "submissionCloseAt", &SubmissionWindowInvalidError{}
+	}
+	if payload.ReviewFromDate == nil {
+		return "reviewFromDate", &ReviewFromDateRequiredError{}
+	}
+	if payload.ReviewToDate == nil {
+		return "reviewToDate", &ReviewToDateRequiredError{}
+	}
+	if !payload.ReviewToDate.After(*payload.ReviewFromDate) || payload.ReviewFromDate.Before(*payload.SubmissionOpenAt) {
+		return "reviewToDate", &ReviewWindowInvalidError{}
+	}
+	if payload.TotalBudget <= 0 {
+		return "totalBudget", &TotalBudgetInvalidError{}
+	}
+	if payload.ApplicantCriteriaVersion <= 0 {
+		return "applicantCriteriaVersion", &ApplicantCriteriaVersionInvalidError{}
+	}
+	if payload.ReviewerCriteriaVersion <= 0 {
+		return "reviewerCriteriaVersion", &ReviewerCriteriaVersionInvalidError{}
+	}
+	if payload.ReviewerThreshold <= 0 {
+		return "reviewerThreshold", &ReviewerThresholdInvalidError{}
+	}
+	return "", nil
+}
@@ -3,12 +3,15 @@ package mw
 import (
 	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
+	fundingRound "github.com/poomipat-k/running-fund/pkg/funding-round"
 	operationConfig "github.com/poomipat-k/running-fund/pkg/operation-config"
 	"github.com/poomipat-k/running-fund/pkg/utils"
 )
 
-func AllowCreateNewProject(next http.HandlerFunc, confStore operationConfig.OperationConfigStore) http.HandlerFunc {
+func AllowCreateNewProject(next http.HandlerFunc, confStore operationConfig.OperationConfigStore, roundStore fundingRound.FundingRoundStore) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		conf, err := confStore.GetLatestConfig()
 		if err != nil {
@@ -20,6 +23,14 @@ func AllowCreateNewProject(next http.HandlerFunc, confStore operationConfig.Oper
 			utils.ErrorJSON(w, errors.New("ปิดรับข้อเสนอโครงการ"), "newProjectNotAllow", http.StatusBadRequest)
 			return
 		}
+
+		round, err := roundStore.GetOpenFundingRound(time.Now())
+		if err != nil {
+			utils.ErrorJSON(w, errors.New("ปิดรับข้อเสนอโครงการ"), "newProjectNotAllow", http.StatusBadRequest)
+			return
+		}
+		r.Header.Set("fundingRoundId", strconv.Itoa(round.Id))
+		r.Header.Set("applicantCriteriaVersion", strconv.Itoa(round.ApplicantCriteriaVersion))
 		next(w, r)
 	})
 }
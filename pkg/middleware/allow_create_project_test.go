@@ -0,0 +1,116 @@
+package mw_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	fundingRound "github.com/poomipat-k/running-fund/pkg/funding-round"
+	mw "github.com/poomipat-k/running-fund/pkg/middleware"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	operationConfig "github.com/poomipat-k/running-fund/pkg/operation-config"
+)
+
+type ErrorBody struct {
+	Error   bool
+	Message string
+	Name    string
+}
+
+func newBool(v bool) *bool {
+	return &v
+}
+
+func TestAllowCreateNewProject(t *testing.T) {
+	tests := []struct {
+		name                    string
+		config                  operationConfig.OperationConfig
+		configErr               error
+		round                   fundingRound.FundingRound
+		roundErr                error
+		expectedStatus          int
+		expectedName            string
+		expectedRoundId         string
+		expectedCriteriaVersion string
+	}{
+		{
+			name:           "should error when config can not be loaded",
+			configErr:      errors.New("connection refused"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedName:   "operationConfig",
+		},
+		{
+			name:           "should block when new projects are switched off",
+			config:         operationConfig.OperationConfig{AllowNewProject: newBool(false)},
+			expectedStatus: http.StatusBadRequest,
+			expectedName:   "newProjectNotAllow",
+		},
+		{
+			name:           "should block when no round is open for submission",
+			config:         operationConfig.OperationConfig{AllowNewProject: newBool(true)},
+			roundErr:       &fundingRound.NoOpenFundingRoundError{},
+			expectedStatus: http.StatusBadRequest,
+			expectedName:   "newProjectNotAllow",
+		},
+		{
+			name:                    "should pass the open round to the handler",
+			config:                  operationConfig.OperationConfig{AllowNewProject: newBool(true)},
+			round:                   fundingRound.FundingRound{Id: 3, ApplicantCriteriaVersion: 2},
+			expectedStatus:          http.StatusOK,
+			expectedRoundId:         "3",
+			expectedCriteriaVersion: "2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confStore := &mock.MockOperationConfigStore{
+				GetLatestConfigFunc: func() (operationConfig.OperationConfig, error) {
+					return tt.config, tt.configErr
+				},
+			}
+			roundStore := &mock.MockFundingRoundStore{
+				GetOpenFundingRoundFunc: func(now time.Time) (fundingRound.FundingRound, error) {
+					return tt.round, tt.roundErr
+				},
+			}
+			called := false
+			next := func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				if got := r.Header.Get("fundingRoundId"); got != tt.expectedRoundId {
+					t.Errorf("got fundingRoundId %q, want %q", got, tt.expectedRoundId)
+				}
+				if got := r.Header.Get("applicantCriteriaVersion"); got != tt.expectedCriteriaVersion {
+					t.Errorf("got applicantCriteriaVersion %q, want %q", got, tt.expectedCriteriaVersion)
+				}
+				w.WriteHeader(http.StatusOK)
+			}
+
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/project", nil)
+			// a client must not be able to choose the round itself
+			req.Header.Set("fundingRoundId", "99")
+
+			mw.AllowCreateNewProject(next, confStore, roundStore)(res, req)
+			if res.Code != tt.expectedStatus {
+				t.Errorf("did not get correct status, got %d, want %d", res.Code, tt.expectedStatus)
+			}
+			if called != (tt.expectedStatus == http.StatusOK) {
+				t.Errorf("next called = %v, want %v", called, !called)
+			}
+			if tt.expectedName != "" {
+				var body ErrorBody
+				err := json.Unmarshal(res.Body.Bytes(), &body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if body.Name != tt.expectedName {
+					t.Errorf("got error name %q, want %q", body.Name, tt.expectedName)
+				}
+			}
+		})
+	}
+}
@@ -5,7 +5,13 @@ import (
 	"mime/multipart"
 	"time"
 
+	completionReport "github.com/poomipat-k/running-fund/pkg/completion-report"
+	"github.com/poomipat-k/running-fund/pkg/disbursement"
+	"github.com/poomipat-k/running-fund/pkg/events"
+	fundingRound "github.com/poomipat-k/running-fund/pkg/funding-round"
+	operationConfig "github.com/poomipat-k/running-fund/pkg/operation-config"
 	"github.com/poomipat-k/running-fund/pkg/projects"
+	"github.com/poomipat-k/running-fund/pkg/review"
 	"github.com/poomipat-k/running-fund/pkg/users"
 )
 
@@ -54,23 +60,56 @@ func (m *MockUserStore) GetUserFullNameById(userId int) (users.UserFullName, err
 type MockProjectStore struct {
 	AdminUpdateData projects.AdminUpdateParam
 
-	GetReviewerDashboardFunc                func(userId int, from time.Time, to time.Time) ([]projects.ReviewDashboardRow, error)
+	GetReviewerDashboardFunc                func(userId int, now time.Time, fundingRoundId *int) ([]projects.ReviewDashboardRow, error)
 	GetReviewerProjectDetailsFunc           func(userId int, projectCode string) (projects.ProjectReviewDetailsResponse, error)
 	GetProjectCriteriaFunc                  func(criteriaVersion int) ([]projects.ProjectReviewCriteria, error)
-	AddProjectFunc                          func(addProject projects.AddProjectRequest, userId int, criteria []projects.ApplicantSelfScoreCriteria, attachments []projects.Attachments) (int, error)
+	AddProjectFunc                          func(addProject projects.AddProjectRequest, userId int, fundingRoundId int, criteria []projects.ApplicantSelfScoreCriteria, attachments []projects.Attachments) (int, error)
 	GetApplicantCriteriaFunc                func(version int) ([]projects.ApplicantSelfScoreCriteria, error)
 	GetAllProjectDashboardByApplicantIdFunc func(applicantId int) ([]projects.ApplicantDashboardItem, error)
 	GetApplicantProjectDetailsFunc          func(isAdmin bool, projectCode string, userId int) ([]projects.ApplicantDetailsData, error)
 	HasPermissionToAddAdditionalFilesFunc   func(userId int, projectCode string) bool
 	GetProjectStatusByProjectCodeFunc       func(projectCode string) (projects.AdminUpdateParam, error)
-	GetAdminRequestDashboardFunc            func(fromDate, toDate time.Time, orderBy string, limit, offset int, projectCode, projectName, projectStatus *string) ([]projects.AdminRequestDashboardRow, error)
-	GetAdminStartedDashboardFunc            func(fromDate, toDate time.Time, orderBy string, limit, offset int, projectCode, projectName, projectStatus *string) ([]projects.AdminRequestDashboardRow, error)
-	GetAdminSummaryFunc                     func(fromDate, toDate time.Time) ([]projects.AdminSummaryData, error)
-	GenerateAdminReportFunc                 func(fromDate, toDate time.Time) (*bytes.Buffer, error)
+	GetAdminRequestDashboardFunc            func(fromDate, toDate time.Time, sort []projects.DashboardSort, limit int, cursor *string, filter projects.AdminDashboardFilter) (projects.AdminDashboardPage, error)
+	GetAdminStartedDashboardFunc            func(fromDate, toDate time.Time, sort []projects.DashboardSort, limit int, cursor *string, filter projects.AdminDashboardFilter) (projects.AdminDashboardPage, error)
+	GetAdminSummaryFunc                     func(fromDate, toDate time.Time, fundingRoundId *int) ([]projects.AdminSummaryData, error)
+	GenerateAdminReportFunc                 func(fromDate, toDate time.Time, fundingRoundId *int) (*bytes.Buffer, error)
+	WithdrawProjectFunc                     func(projectHistoryId int, reason string, withdrawnAt time.Time) error
+	CancelProjectFunc                       func(projectHistoryId int, reason string, cancelledAt time.Time) error
+	GetProjectFullDetailsFunc               func(projectCode string, version int) (projects.ProjectFullDetailsResponse, error)
+	GetEnabledEligibilityRulesFunc          func(fundingRoundId int) ([]projects.EligibilityRule, error)
+	GetAllEligibilityRulesFunc              func() ([]projects.EligibilityRule, error)
+	CountActiveOrganizationProposalsFunc    func(fundingRoundId int, organizationName string) (int, error)
+	AddEligibilityRuleFunc                  func(payload projects.EligibilityRuleRequest) (int, error)
+	UpdateEligibilityRuleFunc               func(ruleId int, payload projects.EligibilityRuleRequest) error
+	GetProjectDuplicatesFunc                func(projectCode string) ([]projects.ProjectDuplicate, error)
+	ResolveDuplicateFunc                    func(duplicateId int, action string, adminId int) error
+	GetProjectNotesFunc                     func(projectCode string) ([]projects.ProjectNote, error)
+	AddProjectNoteFunc                      func(projectCode string, parentId *int, body string, userId int, createdAt time.Time) (int, error)
+	GetProjectTagsFunc                      func(projectCode string) ([]string, error)
+	ReplaceProjectTagsFunc                  func(projectCode string, tags []string, userId int) error
+	GetAllProjectTagsFunc                   func() ([]projects.ProjectTagCount, error)
+	GetProjectOwnerFunc                     func(projectCode string) (projects.ProjectOwner, error)
+	GetProjectMessagesFunc                  func(projectCode string) ([]projects.ProjectMessage, error)
+	AddProjectMessageFunc                   func(param projects.AddProjectMessageParam, attachments []*multipart.FileHeader) (int, error)
+	MarkProjectMessagesReadFunc             func(projectCode string, readerRole string, readerId int, readAt time.Time) (int64, error)
+	GetProjectsForAdminUpdateFunc           func(projectCodes []string) ([]projects.AdminBulkUpdateParam, error)
+	BulkUpdateProjectsByAdminFunc           func(params []projects.AdminBulkUpdateParam) error
+	GetAdminDashboardCountFunc              func(dashboardType string, fromDate, toDate time.Time, filter projects.AdminDashboardFilter) (projects.AdminDashboardCount, error)
+	SearchProjectsFunc                      func(dashboardType string, query string, fromDate, toDate time.Time, limit, offset int, filter projects.AdminDashboardFilter) ([]projects.ProjectSearchRow, error)
+	GetDistanceCatalogueFunc                func() ([]projects.DistanceCatalogueItem, error)
+	GetUnmappedDistanceTypesFunc            func() ([]projects.DistanceTypeCount, error)
+	MapDistanceTypesFunc                    func(mappings []projects.MappedDistanceType) (int64, error)
+	GetDistanceFeeAnalyticsFunc             func(year *int, statuses []string) (projects.DistanceFeeAnalytics, error)
+	GetApplicantCriteriaVersionsFunc        func() ([]projects.ApplicantCriteriaVersion, error)
+	GetApplicantCriteriaPreviewFunc         func(criteriaVersion int) (projects.ApplicantCriteriaPreview, error)
+	AddApplicantCriteriaDraftFunc           func(items []projects.ApplicantCriteriaItem) (int, error)
+	UpdateApplicantCriteriaDraftFunc        func(criteriaVersion int, items []projects.ApplicantCriteriaItem) error
+	DeleteApplicantCriteriaDraftFunc        func(criteriaVersion int) error
+	PublishApplicantCriteriaFunc            func(criteriaVersion int) error
 }
 
-func (m *MockProjectStore) GetReviewerDashboard(userId int, from time.Time, to time.Time) ([]projects.ReviewDashboardRow, error) {
-	return m.GetReviewerDashboardFunc(userId, from, to)
+func (m *MockProjectStore) GetReviewerDashboard(userId int, now time.Time, fundingRoundId *int) ([]projects.ReviewDashboardRow, error) {
+	return m.GetReviewerDashboardFunc(userId, now, fundingRoundId)
 }
 
 func (m *MockProjectStore) GetReviewerProjectDetails(userId int, projectCode string) (projects.ProjectReviewDetailsResponse, error) {
@@ -85,8 +124,8 @@ func (m *MockProjectStore) GetApplicantCriteria(version int) ([]projects.Applica
 	return m.GetApplicantCriteriaFunc(version)
 }
 
-func (m *MockProjectStore) AddProject(addProject projects.AddProjectRequest, userId int, criteria []projects.ApplicantSelfScoreCriteria, attachments []projects.Attachments) (int, error) {
-	return m.AddProjectFunc(addProject, userId, criteria, attachments)
+func (m *MockProjectStore) AddProject(addProject projects.AddProjectRequest, userId int, fundingRoundId int, criteria []projects.ApplicantSelfScoreCriteria, attachments []projects.Attachments) (int, error) {
+	return m.AddProjectFunc(addProject, userId, fundingRoundId, criteria, attachments)
 }
 
 func (m *MockProjectStore) GetAllProjectDashboardByApplicantId(applicantId int) ([]projects.ApplicantDashboardItem, error) {
@@ -113,27 +152,319 @@ func (m *MockProjectStore) UpdateProjectByAdmin(payload projects.AdminUpdatePara
 func (m *MockProjectStore) GetAdminRequestDashboard(
 	fromDate,
 	toDate time.Time,
-	orderBy string,
-	limit, offset int,
-	projectCode, projectName, projectStatus *string,
-) ([]projects.AdminRequestDashboardRow, error) {
-	return m.GetAdminRequestDashboardFunc(fromDate, toDate, orderBy, limit, offset, projectCode, projectName, projectStatus)
+	sort []projects.DashboardSort,
+	limit int,
+	cursor *string,
+	filter projects.AdminDashboardFilter,
+) (projects.AdminDashboardPage, error) {
+	return m.GetAdminRequestDashboardFunc(fromDate, toDate, sort, limit, cursor, filter)
 }
 
 func (m *MockProjectStore) GetAdminStartedDashboard(
 	fromDate,
 	toDate time.Time,
-	orderBy string,
-	limit, offset int,
-	projectCode, projectName, projectStatus *string,
-) ([]projects.AdminRequestDashboardRow, error) {
-	return m.GetAdminRequestDashboardFunc(fromDate, toDate, orderBy, limit, offset, projectCode, projectName, projectStatus)
+	sort []projects.DashboardSort,
+	limit int,
+	cursor *string,
+	filter projects.AdminDashboardFilter,
+) (projects.AdminDashboardPage, error) {
+	return m.GetAdminStartedDashboardFunc(fromDate, toDate, sort, limit, cursor, filter)
 }
 
-func (m *MockProjectStore) GetAdminSummary(fromDate, toDate time.Time) ([]projects.AdminSummaryData, error) {
-	return m.GetAdminSummaryFunc(fromDate, toDate)
+func (m *MockProjectStore) GetAdminSummary(fromDate, toDate time.Time, fundingRoundId *int) ([]projects.AdminSummaryData, error) {
+	return m.GetAdminSummaryFunc(fromDate, toDate, fundingRoundId)
 }
 
-func (m *MockProjectStore) GenerateAdminReport(fromDate, toDate time.Time) (*bytes.Buffer, error) {
-	return m.GenerateAdminReportFunc(fromDate, toDate)
+func (m *MockProjectStore) GenerateAdminReport(fromDate, toDate time.Time, fundingRoundId *int) (*bytes.Buffer, error) {
+	return m.GenerateAdminReportFunc(fromDate, toDate, fundingRoundId)
+}
+
+func (m *MockProjectStore) WithdrawProject(projectHistoryId int, reason string, withdrawnAt time.Time) error {
+	return m.WithdrawProjectFunc(projectHistoryId, reason, withdrawnAt)
+}
+
+func (m *MockProjectStore) CancelProject(projectHistoryId int, reason string, cancelledAt time.Time) error {
+	return m.CancelProjectFunc(projectHistoryId, reason, cancelledAt)
+}
+
+func (m *MockProjectStore) GetProjectFullDetails(projectCode string, version int) (projects.ProjectFullDetailsResponse, error) {
+	return m.GetProjectFullDetailsFunc(projectCode, version)
+}
+
+func (m *MockProjectStore) GetEnabledEligibilityRules(fundingRoundId int) ([]projects.EligibilityRule, error) {
+	return m.GetEnabledEligibilityRulesFunc(fundingRoundId)
+}
+
+func (m *MockProjectStore) GetAllEligibilityRules() ([]projects.EligibilityRule, error) {
+	return m.GetAllEligibilityRulesFunc()
+}
+
+func (m *MockProjectStore) CountActiveOrganizationProposals(fundingRoundId int, organizationName string) (int, error) {
+	return m.CountActiveOrganizationProposalsFunc(fundingRoundId, organizationName)
+}
+
+func (m *MockProjectStore) AddEligibilityRule(payload projects.EligibilityRuleRequest) (int, error) {
+	return m.AddEligibilityRuleFunc(payload)
+}
+
+func (m *MockProjectStore) UpdateEligibilityRule(ruleId int, payload projects.EligibilityRuleRequest) error {
+	return m.UpdateEligibilityRuleFunc(ruleId, payload)
+}
+
+func (m *MockProjectStore) GetProjectDuplicates(projectCode string) ([]projects.ProjectDuplicate, error) {
+	return m.GetProjectDuplicatesFunc(projectCode)
+}
+
+func (m *MockProjectStore) ResolveDuplicate(duplicateId int, action string, adminId int) error {
+	return m.ResolveDuplicateFunc(duplicateId, action, adminId)
+}
+
+func (m *MockProjectStore) GetProjectNotes(projectCode string) ([]projects.ProjectNote, error) {
+	return m.GetProjectNotesFunc(projectCode)
+}
+
+func (m *MockProjectStore) AddProjectNote(projectCode string, parentId *int, body string, userId int, createdAt time.Time) (int, error) {
+	return m.AddProjectNoteFunc(projectCode, parentId, body, userId, createdAt)
+}
+
+func (m *MockProjectStore) GetProjectTags(projectCode string) ([]string, error) {
+	return m.GetProjectTagsFunc(projectCode)
+}
+
+func (m *MockProjectStore) ReplaceProjectTags(projectCode string, tags []string, userId int) error {
+	return m.ReplaceProjectTagsFunc(projectCode, tags, userId)
+}
+
+func (m *MockProjectStore) GetAllProjectTags() ([]projects.ProjectTagCount, error) {
+	return m.GetAllProjectTagsFunc()
+}
+
+func (m *MockProjectStore) GetProjectOwner(projectCode string) (projects.ProjectOwner, error) {
+	return m.GetProjectOwnerFunc(projectCode)
+}
+
+func (m *MockProjectStore) GetProjectMessages(projectCode string) ([]projects.ProjectMessage, error) {
+	return m.GetProjectMessagesFunc(projectCode)
+}
+
+func (m *MockProjectStore) AddProjectMessage(param projects.AddProjectMessageParam, attachments []*multipart.FileHeader) (int, error) {
+	return m.AddProjectMessageFunc(param, attachments)
+}
+
+func (m *MockProjectStore) MarkProjectMessagesRead(projectCode string, readerRole string, readerId int, readAt time.Time) (int64, error) {
+	return m.MarkProjectMessagesReadFunc(projectCode, readerRole, readerId, readAt)
+}
+
+func (m *MockProjectStore) SearchProjects(dashboardType string, query string, fromDate, toDate time.Time, limit, offset int, filter projects.AdminDashboardFilter) ([]projects.ProjectSearchRow, error) {
+	return m.SearchProjectsFunc(dashboardType, query, fromDate, toDate, limit, offset, filter)
+}
+
+func (m *MockProjectStore) GetAdminDashboardCount(dashboardType string, fromDate, toDate time.Time, filter projects.AdminDashboardFilter) (projects.AdminDashboardCount, error) {
+	return m.GetAdminDashboardCountFunc(dashboardType, fromDate, toDate, filter)
+}
+
+func (m *MockProjectStore) GetProjectsForAdminUpdate(projectCodes []string) ([]projects.AdminBulkUpdateParam, error) {
+	return m.GetProjectsForAdminUpdateFunc(projectCodes)
+}
+
+func (m *MockProjectStore) BulkUpdateProjectsByAdmin(params []projects.AdminBulkUpdateParam) error {
+	return m.BulkUpdateProjectsByAdminFunc(params)
+}
+
+func (m *MockProjectStore) GetDistanceCatalogue() ([]projects.DistanceCatalogueItem, error) {
+	return m.GetDistanceCatalogueFunc()
+}
+
+func (m *MockProjectStore) GetUnmappedDistanceTypes() ([]projects.DistanceTypeCount, error) {
+	return m.GetUnmappedDistanceTypesFunc()
+}
+
+func (m *MockProjectStore) MapDistanceTypes(mappings []projects.MappedDistanceType) (int64, error) {
+	return m.MapDistanceTypesFunc(mappings)
+}
+
+func (m *MockProjectStore) GetDistanceFeeAnalytics(year *int, statuses []string) (projects.DistanceFeeAnalytics, error) {
+	return m.GetDistanceFeeAnalyticsFunc(year, statuses)
+}
+
+func (m *MockProjectStore) GetApplicantCriteriaVersions() ([]projects.ApplicantCriteriaVersion, error) {
+	return m.GetApplicantCriteriaVersionsFunc()
+}
+
+func (m *MockProjectStore) GetApplicantCriteriaPreview(criteriaVersion int) (projects.ApplicantCriteriaPreview, error) {
+	return m.GetApplicantCriteriaPreviewFunc(criteriaVersion)
+}
+
+func (m *MockProjectStore) AddApplicantCriteriaDraft(items []projects.ApplicantCriteriaItem) (int, error) {
+	return m.AddApplicantCriteriaDraftFunc(items)
+}
+
+func (m *MockProjectStore) UpdateApplicantCriteriaDraft(criteriaVersion int, items []projects.ApplicantCriteriaItem) error {
+	return m.UpdateApplicantCriteriaDraftFunc(criteriaVersion, items)
+}
+
+func (m *MockProjectStore) DeleteApplicantCriteriaDraft(criteriaVersion int) error {
+	return m.DeleteApplicantCriteriaDraftFunc(criteriaVersion)
+}
+
+func (m *MockProjectStore) PublishApplicantCriteria(criteriaVersion int) error {
+	return m.PublishApplicantCriteriaFunc(criteriaVersion)
+}
+
+type MockEventStore struct {
+	GetCalendarEventsFunc       func(filter events.CalendarFilter, now time.Time) ([]events.CalendarEvent, error)
+	GetCalendarFeedUserIdFunc   func(token string) (int, error)
+	GetCalendarFeedTokenFunc    func(userId int) (string, error)
+	RotateCalendarFeedTokenFunc func(userId int) (string, error)
+	GetPublicEventsFunc         func(filter events.PublicEventFilter) (events.PublicEventPage, error)
+	GetEventMapFunc             func(filter events.EventMapFilter) ([]events.EventMapArea, error)
+}
+
+func (m *MockEventStore) GetCalendarEvents(filter events.CalendarFilter, now time.Time) ([]events.CalendarEvent, error) {
+	return m.GetCalendarEventsFunc(filter, now)
+}
+
+func (m *MockEventStore) GetCalendarFeedUserId(token string) (int, error) {
+	return m.GetCalendarFeedUserIdFunc(token)
+}
+
+func (m *MockEventStore) GetCalendarFeedToken(userId int) (string, error) {
+	return m.GetCalendarFeedTokenFunc(userId)
+}
+
+func (m *MockEventStore) RotateCalendarFeedToken(userId int) (string, error) {
+	return m.RotateCalendarFeedTokenFunc(userId)
+}
+
+func (m *MockEventStore) GetPublicEvents(filter events.PublicEventFilter) (events.PublicEventPage, error) {
+	return m.GetPublicEventsFunc(filter)
+}
+
+func (m *MockEventStore) GetEventMap(filter events.EventMapFilter) ([]events.EventMapArea, error) {
+	return m.GetEventMapFunc(filter)
+}
+
+type MockCompletionReportStore struct {
+	GetReportProjectFunc        func(projectCode string) (completionReport.ReportProject, error)
+	HasOpenReportFunc           func(projectCode string) (bool, error)
+	SubmitReportFunc            func(payload completionReport.SubmitCompletionReportRequest, projectCode string, project completionReport.ReportProject, photoFiles, receiptFiles []*multipart.FileHeader) (int, error)
+	GetReportsByProjectCodeFunc func(projectCode string) ([]completionReport.CompletionReport, error)
+	ReviewReportFunc            func(reportId int, payload completionReport.ReviewCompletionReportRequest, adminId int) error
+}
+
+func (m *MockCompletionReportStore) GetReportProject(projectCode string) (completionReport.ReportProject, error) {
+	return m.GetReportProjectFunc(projectCode)
+}
+
+func (m *MockCompletionReportStore) HasOpenReport(projectCode string) (bool, error) {
+	return m.HasOpenReportFunc(projectCode)
+}
+
+func (m *MockCompletionReportStore) SubmitReport(payload completionReport.SubmitCompletionReportRequest, projectCode string, project completionReport.ReportProject, photoFiles, receiptFiles []*multipart.FileHeader) (int, error) {
+	return m.SubmitReportFunc(payload, projectCode, project, photoFiles, receiptFiles)
+}
+
+func (m *MockCompletionReportStore) GetReportsByProjectCode(projectCode string) ([]completionReport.CompletionReport, error) {
+	return m.GetReportsByProjectCodeFunc(projectCode)
+}
+
+func (m *MockCompletionReportStore) ReviewReport(reportId int, payload completionReport.ReviewCompletionReportRequest, adminId int) error {
+	return m.ReviewReportFunc(reportId, payload, adminId)
+}
+
+type MockDisbursementStore struct {
+	GetLedgerProjectFunc             func(projectCode string) (disbursement.LedgerProject, error)
+	GetLedgerFunc                    func(project disbursement.LedgerProject) (disbursement.Ledger, error)
+	AddEntryFunc                     func(projectCode string, payload disbursement.AddEntryRequest, adminId int, proofFiles []*multipart.FileHeader) (int, error)
+	GetEntryByIdFunc                 func(entryId int) (disbursement.Entry, error)
+	MarkEntryPaidFunc                func(entry disbursement.Entry, payload disbursement.MarkPaidRequest, proofFiles []*multipart.FileHeader) error
+	GenerateReconciliationReportFunc func(fromDate, toDate time.Time) (*bytes.Buffer, error)
+}
+
+func (m *MockDisbursementStore) GetLedgerProject(projectCode string) (disbursement.LedgerProject, error) {
+	return m.GetLedgerProjectFunc(projectCode)
+}
+
+func (m *MockDisbursementStore) GetLedger(project disbursement.LedgerProject) (disbursement.Ledger, error) {
+	return m.GetLedgerFunc(project)
+}
+
+func (m *MockDisbursementStore) AddEntry(projectCode string, payload disbursement.AddEntryRequest, adminId int, proofFiles []*multipart.FileHeader) (int, error) {
+	return m.AddEntryFunc(projectCode, payload, adminId, proofFiles)
+}
+
+func (m *MockDisbursementStore) GetEntryById(entryId int) (disbursement.Entry, error) {
+	return m.GetEntryByIdFunc(entryId)
+}
+
+func (m *MockDisbursementStore) MarkEntryPaid(entry disbursement.Entry, payload disbursement.MarkPaidRequest, proofFiles []*multipart.FileHeader) error {
+	return m.MarkEntryPaidFunc(entry, payload, proofFiles)
+}
+
+func (m *MockDisbursementStore) GenerateReconciliationReport(fromDate, toDate time.Time) (*bytes.Buffer, error) {
+	return m.GenerateReconciliationReportFunc(fromDate, toDate)
+}
+
+type MockFundingRoundStore struct {
+	GetOpenFundingRoundFunc     func(now time.Time) (fundingRound.FundingRound, error)
+	GetFundingRoundByIdFunc     func(roundId int) (fundingRound.FundingRound, error)
+	GetFundingRoundsFunc        func() ([]fundingRound.FundingRoundListItem, error)
+	GetOverlappingRoundNameFunc func(openAt, closeAt time.Time, excludeRoundId int) (string, error)
+	HasCriteriaVersionsFunc     func(applicantVersion, reviewerVersion int) (bool, bool, error)
+	AddFundingRoundFunc         func(payload fundingRound.FundingRoundRequest) (int, error)
+	UpdateFundingRoundFunc      func(roundId int, payload fundingRound.FundingRoundRequest) error
+}
+
+func (m *MockFundingRoundStore) GetOpenFundingRound(now time.Time) (fundingRound.FundingRound, error) {
+	return m.GetOpenFundingRoundFunc(now)
+}
+
+func (m *MockFundingRoundStore) GetFundingRoundById(roundId int) (fundingRound.FundingRound, error) {
+	return m.GetFundingRoundByIdFunc(roundId)
+}
+
+func (m *MockFundingRoundStore) GetFundingRounds() ([]fundingRound.FundingRoundListItem, error) {
+	return m.GetFundingRoundsFunc()
+}
+
+func (m *MockFundingRoundStore) GetOverlappingRoundName(openAt, closeAt time.Time, excludeRoundId int) (string, error) {
+	return m.GetOverlappingRoundNameFunc(openAt, closeAt, excludeRoundId)
+}
+
+func (m *MockFundingRoundStore) HasCriteriaVersions(applicantVersion, reviewerVersion int) (bool, bool, error) {
+	return m.HasCriteriaVersionsFunc(applicantVersion, reviewerVersion)
+}
+
+func (m *MockFundingRoundStore) AddFundingRound(payload fundingRound.FundingRoundRequest) (int, error) {
+	return m.AddFundingRoundFunc(payload)
+}
+
+func (m *MockFundingRoundStore) UpdateFundingRound(roundId int, payload fundingRound.FundingRoundRequest) error {
+	return m.UpdateFundingRoundFunc(roundId, payload)
+}
+
+type MockOperationConfigStore struct {
+	GetLatestConfigFunc func() (operationConfig.OperationConfig, error)
+}
+
+func (m *MockOperationConfigStore) GetLatestConfig() (operationConfig.OperationConfig, error) {
+	return m.GetLatestConfigFunc()
+}
+
+type MockReviewStore struct {
+	AddReviewFunc                        func(payload review.AddReviewRequest, userId int, criteriaList []review.ProjectReviewCriteriaMinimal, reviewerThreshold int) (int, error)
+	GetProjectCriteriaMinimalDetailsFunc func(cv int) ([]review.ProjectReviewCriteriaMinimal, error)
+	GetProjectFundingRoundIdFunc         func(projectHistoryId int) (int, error)
+}
+
+func (m *MockReviewStore) AddReview(payload review.AddReviewRequest, userId int, criteriaList []review.ProjectReviewCriteriaMinimal, reviewerThreshold int) (int, error) {
+	return m.AddReviewFunc(payload, userId, criteriaList, reviewerThreshold)
+}
+
+func (m *MockReviewStore) GetProjectCriteriaMinimalDetails(cv int) ([]review.ProjectReviewCriteriaMinimal, error) {
+	return m.GetProjectCriteriaMinimalDetailsFunc(cv)
+}
+
+func (m *MockReviewStore) GetProjectFundingRoundId(projectHistoryId int) (int, error) {
+	return m.GetProjectFundingRoundIdFunc(projectHistoryId)
 }
@@ -0,0 +1,79 @@
+package projects
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const APPLICANT_CRITERIA_DRAFT = "draft"
+const APPLICANT_CRITERIA_PUBLISHED = "published"
+
+const APPLICANT_CRITERIA_MAX_ITEMS = 30
+const APPLICANT_CRITERIA_TEXT_MAX_LENGTH = 512
+
+type ApplicantCriteriaVersion struct {
+	CriteriaVersion   int        `json:"criteriaVersion"`
+	Status            string     `json:"status"`
+	ItemCount         int        `json:"itemCount"`
+	FundingRoundCount int        `json:"fundingRoundCount"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+	PublishedAt       *time.Time `json:"publishedAt"`
+}
+
+type ApplicantCriteriaItem struct {
+	OrderNumber int    `json:"orderNumber"`
+	Display     string `json:"display"`
+	PdfDisplay  string `json:"pdfDisplay"`
+}
+
+type ApplicantCriteriaRequest struct {
+	Items []ApplicantCriteriaItem `json:"items"`
+}
+
+// ApplicantCriteriaPreview shows a version with both the form and the PDF text, drafts included
+type ApplicantCriteriaPreview struct {
+	CriteriaVersion int                          `json:"criteriaVersion"`
+	Status          string                       `json:"status"`
+	CreatedAt       time.Time                    `json:"createdAt"`
+	UpdatedAt       time.Time                    `json:"updatedAt"`
+	PublishedAt     *time.Time                   `json:"publishedAt"`
+	Items           []ApplicantSelfScoreCriteria `json:"items"`
+}
+
+// validateApplicantCriteriaPayload checks order numbers run from 1 without gaps,
+// scores are keyed by q_<version>_<orderNumber> and the form numbers the questions the same way
+func validateApplicantCriteriaPayload(payload ApplicantCriteriaRequest) (string, error) {
+	if len(payload.Items) == 0 {
+		return "items", &ApplicantCriteriaItemsRequiredError{}
+	}
+	if len(payload.Items) > APPLICANT_CRITERIA_MAX_ITEMS {
+		return "items", &ApplicantCriteriaTooManyItemsError{}
+	}
+	seen := map[int]bool{}
+	for i, item := range payload.Items {
+		if item.OrderNumber < 1 || item.OrderNumber > len(payload.Items) || seen[item.OrderNumber] {
+			return fmt.Sprintf("items[%d].orderNumber", i), &ApplicantCriteriaOrderNumberInvalidError{Count: len(payload.Items)}
+		}
+		seen[item.OrderNumber] = true
+
+		texts := []struct {
+			name  string
+			value string
+		}{
+			{name: fmt.Sprintf("items[%d].display", i), value: item.Display},
+			{name: fmt.Sprintf("items[%d].pdfDisplay", i), value: item.PdfDisplay},
+		}
+		for _, text := range texts {
+			if strings.TrimSpace(text.value) == "" {
+				return text.name, &ApplicantCriteriaTextRequiredError{Name: text.name}
+			}
+			if utf8.RuneCountInString(text.value) > APPLICANT_CRITERIA_TEXT_MAX_LENGTH {
+				return text.name, &ApplicantCriteriaTextTooLongError{Name: text.name}
+			}
+		}
+	}
+	return "", nil
+}
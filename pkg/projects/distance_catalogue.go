@@ -0,0 +1,217 @@
+package projects
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const FUN_RUN_MAX_KM = 10
+const MARATHON_KM = 42.195
+
+// DISTANCE_CATALOGUE mirrors the codes seeded in the distance_catalogue table
+var DISTANCE_CATALOGUE = map[string]bool{
+	"fun":    true,
+	"mini":   true,
+	"half":   true,
+	"full":   true,
+	"ultra":  true,
+	"custom": true,
+}
+
+// standardDistanceKm are the catalogue distances with a fixed length, applicants cannot change them
+var standardDistanceKm = map[string]float64{
+	"mini": 10,
+	"half": 21.0975,
+	"full": MARATHON_KM,
+}
+
+var distanceKmPattern = regexp.MustCompile(`(?i)(\d+(?:\.\d+)?)\s*(k|km|กม\.?|กิโลเมตร|กิโล)?(?:\s|$|[^\p{L}])`)
+
+// keywords are checked in order, mini and half before full as their names contain "marathon" too
+var distanceKeywords = []struct {
+	code     string
+	keywords []string
+}{
+	{code: "ultra", keywords: []string{"ultra", "อัลตร้า", "อัลตรา", "อุลตร้า"}},
+	{code: "fun", keywords: []string{"fun", "ฟันรัน", "ฟัน รัน"}},
+	{code: "mini", keywords: []string{"mini", "มินิ"}},
+	{code: "half", keywords: []string{"half", "ฮาล์ฟ", "ฮาฟ", "ฮาร์ฟ"}},
+	{code: "full", keywords: []string{"full", "marathon", "มาราธอน", "ฟูล"}},
+}
+
+type DistanceCatalogueItem struct {
+	Code        string   `json:"code"`
+	Name        string   `json:"name"`
+	Km          *float64 `json:"km"`
+	KmRequired  bool     `json:"kmRequired"`
+	OrderNumber int      `json:"orderNumber"`
+}
+
+type DistanceMapping struct {
+	Code string   `json:"code"`
+	Km   *float64 `json:"km"`
+}
+
+type MapDistancesRequest struct {
+	DryRun bool `json:"dryRun"`
+	// Overrides are keyed by the distance type exactly as it is stored
+	Overrides map[string]DistanceMapping `json:"overrides,omitempty"`
+}
+
+type DistanceTypeCount struct {
+	Type string `json:"type"`
+	Rows int    `json:"rows"`
+}
+
+type MappedDistanceType struct {
+	Type string   `json:"type"`
+	Code string   `json:"code"`
+	Km   *float64 `json:"km"`
+	Rows int      `json:"rows"`
+}
+
+type MapDistancesResponse struct {
+	DryRun      bool                 `json:"dryRun"`
+	Mapped      []MappedDistanceType `json:"mapped"`
+	Unmapped    []DistanceTypeCount  `json:"unmapped"`
+	RowsUpdated int64                `json:"rowsUpdated"`
+}
+
+type DistanceFeeAnalyticsRequest struct {
+	Year     *int     `json:"year,omitempty"`
+	Statuses []string `json:"statuses,omitempty"`
+}
+
+type DistanceFeeStat struct {
+	Code      string  `json:"code"`
+	Name      string  `json:"name"`
+	Count     int     `json:"count"`
+	MinFee    float64 `json:"minFee"`
+	MaxFee    float64 `json:"maxFee"`
+	AvgFee    float64 `json:"avgFee"`
+	MedianFee float64 `json:"medianFee"`
+}
+
+type DistanceFeeAnalytics struct {
+	Distances []DistanceFeeStat `json:"distances"`
+	// UnmappedCount is the number of distances left out because they have no catalogue code yet
+	UnmappedCount int `json:"unmappedCount"`
+}
+
+// MapDistanceType guesses the catalogue entry of a free text distance such as "10K", "10 km" or "มินิมาราธอน"
+func MapDistanceType(raw string) (string, *float64, bool) {
+	text := strings.ToLower(strings.Join(strings.Fields(raw), " "))
+	if text == "" {
+		return "", nil, false
+	}
+	var km *float64
+	if match := distanceKmPattern.FindStringSubmatch(text); match != nil {
+		v, err := strconv.ParseFloat(match[1], 64)
+		if err == nil && v > 0 {
+			km = &v
+		}
+	}
+
+	for _, k := range distanceKeywords {
+		for _, keyword := range k.keywords {
+			if !strings.Contains(text, keyword) {
+				continue
+			}
+			if std, found := standardDistanceKm[k.code]; found {
+				return k.code, &std, true
+			}
+			if k.code == "ultra" && (km == nil || *km <= MARATHON_KM) {
+				return "", nil, false
+			}
+			if k.code == "fun" && km != nil && *km > FUN_RUN_MAX_KM {
+				km = nil
+			}
+			return k.code, km, true
+		}
+	}
+
+	if km == nil {
+		return "", nil, false
+	}
+	code := distanceCodeForKm(*km)
+	if std, found := standardDistanceKm[code]; found {
+		return code, &std, true
+	}
+	return code, km, true
+}
+
+// distanceCodeForKm puts a bare length in the catalogue, GPS rounded lengths like 21 or 42 still count as standard
+func distanceCodeForKm(km float64) string {
+	for code, std := range standardDistanceKm {
+		if math.Abs(km-std) <= 0.3 {
+			return code
+		}
+	}
+	if km < FUN_RUN_MAX_KM {
+		return "fun"
+	}
+	if km > MARATHON_KM {
+		return "ultra"
+	}
+	return "custom"
+}
+
+func validateDistanceChoice(code string, km *float64) error {
+	if !DISTANCE_CATALOGUE[code] {
+		return &DistanceCodeInvalidError{Code: code}
+	}
+	if km != nil && *km <= 0 {
+		return &DistanceKmInvalidError{Code: code}
+	}
+	if (code == "custom" || code == "ultra") && km == nil {
+		return &DistanceKmRequiredError{Code: code}
+	}
+	if code == "ultra" && *km <= MARATHON_KM {
+		return &DistanceKmInvalidError{Code: code}
+	}
+	if code == "fun" && km != nil && *km > FUN_RUN_MAX_KM {
+		return &DistanceKmInvalidError{Code: code}
+	}
+	return nil
+}
+
+// resolveDistance returns the catalogue code and length to store, distances without a code are mapped from their type
+func resolveDistance(d DistanceAndFee) (*string, *float64) {
+	if d.Code != "" {
+		code := d.Code
+		if std, found := standardDistanceKm[code]; found {
+			return &code, &std
+		}
+		return &code, d.Km
+	}
+	code, km, ok := MapDistanceType(d.Type)
+	if !ok {
+		return nil, nil
+	}
+	return &code, km
+}
+
+// mapDistanceTypes applies the overrides first and guesses the rest
+func mapDistanceTypes(types []DistanceTypeCount, overrides map[string]DistanceMapping) ([]MappedDistanceType, []DistanceTypeCount) {
+	mapped := []MappedDistanceType{}
+	unmapped := []DistanceTypeCount{}
+	for _, t := range types {
+		if o, found := overrides[t.Type]; found {
+			km := o.Km
+			if std, found := standardDistanceKm[o.Code]; found {
+				km = &std
+			}
+			mapped = append(mapped, MappedDistanceType{Type: t.Type, Code: o.Code, Km: km, Rows: t.Rows})
+			continue
+		}
+		code, km, ok := MapDistanceType(t.Type)
+		if !ok {
+			unmapped = append(unmapped, t)
+			continue
+		}
+		mapped = append(mapped, MappedDistanceType{Type: t.Type, Code: code, Km: km, Rows: t.Rows})
+	}
+	return mapped, unmapped
+}
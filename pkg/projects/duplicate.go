@@ -0,0 +1,149 @@
+package projects
+
+import (
+	"math"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	DUPLICATE_SCORE_THRESHOLD = 0.7
+	// candidates are only looked up around the event date of the new proposal
+	DUPLICATE_SEARCH_DAYS = 7
+)
+
+var DUPLICATE_ACTION = map[string]string{
+	"link":               "Linked",
+	"close-as-duplicate": "ClosedAsDuplicate",
+	"merge":              "Merged",
+	"dismiss":            "Dismissed",
+}
+
+// DUPLICATE_MERGE_DIR is the folder under the surviving project that keeps a merged proposal's files
+const DUPLICATE_MERGE_DIR = "merged"
+
+type duplicateCandidate struct {
+	ProjectCode      string
+	ProjectName      string
+	FromDate         time.Time
+	ProvinceId       *int
+	DistrictId       *int
+	OrganizerName    string
+	OrganizationName string
+}
+
+type duplicateSubject struct {
+	ProjectName      string
+	FromDate         time.Time
+	ProvinceId       int
+	DistrictId       int
+	OrganizerName    string
+	OrganizationName string
+}
+
+// normaliseName keeps letters, digits and Thai combining marks so spacing,
+// punctuation and letter case do not hide a duplicate
+func normaliseName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// nameSimilarity is the Dice coefficient over rune bigrams, which works for Thai text without word breaks
+func nameSimilarity(a, b string) float64 {
+	ra := []rune(normaliseName(a))
+	rb := []rune(normaliseName(b))
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+	if string(ra) == string(rb) {
+		return 1
+	}
+	if len(ra) < 2 || len(rb) < 2 {
+		return 0
+	}
+	bigrams := map[string]int{}
+	for i := 0; i < len(ra)-1; i++ {
+		bigrams[string(ra[i:i+2])]++
+	}
+	matches := 0
+	for i := 0; i < len(rb)-1; i++ {
+		key := string(rb[i : i+2])
+		if bigrams[key] > 0 {
+			bigrams[key]--
+			matches++
+		}
+	}
+	return float64(2*matches) / float64(len(ra)-1+len(rb)-1)
+}
+
+// scoreDuplicate weighs name 0.5, event date 0.25, area 0.15 and organiser 0.1
+func scoreDuplicate(subject duplicateSubject, candidate duplicateCandidate) (float64, []string) {
+	var score float64
+	reasons := []string{}
+
+	sim := nameSimilarity(subject.ProjectName, candidate.ProjectName)
+	score += 0.5 * sim
+	if sim >= 0.6 {
+		reasons = append(reasons, "name")
+	}
+
+	days := math.Abs(subject.FromDate.Sub(candidate.FromDate).Hours() / 24)
+	if days < 1 {
+		score += 0.25
+		reasons = append(reasons, "date")
+	} else if days < 2 {
+		score += 0.15
+		reasons = append(reasons, "date")
+	}
+
+	if candidate.DistrictId != nil && *candidate.DistrictId == subject.DistrictId {
+		score += 0.15
+		reasons = append(reasons, "district")
+	} else if candidate.ProvinceId != nil && *candidate.ProvinceId == subject.ProvinceId {
+		score += 0.05
+		reasons = append(reasons, "province")
+	}
+
+	if sameOrganiser(subject, candidate) {
+		score += 0.1
+		reasons = append(reasons, "organizer")
+	}
+	return math.Round(score*1000) / 1000, reasons
+}
+
+func sameOrganiser(subject duplicateSubject, candidate duplicateCandidate) bool {
+	pairs := [][2]string{
+		{subject.OrganizerName, candidate.OrganizerName},
+		{subject.OrganizationName, candidate.OrganizationName},
+	}
+	for _, p := range pairs {
+		if normaliseName(p[0]) != "" && nameSimilarity(p[0], p[1]) >= 0.8 {
+			return true
+		}
+	}
+	return false
+}
+
+type ProjectDuplicate struct {
+	Id                     int        `json:"id"`
+	ProjectCode            string     `json:"projectCode"`
+	DuplicateOfProjectCode string     `json:"duplicateOfProjectCode"`
+	OtherProjectName       string     `json:"otherProjectName"`
+	OtherProjectStatus     string     `json:"otherProjectStatus"`
+	Score                  float64    `json:"score"`
+	Reasons                []string   `json:"reasons"`
+	Status                 string     `json:"status"`
+	ResolvedBy             *int       `json:"resolvedBy,omitempty"`
+	ResolvedAt             *time.Time `json:"resolvedAt,omitempty"`
+	CreatedAt              time.Time  `json:"createdAt"`
+}
+
+type ResolveDuplicateRequest struct {
+	Action string `json:"action"`
+}
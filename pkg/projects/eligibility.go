@@ -0,0 +1,180 @@
+package projects
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+// Rule types an admin can configure, evaluated in this order
+const (
+	RULE_NO_ALCOHOL_SPONSOR         = "noAlcoholSponsor"
+	RULE_MIN_LEAD_TIME_DAYS         = "minLeadTimeDays"
+	RULE_MAX_ORGANIZATION_PROPOSALS = "maxOrganizationProposals"
+	RULE_MAX_REQUESTED_FUND_AMOUNT  = "maxRequestedFundAmount"
+	ELIGIBILITY_MESSAGE_MAX_LENGTH  = 512
+	eligibilityFailedMessage        = "ข้อเสนอโครงการไม่ผ่านเกณฑ์คุณสมบัติ"
+)
+
+var ELIGIBILITY_RULE_TYPE = map[string]bool{
+	RULE_NO_ALCOHOL_SPONSOR:         true,
+	RULE_MIN_LEAD_TIME_DAYS:         true,
+	RULE_MAX_ORGANIZATION_PROPOSALS: true,
+	RULE_MAX_REQUESTED_FUND_AMOUNT:  true,
+}
+
+type EligibilityRuleParams struct {
+	Days   *int `json:"days,omitempty"`
+	Max    *int `json:"max,omitempty"`
+	Amount *int `json:"amount,omitempty"`
+}
+
+type EligibilityRule struct {
+	Id             int                   `json:"id"`
+	RuleType       string                `json:"ruleType"`
+	Params         EligibilityRuleParams `json:"params"`
+	Message        *string               `json:"message,omitempty"`
+	FundingRoundId *int                  `json:"fundingRoundId,omitempty"`
+	Enabled        bool                  `json:"enabled"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	UpdatedAt      time.Time             `json:"updatedAt"`
+}
+
+type EligibilityRuleRequest struct {
+	RuleType       string                `json:"ruleType,omitempty"`
+	Params         EligibilityRuleParams `json:"params,omitempty"`
+	Message        *string               `json:"message,omitempty"`
+	FundingRoundId *int                  `json:"fundingRoundId,omitempty"`
+	Enabled        *bool                 `json:"enabled,omitempty"`
+}
+
+type EligibilityFailure struct {
+	RuleId   int    `json:"ruleId"`
+	RuleType string `json:"ruleType"`
+	Message  string `json:"message"`
+}
+
+type EligibilityResponse struct {
+	Eligible bool                 `json:"eligible"`
+	Failures []EligibilityFailure `json:"failures"`
+}
+
+// Facts the rules need besides the payload, loaded by the handler
+type EligibilityFacts struct {
+	Now                         time.Time
+	ActiveOrganizationProposals int
+}
+
+func needsOrganizationProposalCount(rules []EligibilityRule) bool {
+	for _, rule := range rules {
+		if rule.RuleType == RULE_MAX_ORGANIZATION_PROPOSALS {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluateEligibility returns every failing rule, an empty list means the proposal is eligible
+func evaluateEligibility(rules []EligibilityRule, payload AddProjectRequest, facts EligibilityFacts) []EligibilityFailure {
+	failures := []EligibilityFailure{}
+	for _, rule := range rules {
+		passed, defaultMessage := evaluateRule(rule, payload, facts)
+		if passed {
+			continue
+		}
+		message := defaultMessage
+		if rule.Message != nil && strings.TrimSpace(*rule.Message) != "" {
+			message = *rule.Message
+		}
+		failures = append(failures, EligibilityFailure{
+			RuleId:   rule.Id,
+			RuleType: rule.RuleType,
+			Message:  message,
+		})
+	}
+	return failures
+}
+
+// eligibilityValidationError reports each failing rule at /eligibility/{ruleType} with the rule type as code
+func eligibilityValidationError(failures []EligibilityFailure) *utils.ValidationError {
+	errs := &utils.ValidationError{}
+	for _, failure := range failures {
+		errs.Add("/eligibility/"+failure.RuleType, failure.RuleType, eligibilityFailedMessage, failure.Message)
+	}
+	return errs
+}
+
+func evaluateRule(rule EligibilityRule, payload AddProjectRequest, facts EligibilityFacts) (bool, string) {
+	switch rule.RuleType {
+	case RULE_NO_ALCOHOL_SPONSOR:
+		return payload.Fund.Budget.NoAlcoholSponsor, "โครงการต้องไม่ได้รับการสนับสนุนจากผลิตภัณฑ์เครื่องดื่มแอลกอฮอล์และบุหรี่"
+	case RULE_MIN_LEAD_TIME_DAYS:
+		if rule.Params.Days == nil {
+			return true, ""
+		}
+		days := *rule.Params.Days
+		message := fmt.Sprintf("ต้องยื่นข้อเสนอโครงการล่วงหน้าอย่างน้อย %d วันก่อนวันจัดงาน", days)
+		eventDate := payload.General.EventDate
+		// not filled in yet, validateAddProjectPayload reports it on submission
+		if eventDate.Year == 0 || eventDate.Month == 0 || eventDate.Day == 0 {
+			return true, message
+		}
+		loc, err := getTimeLocation()
+		if err != nil {
+			return false, message
+		}
+		eventDay := time.Date(eventDate.Year, time.Month(eventDate.Month), eventDate.Day, 0, 0, 0, 0, loc)
+		nowLocal := facts.Now.In(loc)
+		today := time.Date(nowLocal.Year(), nowLocal.Month(), nowLocal.Day(), 0, 0, 0, 0, loc)
+		return !eventDay.Before(today.AddDate(0, 0, days)), message
+	case RULE_MAX_ORGANIZATION_PROPOSALS:
+		if rule.Params.Max == nil {
+			return true, ""
+		}
+		max := *rule.Params.Max
+		return facts.ActiveOrganizationProposals < max, fmt.Sprintf("องค์กรนี้ยื่นข้อเสนอโครงการในรอบนี้ครบ %d โครงการแล้ว", max)
+	case RULE_MAX_REQUESTED_FUND_AMOUNT:
+		if rule.Params.Amount == nil {
+			return true, ""
+		}
+		amount := *rule.Params.Amount
+		requested := 0
+		if payload.Fund.Request.Type.Fund {
+			requested = payload.Fund.Request.Details.FundAmount
+		}
+		return requested <= amount, fmt.Sprintf("จำนวนเงินที่ขอรับการสนับสนุนต้องไม่เกิน %d บาท", amount)
+	default:
+		// unknown rules are rejected on save, never block a submission because of one
+		return true, ""
+	}
+}
+
+func validateEligibilityRulePayload(payload EligibilityRuleRequest) (string, error) {
+	if !ELIGIBILITY_RULE_TYPE[payload.RuleType] {
+		return "ruleType", &EligibilityRuleTypeInvalidError{}
+	}
+	switch payload.RuleType {
+	case RULE_MIN_LEAD_TIME_DAYS:
+		if payload.Params.Days == nil || *payload.Params.Days <= 0 {
+			return "params.days", &EligibilityRuleParamInvalidError{Name: "days"}
+		}
+	case RULE_MAX_ORGANIZATION_PROPOSALS:
+		if payload.Params.Max == nil || *payload.Params.Max <= 0 {
+			return "params.max", &EligibilityRuleParamInvalidError{Name: "max"}
+		}
+	case RULE_MAX_REQUESTED_FUND_AMOUNT:
+		if payload.Params.Amount == nil || *payload.Params.Amount <= 0 {
+			return "params.amount", &EligibilityRuleParamInvalidError{Name: "amount"}
+		}
+	}
+	if payload.Message != nil && utf8.RuneCountInString(*payload.Message) > ELIGIBILITY_MESSAGE_MAX_LENGTH {
+		return "message", &EligibilityRuleMessageTooLongError{}
+	}
+	if payload.Enabled == nil {
+		return "enabled", &EligibilityRuleEnabledRequiredError{}
+	}
+	return "", nil
+}
@@ -2,40 +2,7 @@ package projects
 
 import "fmt"
 
-type InvalidError struct {
-	Name string
-}
-
-func (e *InvalidError) Error() string {
-	return fmt.Sprintf("%s is invalid", e.Name)
-}
-
-// Collaborated
-type CollaboratedRequiredError struct{}
-
-func (e *CollaboratedRequiredError) Error() string {
-	return "collaborated is required"
-}
-
-type CollaboratedFilesRequiredError struct{}
-
-func (e *CollaboratedFilesRequiredError) Error() string {
-	return "collaboratedFiles are required"
-}
-
 // General
-type ProjectNameRequiredError struct{}
-
-func (e *ProjectNameRequiredError) Error() string {
-	return "projectName is required"
-}
-
-type YearRequiredError struct{}
-
-func (e *YearRequiredError) Error() string {
-	return "year is required"
-}
-
 type YearInvalidError struct{}
 
 func (e *YearInvalidError) Error() string {
@@ -54,904 +21,593 @@ func (e *MonthOutOfBoundError) Error() string {
 	return "month must greater than > 0 and <= 12"
 }
 
-type DayRequiredError struct{}
-
-func (e *DayRequiredError) Error() string {
-	return "day is required"
-}
-
-type DayOutOfBoundError struct{}
-
-func (e *DayOutOfBoundError) Error() string {
-	return "day is not valid"
-}
-
-type FromHourRequiredError struct{}
-
-func (e *FromHourRequiredError) Error() string {
-	return "fromHour is required"
-}
-
-type FromMinuteRequiredError struct{}
-
-func (e *FromMinuteRequiredError) Error() string {
-	return "fromMinute is required"
-}
-
-type ToHourRequiredError struct{}
-
-func (e *ToHourRequiredError) Error() string {
-	return "toHour is required"
-}
-
-type ToMinuteRequiredError struct{}
-
-func (e *ToMinuteRequiredError) Error() string {
-	return "toMinute is required"
-}
-
-type GeneralAddressRequiredError struct{}
-
-func (e *GeneralAddressRequiredError) Error() string {
-	return "general address is required"
-}
-
-type GeneralProvinceRequiredError struct{}
-
-func (e *GeneralProvinceRequiredError) Error() string {
-	return "general provinceId is required"
-}
-
-type GeneralDistrictIdRequiredError struct{}
-
-func (e *GeneralDistrictIdRequiredError) Error() string {
-	return "general districtId is required"
-}
-
-type GeneralSubdistrictIdRequiredError struct{}
-
-func (e *GeneralSubdistrictIdRequiredError) Error() string {
-	return "general subdistrictIdId is required"
-}
-
-type GeneralPostcodeIdRequiredError struct{}
-
-func (e *GeneralPostcodeIdRequiredError) Error() string {
-	return "general postcodeId is required"
-}
-
-type StartPointRequiredError struct{}
-
-func (e *StartPointRequiredError) Error() string {
-	return "startPoint is required"
-}
-
-type FinishPointRequiredError struct{}
-
-func (e *FinishPointRequiredError) Error() string {
-	return "finishPoint is required"
-}
-
-type CategoryAvailableRequiredOneError struct{}
-
-func (e *CategoryAvailableRequiredOneError) Error() string {
-	return "must select at least one category"
-}
-
-type OtherEventTypeRequiredError struct{}
-
-func (e *OtherEventTypeRequiredError) Error() string {
-	return "otherType is required"
-}
-
-type DistanceRequiredOneError struct{}
-
-func (e *DistanceRequiredOneError) Error() string {
-	return "must select at least one distance"
-}
-
-type DistanceTypeRequiredError struct{}
-
-func (e *DistanceTypeRequiredError) Error() string {
-	return "type is required"
-}
-
-type DistanceFeeRequiredError struct{}
-
-func (e *DistanceFeeRequiredError) Error() string {
-	return "fee is required"
-}
-
-type ValueNegativeError struct{}
-
-func (e *ValueNegativeError) Error() string {
-	return "value must >= 0"
-}
-
-type DistanceAndFeeDynamicRequiredError struct{}
-
-func (e *DistanceAndFeeDynamicRequiredError) Error() string {
-	return "dynamic is required"
-}
-
-type VIPRequiredError struct{}
-
-func (e *VIPRequiredError) Error() string {
-	return "vip is required"
-}
-
-type VIPFeeRequiredError struct{}
-
-func (e *VIPFeeRequiredError) Error() string {
-	return "vipFee is required"
-}
-
-type VIPFeeNegativeError struct{}
-
-func (e *VIPFeeNegativeError) Error() string {
-	return "vipFee must greater equal to zero"
-}
-
-type ExpectedParticipantsRequiredError struct{}
-
-func (e *ExpectedParticipantsRequiredError) Error() string {
-	return "expectedParticipants is required"
-}
-
 type ExpectedParticipantsInvalidError struct{}
 
 func (e *ExpectedParticipantsInvalidError) Error() string {
 	return "expectedParticipants is invalid"
 }
 
-type HasOrganizerRequiredError struct{}
-
-func (e *HasOrganizerRequiredError) Error() string {
-	return "hasOrganizer is required"
-}
-
-type OrganizerNameRequiredError struct{}
-
-func (e *OrganizerNameRequiredError) Error() string {
-	return "organizerName is required"
-}
-
-// Contact
-
-// ProjectHead
-type ProjectHeadPrefixRequiredError struct{}
-
-func (e *ProjectHeadPrefixRequiredError) Error() string {
-	return "projectHead prefix is required"
-}
-
-type ProjectHeadFirstNameRequiredError struct{}
-
-func (e *ProjectHeadFirstNameRequiredError) Error() string {
-	return "projectHead firstName is required"
-}
-
-type ProjectHeadLastNameRequiredError struct{}
+type ApplicantCriteriaNotFoundError struct{}
 
-func (e *ProjectHeadLastNameRequiredError) Error() string {
-	return "projectHead lastName is required"
+func (e *ApplicantCriteriaNotFoundError) Error() string {
+	return "applicant criteria not found"
 }
 
-type ProjectHeadOrganizationPositionRequiredError struct{}
-
-func (e *ProjectHeadOrganizationPositionRequiredError) Error() string {
-	return "projectHead organizationPosition is required"
+type ScoreRequiredError struct {
+	Name string
 }
 
-type ProjectHeadEventPositionRequiredError struct{}
-
-func (e *ProjectHeadEventPositionRequiredError) Error() string {
-	return "projectHead eventPosition is required"
+func (e *ScoreRequiredError) Error() string {
+	return fmt.Sprintf("score %s is required", e.Name)
 }
 
-// ProjectManager
-type ProjectManagerPrefixRequiredError struct{}
-
-func (e *ProjectManagerPrefixRequiredError) Error() string {
-	return "projectManager prefix is required"
+type ScoreInvalidError struct {
+	Name string
 }
 
-type ProjectManagerFirstNameRequiredError struct{}
-
-func (e *ProjectManagerFirstNameRequiredError) Error() string {
-	return "projectManager firstName is required"
+func (e *ScoreInvalidError) Error() string {
+	return fmt.Sprintf("score %s is invalid. 1 <= score <= 5", e.Name)
 }
 
-type ProjectManagerLastNameRequiredError struct{}
-
-func (e *ProjectManagerLastNameRequiredError) Error() string {
-	return "projectManager lastName is required"
+type BudgetItemsTotalMismatchError struct {
+	ItemsTotal  int
+	BudgetTotal int
 }
 
-type ProjectManagerOrganizationPositionRequiredError struct{}
-
-func (e *ProjectManagerOrganizationPositionRequiredError) Error() string {
-	return "projectManager organizationPosition is required"
+func (e *BudgetItemsTotalMismatchError) Error() string {
+	return fmt.Sprintf("budget items total %d does not match budget total %d", e.ItemsTotal, e.BudgetTotal)
 }
 
-type ProjectManagerEventPositionRequiredError struct{}
-
-func (e *ProjectManagerEventPositionRequiredError) Error() string {
-	return "projectManager eventPosition is required"
+type BudgetItemsFundRequestMismatchError struct {
+	ItemsTotal int
+	FundAmount int
 }
 
-// ProjectCoordinator
-type ProjectCoordinatorPrefixRequiredError struct{}
-
-func (e *ProjectCoordinatorPrefixRequiredError) Error() string {
-	return "projectCoordinator prefix is required"
+func (e *BudgetItemsFundRequestMismatchError) Error() string {
+	return fmt.Sprintf("runningFund budget items total %d does not match request fundAmount %d", e.ItemsTotal, e.FundAmount)
 }
 
-type ProjectCoordinatorFirstNameRequiredError struct{}
-
-func (e *ProjectCoordinatorFirstNameRequiredError) Error() string {
-	return "projectCoordinator firstName is required"
-}
-
-type ProjectCoordinatorLastNameRequiredError struct{}
+type MarketingFilesRequiredError struct{}
 
-func (e *ProjectCoordinatorLastNameRequiredError) Error() string {
-	return "projectCoordinator lastName is required"
+func (e *MarketingFilesRequiredError) Error() string {
+	return "marketingFiles are required"
 }
 
-type ProjectCoordinatorOrganizationPositionRequiredError struct{}
+type RouteFilesRequiredError struct{}
 
-func (e *ProjectCoordinatorOrganizationPositionRequiredError) Error() string {
-	return "projectCoordinator organizationPosition is required"
+func (e *RouteFilesRequiredError) Error() string {
+	return "routeFiles are required"
 }
 
-type ProjectCoordinatorEventPositionRequiredError struct{}
-
-func (e *ProjectCoordinatorEventPositionRequiredError) Error() string {
-	return "projectCoordinator eventPosition is required"
+type RouteFileInvalidError struct {
+	FileName string
 }
 
-// Head address
-type ProjectHeadAddressRequiredError struct{}
-
-func (e *ProjectHeadAddressRequiredError) Error() string {
-	return "ProjectHead address is required"
+func (e *RouteFileInvalidError) Error() string {
+	return fmt.Sprintf("routeFiles %s is not a valid GPX or KML file", e.FileName)
 }
 
-type ProjectHeadProvinceIdRequiredError struct{}
-
-func (e *ProjectHeadProvinceIdRequiredError) Error() string {
-	return "ProjectHead provinceId is required"
+type RouteFileNoTrackError struct {
+	FileName string
 }
 
-type ProjectHeadDistrictIdRequiredError struct{}
-
-func (e *ProjectHeadDistrictIdRequiredError) Error() string {
-	return "ProjectHead districtId is required"
+func (e *RouteFileNoTrackError) Error() string {
+	return fmt.Sprintf("routeFiles %s has no track with at least 2 points", e.FileName)
 }
 
-type ProjectHeadSubdistrictIdRequiredError struct{}
+type EventMapFilesRequiredError struct{}
 
-func (e *ProjectHeadSubdistrictIdRequiredError) Error() string {
-	return "ProjectHead subdistrictId is required"
+func (e *EventMapFilesRequiredError) Error() string {
+	return "EventMapFiles are required"
 }
 
-type ProjectHeadPostcodeIdRequiredError struct{}
+type EventDetailsFilesRequiredError struct{}
 
-func (e *ProjectHeadPostcodeIdRequiredError) Error() string {
-	return "ProjectHead postcodeId is required"
+func (e *EventDetailsFilesRequiredError) Error() string {
+	return "eventDetailsFiles are required"
 }
 
-type ProjectHeadEmailRequiredError struct{}
+type FilesRequiredError struct{}
 
-func (e *ProjectHeadEmailRequiredError) Error() string {
-	return "ProjectHead email is required"
+func (e *FilesRequiredError) Error() string {
+	return "additionFiles or etcFiles are required"
 }
 
-type ProjectHeadLineIdRequiredError struct{}
+type ProjectNotFoundError struct{}
 
-func (e *ProjectHeadLineIdRequiredError) Error() string {
-	return "ProjectHead lineId is required"
+func (e *ProjectNotFoundError) Error() string {
+	return "project is not found"
 }
 
-type ProjectHeadPhoneNumberRequiredError struct{}
+type ProjectCodeRequiredError struct{}
 
-func (e *ProjectHeadPhoneNumberRequiredError) Error() string {
-	return "ProjectHead phoneNumber is required"
+func (e *ProjectCodeRequiredError) Error() string {
+	return "projectCode is required"
 }
 
-type ProjectHeadPhoneNumberLengthError struct{}
+type ReviewerIdRequiredError struct{}
 
-func (e *ProjectHeadPhoneNumberLengthError) Error() string {
-	return "ProjectHead phoneNumber is shorter than 9 numbers"
+func (e *ReviewerIdRequiredError) Error() string {
+	return "reviewerId is required"
 }
 
-type ProjectHeadPhoneNumberInvalidError struct{}
+type ProjectStatusPrimaryRequiredError struct{}
 
-func (e *ProjectHeadPhoneNumberInvalidError) Error() string {
-	return "ProjectHead phoneNumber is invalid"
+func (e *ProjectStatusPrimaryRequiredError) Error() string {
+	return "projectStatusPrimary is required"
 }
 
-// Manager address
-type ProjectManagerAddressRequiredError struct{}
+type ProjectStatusPrimaryInvalidError struct{}
 
-func (e *ProjectManagerAddressRequiredError) Error() string {
-	return "ProjectManager address is required"
+func (e *ProjectStatusPrimaryInvalidError) Error() string {
+	return "projectStatusPrimary is invalid"
 }
 
-type ProjectManagerProvinceIdRequiredError struct{}
+type ProjectStatusSecondaryRequiredError struct{}
 
-func (e *ProjectManagerProvinceIdRequiredError) Error() string {
-	return "ProjectManager provinceId is required"
+func (e *ProjectStatusSecondaryRequiredError) Error() string {
+	return "projectStatusSecondary is required"
 }
 
-type ProjectManagerDistrictIdRequiredError struct{}
+type ProjectStatusSecondaryInvalidError struct{}
 
-func (e *ProjectManagerDistrictIdRequiredError) Error() string {
-	return "ProjectManager districtId is required"
+func (e *ProjectStatusSecondaryInvalidError) Error() string {
+	return "projectStatusSecondary is invalid"
 }
 
-type ProjectManagerSubdistrictIdRequiredError struct{}
+type AdminScoreOutOfRangeError struct{}
 
-func (e *ProjectManagerSubdistrictIdRequiredError) Error() string {
-	return "ProjectManager subdistrictId is required"
+func (e *AdminScoreOutOfRangeError) Error() string {
+	return "adminScore is out of range 0-100"
 }
 
-type ProjectManagerPostcodeIdRequiredError struct{}
+type FundApprovedAmountNegativeError struct{}
 
-func (e *ProjectManagerPostcodeIdRequiredError) Error() string {
-	return "ProjectManager postcodeId is required"
+func (e *FundApprovedAmountNegativeError) Error() string {
+	return "fundApprovedAmount is negative"
 }
 
-type ProjectManagerEmailRequiredError struct{}
-
-func (e *ProjectManagerEmailRequiredError) Error() string {
-	return "ProjectManager email is required"
+type AdminCommentTooLongError struct {
+	Length int
 }
 
-type ProjectManagerLineIdRequiredError struct{}
-
-func (e *ProjectManagerLineIdRequiredError) Error() string {
-	return "ProjectManager lineId is required"
+func (e *AdminCommentTooLongError) Error() string {
+	return fmt.Sprintf("adminComment length is over 512 characters, got %d", e.Length)
 }
 
-type ProjectManagerPhoneNumberRequiredError struct{}
+type FromYearRequiredError struct{}
 
-func (e *ProjectManagerPhoneNumberRequiredError) Error() string {
-	return "ProjectManager phoneNumber is required"
+func (e *FromYearRequiredError) Error() string {
+	return "fromYear is required"
 }
 
-type ProjectManagerPhoneNumberLengthError struct{}
+type ToYearRequiredError struct{}
 
-func (e *ProjectManagerPhoneNumberLengthError) Error() string {
-	return "ProjectManager phoneNumber is shorter than 9 numbers"
+func (e *ToYearRequiredError) Error() string {
+	return "toYear is required"
 }
 
-type ProjectManagerPhoneNumberInvalidError struct{}
+type PageNoInvalidError struct{}
 
-func (e *ProjectManagerPhoneNumberInvalidError) Error() string {
-	return "ProjectManager phoneNumber is invalid"
+func (e *PageNoInvalidError) Error() string {
+	return "pageNo is invalid"
 }
 
-// Coordinator address
-type ProjectCoordinatorAddressRequiredError struct{}
+type PageSizeInvalidError struct{}
 
-func (e *ProjectCoordinatorAddressRequiredError) Error() string {
-	return "projectCoordinator address is required"
+func (e *PageSizeInvalidError) Error() string {
+	return "pageSize is invalid"
 }
 
-type ProjectCoordinatorProvinceIdRequiredError struct{}
+type SortRequiredError struct{}
 
-func (e *ProjectCoordinatorProvinceIdRequiredError) Error() string {
-	return "projectCoordinator provinceId is required"
+func (e *SortRequiredError) Error() string {
+	return "sort is required"
 }
 
-type ProjectCoordinatorDistrictIdRequiredError struct{}
+type SortTooManyKeysError struct{}
 
-func (e *ProjectCoordinatorDistrictIdRequiredError) Error() string {
-	return "projectCoordinator districtId is required"
+func (e *SortTooManyKeysError) Error() string {
+	return fmt.Sprintf("sort must not have more than %d keys", DASHBOARD_SORT_MAX_KEYS)
 }
 
-type ProjectCoordinatorSubdistrictIdRequiredError struct{}
-
-func (e *ProjectCoordinatorSubdistrictIdRequiredError) Error() string {
-	return "projectCoordinator subdistrictId is required"
+type SortKeyInvalidError struct {
+	Key string
 }
 
-type ProjectCoordinatorPostcodeIdRequiredError struct{}
-
-func (e *ProjectCoordinatorPostcodeIdRequiredError) Error() string {
-	return "projectCoordinator postcodeId is required"
+func (e *SortKeyInvalidError) Error() string {
+	return fmt.Sprintf("sort key %q is invalid", e.Key)
 }
 
-type ProjectCoordinatorEmailRequiredError struct{}
-
-func (e *ProjectCoordinatorEmailRequiredError) Error() string {
-	return "projectCoordinator email is required"
+type SortKeyDuplicatedError struct {
+	Key string
 }
 
-type ProjectCoordinatorLineIdRequiredError struct{}
-
-func (e *ProjectCoordinatorLineIdRequiredError) Error() string {
-	return "projectCoordinator lineId is required"
+func (e *SortKeyDuplicatedError) Error() string {
+	return fmt.Sprintf("sort key %s is duplicated", e.Key)
 }
 
-type ProjectCoordinatorPhoneNumberRequiredError struct{}
-
-func (e *ProjectCoordinatorPhoneNumberRequiredError) Error() string {
-	return "projectCoordinator phoneNumber is required"
+type SortDirectionInvalidError struct {
+	Key string
 }
 
-type ProjectCoordinatorPhoneNumberLengthError struct{}
-
-func (e *ProjectCoordinatorPhoneNumberLengthError) Error() string {
-	return "projectCoordinator phoneNumber is shorter than 9 numbers"
+func (e *SortDirectionInvalidError) Error() string {
+	return fmt.Sprintf("sort direction of %s must be asc or desc", e.Key)
 }
 
-type ProjectCoordinatorPhoneNumberInvalidError struct{}
+type FromDateExceedToDateError struct{}
 
-func (e *ProjectCoordinatorPhoneNumberInvalidError) Error() string {
-	return "projectCoordinator phoneNumber is invalid"
+func (e *FromDateExceedToDateError) Error() string {
+	return "fromDate is later than toDate"
 }
 
-type RaceDirectorWhoRequiredError struct{}
+type WithdrawReasonRequiredError struct{}
 
-func (e *RaceDirectorWhoRequiredError) Error() string {
-	return "raceDirector who is required"
+func (e *WithdrawReasonRequiredError) Error() string {
+	return "withdraw reason is required"
 }
 
-type RaceDirectorAlternativePrefixRequiredError struct{}
+type CancelReasonRequiredError struct{}
 
-func (e *RaceDirectorAlternativePrefixRequiredError) Error() string {
-	return "raceDirector alternative prefix is required"
+func (e *CancelReasonRequiredError) Error() string {
+	return "cancel reason is required"
 }
 
-type RaceDirectorAlternativeFirstNameRequiredError struct{}
-
-func (e *RaceDirectorAlternativeFirstNameRequiredError) Error() string {
-	return "raceDirector alternative firstName is required"
+type StatusReasonTooLongError struct {
+	Length int
 }
 
-type RaceDirectorAlternativeLastNameRequiredError struct{}
-
-func (e *RaceDirectorAlternativeLastNameRequiredError) Error() string {
-	return "raceDirector alternative lastName is required"
+func (e *StatusReasonTooLongError) Error() string {
+	return fmt.Sprintf("reason length is over 512 characters, got %d", e.Length)
 }
 
-type ContactOrganizationNameRequiredError struct{}
+type ProjectNotWithdrawableError struct{}
 
-func (e *ContactOrganizationNameRequiredError) Error() string {
-	return "organization name is required"
+func (e *ProjectNotWithdrawableError) Error() string {
+	return "project can only be withdrawn while it is Reviewing, Reviewed or Revise"
 }
 
-type ContactOrganizationTypeRequiredError struct{}
+type ProjectNotCancellableError struct{}
 
-func (e *ContactOrganizationTypeRequiredError) Error() string {
-	return "organization type is required"
+func (e *ProjectNotCancellableError) Error() string {
+	return "project can only be cancelled while it is Approved or Start"
 }
 
-type BackgroundRequiredError struct{}
-
-func (e *BackgroundRequiredError) Error() string {
-	return "background is required"
+type ProjectStatusTransitionInvalidError struct {
+	From string
+	To   string
 }
 
-type ObjectiveRequiredError struct{}
-
-func (e *ObjectiveRequiredError) Error() string {
-	return "objective is required"
+func (e *ProjectStatusTransitionInvalidError) Error() string {
+	return fmt.Sprintf("project status can not change from %s to %s", e.From, e.To)
 }
 
-type OnlineAvailableRequiredOne struct{}
-
-func (e *OnlineAvailableRequiredOne) Error() string {
-	return "online marketing available required one"
+type ProjectClosedError struct {
+	Status string
 }
 
-type FacebookHowToIsRequired struct{}
-
-func (e *FacebookHowToIsRequired) Error() string {
-	return "facebook link is required"
+func (e *ProjectClosedError) Error() string {
+	return fmt.Sprintf("project is %s and can not be updated", e.Status)
 }
 
-type WebsiteHowToIsRequired struct{}
+type ProjectVersionInvalidError struct{}
 
-func (e *WebsiteHowToIsRequired) Error() string {
-	return "website link is required"
+func (e *ProjectVersionInvalidError) Error() string {
+	return "version must be a positive number"
 }
 
-type OnlinePageHowToIsRequired struct{}
+type EligibilityRuleTypeInvalidError struct{}
 
-func (e *OnlinePageHowToIsRequired) Error() string {
-	return "onlinePage link is required"
+func (e *EligibilityRuleTypeInvalidError) Error() string {
+	return "ruleType is invalid"
 }
 
-type OtherHowToIsRequired struct{}
-
-func (e *OtherHowToIsRequired) Error() string {
-	return "other link is required"
+type EligibilityRuleParamInvalidError struct {
+	Name string
 }
 
-type OfflineAvailableRequiredOne struct{}
-
-func (e *OfflineAvailableRequiredOne) Error() string {
-	return "offline marketing available required one"
+func (e *EligibilityRuleParamInvalidError) Error() string {
+	return fmt.Sprintf("params %s must be greater than 0", e.Name)
 }
 
-type OfflineAdditionRequiredError struct{}
+type EligibilityRuleMessageTooLongError struct{}
 
-func (e *OfflineAdditionRequiredError) Error() string {
-	return "offline addition is required"
+func (e *EligibilityRuleMessageTooLongError) Error() string {
+	return fmt.Sprintf("message must not exceed %d characters", ELIGIBILITY_MESSAGE_MAX_LENGTH)
 }
 
-type ApplicantCriteriaNotFoundError struct{}
+type EligibilityRuleEnabledRequiredError struct{}
 
-func (e *ApplicantCriteriaNotFoundError) Error() string {
-	return "applicant criteria not found"
+func (e *EligibilityRuleEnabledRequiredError) Error() string {
+	return "enabled is required"
 }
 
-type ScoreRequiredError struct {
-	Name string
-}
+type EligibilityRuleNotFoundError struct{}
 
-func (e *ScoreRequiredError) Error() string {
-	return fmt.Sprintf("score %s is required", e.Name)
+func (e *EligibilityRuleNotFoundError) Error() string {
+	return "eligibility rule is not found"
 }
 
-type ScoreInvalidError struct {
-	Name string
-}
+type DuplicateActionInvalidError struct{}
 
-func (e *ScoreInvalidError) Error() string {
-	return fmt.Sprintf("score %s is invalid. 1 <= score <= 5", e.Name)
+func (e *DuplicateActionInvalidError) Error() string {
+	return "action must be link, close-as-duplicate, merge or dismiss"
 }
 
-type SafetyReadyRequiredOneError struct{}
+type DuplicateNotFoundError struct{}
 
-func (e *SafetyReadyRequiredOneError) Error() string {
-	return "safety ready required one"
+func (e *DuplicateNotFoundError) Error() string {
+	return "duplicate is not found"
 }
 
-type AEDCountInvalidError struct{}
-
-func (e *AEDCountInvalidError) Error() string {
-	return "safety aedCount is invalid. aedCount must >= 1"
+type DuplicateAlreadyResolvedError struct {
+	Status string
 }
 
-type SafetyAdditionRequiredError struct{}
-
-func (e *SafetyAdditionRequiredError) Error() string {
-	return "safety addition is required"
+func (e *DuplicateAlreadyResolvedError) Error() string {
+	return fmt.Sprintf("duplicate is already %s", e.Status)
 }
 
-type RouteMeasurementRequiredOneError struct{}
+type ProjectNotClosableAsDuplicateError struct{}
 
-func (e *RouteMeasurementRequiredOneError) Error() string {
-	return "route measurement required one"
+func (e *ProjectNotClosableAsDuplicateError) Error() string {
+	return "project can only be closed as duplicate while it is Reviewing, Reviewed or Revise"
 }
 
-type RouteToolRequiredError struct{}
-
-func (e *RouteToolRequiredError) Error() string {
-	return "route tool is required"
+type DuplicateMergeTargetClosedError struct {
+	ProjectCode string
+	Status      string
 }
 
-type RouteTrafficManagementRequiredOneError struct{}
-
-func (e *RouteTrafficManagementRequiredOneError) Error() string {
-	return "route trafficManagement required one"
+func (e *DuplicateMergeTargetClosedError) Error() string {
+	return fmt.Sprintf("project %s is %s and can not take a merge", e.ProjectCode, e.Status)
 }
 
-type JudgeTypeRequiredError struct{}
+type SearchQueryLengthError struct{}
 
-func (e *JudgeTypeRequiredError) Error() string {
-	return "judge type is required"
+func (e *SearchQueryLengthError) Error() string {
+	return fmt.Sprintf("query must be %d-%d characters", SEARCH_QUERY_MIN_LENGTH, SEARCH_QUERY_MAX_LENGTH)
 }
 
-type JudgeTypeInvalidError struct{}
+type DashboardTypeInvalidError struct{}
 
-func (e *JudgeTypeInvalidError) Error() string {
-	return "judge type is invalid"
+func (e *DashboardTypeInvalidError) Error() string {
+	return "dashboard must be request or started"
 }
 
-type JudgeOtherTypeRequiredError struct{}
-
-func (e *JudgeOtherTypeRequiredError) Error() string {
-	return "judge otherType is required"
+type FilterTooManyValuesError struct {
+	Name string
 }
 
-type SupportOrganizationRequiredOneError struct{}
-
-func (e *SupportOrganizationRequiredOneError) Error() string {
-	return "support organization required one"
+func (e *FilterTooManyValuesError) Error() string {
+	return fmt.Sprintf("%s must not have more than %d values", e.Name, DASHBOARD_FILTER_MAX_IDS)
 }
 
-type SupportAdditionRequiredError struct{}
-
-func (e *SupportAdditionRequiredError) Error() string {
-	return "support addition is required"
+type FilterIdInvalidError struct {
+	Name string
 }
 
-type FeedbackRequiredError struct{}
-
-func (e *FeedbackRequiredError) Error() string {
-	return "feedback is required"
+func (e *FilterIdInvalidError) Error() string {
+	return fmt.Sprintf("%s must only contain positive ids", e.Name)
 }
 
-type ThisSeriesFirstTimeRequiredError struct{}
-
-func (e *ThisSeriesFirstTimeRequiredError) Error() string {
-	return "firstTime is required"
+type FilterDateInvalidError struct {
+	Name string
 }
 
-type HistoryOrdinalNumberInvalidError struct{}
-
-func (e *HistoryOrdinalNumberInvalidError) Error() string {
-	return "history ordinalNumber is invalid"
+func (e *FilterDateInvalidError) Error() string {
+	return fmt.Sprintf("%s is not a valid date", e.Name)
 }
 
-type HistoryYearRequiredError struct{}
-
-func (e *HistoryYearRequiredError) Error() string {
-	return "history year is required"
+type FilterRangeInvalidError struct {
+	Name string
 }
 
-type HistoryYearOutOfBoundError struct{}
-
-func (e *HistoryYearOutOfBoundError) Error() string {
-	return "history year is out of bound"
+func (e *FilterRangeInvalidError) Error() string {
+	return fmt.Sprintf("%s range is invalid", e.Name)
 }
 
-type HistoryMonthRequiredError struct{}
+type DashboardCursorInvalidError struct{}
 
-func (e *HistoryMonthRequiredError) Error() string {
-	return "history month is required"
+func (e *DashboardCursorInvalidError) Error() string {
+	return "cursor is invalid or does not match the sort"
 }
 
-type HistoryMonthOutOfBoundError struct{}
+type ProjectStatusInvalidError struct{}
 
-func (e *HistoryMonthOutOfBoundError) Error() string {
-	return "history month is out of bound"
+func (e *ProjectStatusInvalidError) Error() string {
+	return "projectStatus is invalid"
 }
 
-type HistoryDayRequiredError struct{}
+type BulkProjectCodesRequiredError struct{}
 
-func (e *HistoryDayRequiredError) Error() string {
-	return "history day is required"
+func (e *BulkProjectCodesRequiredError) Error() string {
+	return "projectCodes is required"
 }
 
-type HistoryDayOutOfBoundError struct{}
+type BulkTooManyProjectsError struct{}
 
-func (e *HistoryDayOutOfBoundError) Error() string {
-	return "history day is out of bound"
+func (e *BulkTooManyProjectsError) Error() string {
+	return fmt.Sprintf("projectCodes must not have more than %d projects", BULK_UPDATE_MAX_PROJECTS)
 }
 
-type CompletedYearRequiredError struct{}
-
-func (e *CompletedYearRequiredError) Error() string {
-	return "history completed year is required"
+type BulkProjectCodeDuplicatedError struct {
+	ProjectCode string
 }
 
-type CompletedYearOutOfBoundError struct{}
-
-func (e *CompletedYearOutOfBoundError) Error() string {
-	return "history completed year is out of bound"
+func (e *BulkProjectCodeDuplicatedError) Error() string {
+	return fmt.Sprintf("projectCode %s is duplicated", e.ProjectCode)
 }
 
-type CompletedNameRequiredError struct{}
+type BulkNothingToUpdateError struct{}
 
-func (e *CompletedNameRequiredError) Error() string {
-	return "history completed name is required"
+func (e *BulkNothingToUpdateError) Error() string {
+	return "at least one of projectStatus, adminComment or fundApprovedAmount is required"
 }
 
-type CompletedParticipantRequiredError struct{}
-
-func (e *CompletedParticipantRequiredError) Error() string {
-	return "history completed participant is required"
+type BulkUpdateConflictError struct {
+	ProjectCode string
 }
 
-type CompletedParticipantInvalidError struct{}
-
-func (e *CompletedParticipantInvalidError) Error() string {
-	return "history completed participant is invalid"
+func (e *BulkUpdateConflictError) Error() string {
+	return fmt.Sprintf("project %s was changed by someone else, nothing was updated", e.ProjectCode)
 }
 
-type DoneBeforeRequiredError struct{}
+type ProjectNoteBodyRequiredError struct{}
 
-func (e *DoneBeforeRequiredError) Error() string {
-	return "otherSeries doneBefore is required"
+func (e *ProjectNoteBodyRequiredError) Error() string {
+	return "body is required"
 }
 
-type TotalBudgetRequiredError struct{}
-
-func (e *TotalBudgetRequiredError) Error() string {
-	return "budget total is required"
+type ProjectNoteTooLongError struct {
+	Length int
 }
 
-type BudgetSupportOrganizationRequiredError struct{}
-
-func (e *BudgetSupportOrganizationRequiredError) Error() string {
-	return "budget supportOrganization is required"
+func (e *ProjectNoteTooLongError) Error() string {
+	return fmt.Sprintf("body must not exceed %d characters, got %d", PROJECT_NOTE_MAX_LENGTH, e.Length)
 }
 
-type NoAlcoholSponsorError struct{}
+type ProjectNoteParentNotFoundError struct{}
 
-func (e *NoAlcoholSponsorError) Error() string {
-	return "budget noAlcohol sponsor must be checked"
+func (e *ProjectNoteParentNotFoundError) Error() string {
+	return "parent note is not found in this project"
 }
 
-type FundRequestTypeRequiredOneError struct{}
-
-func (e *FundRequestTypeRequiredOneError) Error() string {
-	return "fund request type is required"
+type ProjectTagInvalidError struct {
+	Tag string
 }
 
-type FundRequestAmountRequiredError struct{}
-
-func (e *FundRequestAmountRequiredError) Error() string {
-	return "request fundAmount is required"
+func (e *ProjectTagInvalidError) Error() string {
+	return fmt.Sprintf("tag %q must be 1-%d characters", e.Tag, PROJECT_TAG_MAX_LENGTH)
 }
 
-type FundRequestAmountInvalidError struct{}
+type ProjectTooManyTagsError struct{}
 
-func (e *FundRequestAmountInvalidError) Error() string {
-	return "request fundAmount is invalid"
+func (e *ProjectTooManyTagsError) Error() string {
+	return fmt.Sprintf("a project must not have more than %d tags", PROJECT_TAGS_MAX)
 }
 
-type BibRequestAmountRequiredError struct{}
+type ProjectMessageRequiredError struct{}
 
-func (e *BibRequestAmountRequiredError) Error() string {
-	return "request bibAmount is required"
+func (e *ProjectMessageRequiredError) Error() string {
+	return "body or attachments is required"
 }
 
-type BibRequestAmountInvalidError struct{}
-
-func (e *BibRequestAmountInvalidError) Error() string {
-	return "request bibAmount is invalid"
+type ProjectMessageTooLongError struct {
+	Length int
 }
 
-type SeminarRequiredError struct{}
-
-func (e *SeminarRequiredError) Error() string {
-	return "request details seminar is required"
+func (e *ProjectMessageTooLongError) Error() string {
+	return fmt.Sprintf("body must not exceed %d characters, got %d", PROJECT_MESSAGE_MAX_LENGTH, e.Length)
 }
 
-type OtherRequestRequiredError struct{}
+type ProjectMessageTooManyAttachmentsError struct{}
 
-func (e *OtherRequestRequiredError) Error() string {
-	return "request details otherRequest is required"
+func (e *ProjectMessageTooManyAttachmentsError) Error() string {
+	return fmt.Sprintf("a message must not have more than %d attachments", PROJECT_MESSAGE_MAX_ATTACHMENTS)
 }
 
-type MarketingFilesRequiredError struct{}
+type ReviseDueAtNotAllowedError struct{}
 
-func (e *MarketingFilesRequiredError) Error() string {
-	return "marketingFiles are required"
+func (e *ReviseDueAtNotAllowedError) Error() string {
+	return "reviseDueAt is only allowed when projectStatusSecondary is Revise"
 }
 
-type RouteFilesRequiredError struct{}
+type ReviseDueAtInPastError struct{}
 
-func (e *RouteFilesRequiredError) Error() string {
-	return "routeFiles are required"
+func (e *ReviseDueAtInPastError) Error() string {
+	return "reviseDueAt must be in the future"
 }
 
-type EventMapFilesRequiredError struct{}
-
-func (e *EventMapFilesRequiredError) Error() string {
-	return "EventMapFiles are required"
+type DistanceCodeInvalidError struct {
+	Code string
 }
 
-type EventDetailsFilesRequiredError struct{}
-
-func (e *EventDetailsFilesRequiredError) Error() string {
-	return "eventDetailsFiles are required"
+func (e *DistanceCodeInvalidError) Error() string {
+	return fmt.Sprintf("distance code %s is not in the catalogue", e.Code)
 }
 
-type FilesRequiredError struct{}
-
-func (e *FilesRequiredError) Error() string {
-	return "additionFiles or etcFiles are required"
+type DistanceKmRequiredError struct {
+	Code string
 }
 
-type ProjectNotFoundError struct{}
-
-func (e *ProjectNotFoundError) Error() string {
-	return "project is not found"
+func (e *DistanceKmRequiredError) Error() string {
+	return fmt.Sprintf("km is required for distance %s", e.Code)
 }
 
-type ProjectCodeRequiredError struct{}
-
-func (e *ProjectCodeRequiredError) Error() string {
-	return "projectCode is required"
+type DistanceKmInvalidError struct {
+	Code string
 }
 
-type ReviewerIdRequiredError struct{}
-
-func (e *ReviewerIdRequiredError) Error() string {
-	return "reviewerId is required"
+func (e *DistanceKmInvalidError) Error() string {
+	return fmt.Sprintf("km is out of range for distance %s", e.Code)
 }
 
-type ProjectStatusPrimaryRequiredError struct{}
-
-func (e *ProjectStatusPrimaryRequiredError) Error() string {
-	return "projectStatusPrimary is required"
+type DistanceOverrideInvalidError struct {
+	Type string
+	Err  error
 }
 
-type ProjectStatusPrimaryInvalidError struct{}
-
-func (e *ProjectStatusPrimaryInvalidError) Error() string {
-	return "projectStatusPrimary is invalid"
+func (e *DistanceOverrideInvalidError) Error() string {
+	return fmt.Sprintf("override for %q: %s", e.Type, e.Err.Error())
 }
 
-type ProjectStatusSecondaryRequiredError struct{}
-
-func (e *ProjectStatusSecondaryRequiredError) Error() string {
-	return "projectStatusSecondary is required"
+func (e *DistanceOverrideInvalidError) Unwrap() error {
+	return e.Err
 }
 
-type ProjectStatusSecondaryInvalidError struct{}
+type ApplicantCriteriaItemsRequiredError struct{}
 
-func (e *ProjectStatusSecondaryInvalidError) Error() string {
-	return "projectStatusSecondary is invalid"
+func (e *ApplicantCriteriaItemsRequiredError) Error() string {
+	return "items are required"
 }
 
-type AdminScoreOutOfRangeError struct{}
+type ApplicantCriteriaTooManyItemsError struct{}
 
-func (e *AdminScoreOutOfRangeError) Error() string {
-	return "adminScore is out of range 0-100"
+func (e *ApplicantCriteriaTooManyItemsError) Error() string {
+	return fmt.Sprintf("a criteria version must not have more than %d items", APPLICANT_CRITERIA_MAX_ITEMS)
 }
 
-type FundApprovedAmountNegativeError struct{}
-
-func (e *FundApprovedAmountNegativeError) Error() string {
-	return "fundApprovedAmount is negative"
+type ApplicantCriteriaOrderNumberInvalidError struct {
+	Count int
 }
 
-type AdminCommentTooLongError struct {
-	Length int
+func (e *ApplicantCriteriaOrderNumberInvalidError) Error() string {
+	return fmt.Sprintf("orderNumber must be unique and from 1 to %d", e.Count)
 }
 
-func (e *AdminCommentTooLongError) Error() string {
-	return fmt.Sprintf("adminComment length is over 512 characters, got %d", e.Length)
+type ApplicantCriteriaTextRequiredError struct {
+	Name string
 }
 
-type FromYearRequiredError struct{}
-
-func (e *FromYearRequiredError) Error() string {
-	return "fromYear is required"
+func (e *ApplicantCriteriaTextRequiredError) Error() string {
+	return fmt.Sprintf("%s is required", e.Name)
 }
 
-type ToYearRequiredError struct{}
-
-func (e *ToYearRequiredError) Error() string {
-	return "toYear is required"
+type ApplicantCriteriaTextTooLongError struct {
+	Name string
 }
 
-type PageNoInvalidError struct{}
-
-func (e *PageNoInvalidError) Error() string {
-	return "pageNo is invalid"
+func (e *ApplicantCriteriaTextTooLongError) Error() string {
+	return fmt.Sprintf("%s must not exceed %d characters", e.Name, APPLICANT_CRITERIA_TEXT_MAX_LENGTH)
 }
 
-type PageSizeInvalidError struct{}
+type ApplicantCriteriaVersionNotFoundError struct{}
 
-func (e *PageSizeInvalidError) Error() string {
-	return "pageSize is invalid"
+func (e *ApplicantCriteriaVersionNotFoundError) Error() string {
+	return "criteria version not found"
 }
 
-type SortByRequiredError struct{}
-
-func (e *SortByRequiredError) Error() string {
-	return "sortBy is required"
+type ApplicantCriteriaVersionPublishedError struct {
+	Version int
 }
 
-type SortByInvalidError struct{}
-
-func (e *SortByInvalidError) Error() string {
-	return "sortBy is invalid"
+func (e *ApplicantCriteriaVersionPublishedError) Error() string {
+	return fmt.Sprintf("criteria version %d is published and can not be changed", e.Version)
 }
 
-type FromDateExceedToDateError struct{}
+type FormSchemaNotFoundError struct{}
 
-func (e *FromDateExceedToDateError) Error() string {
-	return "fromDate is later than toDate"
+func (e *FormSchemaNotFoundError) Error() string {
+	return "form schema version not found"
 }
@@ -0,0 +1,417 @@
+package projects
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+//go:embed form_schema/*.json
+var formSchemaFiles embed.FS
+
+// FORM_SCHEMA_VERSION is the schema new proposals are validated against
+const FORM_SCHEMA_VERSION = 1
+
+var formSchemas = mustLoadFormSchemas()
+
+// FormSchema describes the proposal form. Fields are checked in order and every field reports its first failing rule.
+// Messages are the Thai templates keyed by "<rule>.<type>" or "<rule>", {label} is the field label and {value} the rule value.
+type FormSchema struct {
+	Version  int               `json:"version"`
+	Messages map[string]string `json:"messages,omitempty"`
+	Fields   []FormField       `json:"fields"`
+}
+
+// FormField paths are relative to the enclosing field, items are relative to each array element.
+// Type is for the UI only, the payload has been decoded into AddProjectRequest before it is validated.
+type FormField struct {
+	Path   string         `json:"path"`
+	Type   string         `json:"type,omitempty"`
+	Label  string         `json:"label,omitempty"`
+	When   *FormCondition `json:"when,omitempty"`
+	Rules  []FormRule     `json:"rules,omitempty"`
+	Fields []FormField    `json:"fields,omitempty"`
+	Items  []FormField    `json:"items,omitempty"`
+}
+
+// FormRule messages may use {index} for the position of the array element being checked,
+// labels and Thai messages use {number} which counts from 1
+type FormRule struct {
+	Rule      string         `json:"rule"`
+	Value     any            `json:"value,omitempty"`
+	Values    []any          `json:"values,omitempty"`
+	Fields    []string       `json:"fields,omitempty"`
+	Year      string         `json:"year,omitempty"`
+	Month     string         `json:"month,omitempty"`
+	Where     *FormCondition `json:"where,omitempty"`
+	Name      string         `json:"name,omitempty"`
+	Message   string         `json:"message,omitempty"`
+	MessageTh string         `json:"messageTh,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// FormCondition paths are relative to the same object as the field it guards
+type FormCondition struct {
+	Path       string          `json:"path,omitempty"`
+	Equals     any             `json:"equals,omitempty"`
+	Present    bool            `json:"present,omitempty"`
+	AnyPresent []string        `json:"anyPresent,omitempty"`
+	All        []FormCondition `json:"all,omitempty"`
+}
+
+// formCustomRules are checks that need more than the payload, the schema only names them
+var formCustomRules = map[string]func(v *formValidator, index int) error{
+	"applicantScores": func(v *formValidator, index int) error {
+		return validateScore(v.payload, v.criteria)
+	},
+	"distanceChoice": func(v *formValidator, index int) error {
+		df := v.payload.General.EventDetails.DistanceAndFee[index]
+		return validateDistanceChoice(df.Code, df.Km)
+	},
+	"budgetItemsTotal": func(v *formValidator, index int) error {
+		return validateBudgetItemsTotal(v.payload.Fund)
+	},
+}
+
+func GetFormSchema(version int) (FormSchema, bool) {
+	schema, found := formSchemas[version]
+	return schema, found
+}
+
+func mustLoadFormSchemas() map[int]FormSchema {
+	files, err := fs.Glob(formSchemaFiles, "form_schema/*.json")
+	if err != nil {
+		panic(err)
+	}
+	schemas := map[int]FormSchema{}
+	for _, name := range files {
+		raw, err := formSchemaFiles.ReadFile(name)
+		if err != nil {
+			panic(err)
+		}
+		var schema FormSchema
+		err = json.Unmarshal(raw, &schema)
+		if err != nil {
+			panic(fmt.Sprintf("%s: %s", name, err.Error()))
+		}
+		err = prepareFormFields(schema.Fields)
+		if err != nil {
+			panic(fmt.Sprintf("%s: %s", name, err.Error()))
+		}
+		schemas[schema.Version] = schema
+	}
+	if _, found := schemas[FORM_SCHEMA_VERSION]; !found {
+		panic(fmt.Sprintf("form schema version %d not found", FORM_SCHEMA_VERSION))
+	}
+	return schemas
+}
+
+// prepareFormFields compiles patterns and rejects rules the validator does not know
+func prepareFormFields(fields []FormField) error {
+	for _, f := range fields {
+		for i := range f.Rules {
+			r := &f.Rules[i]
+			switch r.Rule {
+			case "required", "min", "max", "minLength", "maxLength", "maxItems", "enum", "const", "notBlank", "anyTrue", "someItems", "dayOfMonth":
+			case "pattern":
+				re, err := regexp.Compile(fmt.Sprint(r.Value))
+				if err != nil {
+					return fmt.Errorf("%s: %w", f.Path, err)
+				}
+				r.pattern = re
+			case "custom":
+				if formCustomRules[r.Name] == nil {
+					return fmt.Errorf("%s: unknown custom rule %s", f.Path, r.Name)
+				}
+			default:
+				return fmt.Errorf("%s: unknown rule %s", f.Path, r.Rule)
+			}
+		}
+		if err := prepareFormFields(f.Fields); err != nil {
+			return err
+		}
+		if err := prepareFormFields(f.Items); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type formValidator struct {
+	payload     AddProjectRequest
+	criteria    []ApplicantSelfScoreCriteria
+	currentYear int
+	messages    map[string]string
+	errs        *utils.ValidationError
+}
+
+// validate checks the payload as it is sent back as JSON, so empty values are left out the same way for every field.
+// Failures are added to errs, the returned error is only for a payload that can not be encoded.
+func (s FormSchema) validate(payload AddProjectRequest, criteria []ApplicantSelfScoreCriteria, errs *utils.ValidationError) error {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	var doc map[string]any
+	err = json.Unmarshal(raw, &doc)
+	if err != nil {
+		return err
+	}
+	currentYear, _, _ := getLocalYearMonthDay()
+	v := &formValidator{payload: payload, criteria: criteria, currentYear: currentYear, messages: s.Messages, errs: errs}
+	v.validateFields(s.Fields, doc, "", -1)
+	return nil
+}
+
+// validateFields stops at the first failing rule of a field and skips what is under it,
+// a field that already failed or has a failing child is not checked again by a later entry
+func (v *formValidator) validateFields(fields []FormField, scope any, prefix string, index int) {
+	for _, f := range fields {
+		if f.When != nil && !f.When.matches(scope) {
+			continue
+		}
+		value := lookupFormPath(scope, f.Path)
+		path := joinFormPointer(prefix, f.Path)
+		if v.hasFailed(path) {
+			continue
+		}
+		failed := false
+		for _, r := range f.Rules {
+			err := v.checkRule(r, value, scope, index)
+			if err != nil {
+				v.errs.Add(path, formErrorCode(r), err.Error(), v.thaiMessage(f, r, index))
+				failed = true
+				break
+			}
+		}
+		if failed {
+			continue
+		}
+		if len(f.Fields) > 0 {
+			v.validateFields(f.Fields, value, path, index)
+		}
+		if len(f.Items) > 0 {
+			items, _ := value.([]any)
+			for i, item := range items {
+				v.validateFields(f.Items, item, fmt.Sprintf("%s/%d", path, i), i)
+			}
+		}
+	}
+}
+
+func (v *formValidator) hasFailed(path string) bool {
+	for _, e := range v.errs.Errors {
+		if e.Path == path || strings.HasPrefix(e.Path, path+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// formErrorCode is the rule name, custom rules are told apart by their name
+func formErrorCode(r FormRule) string {
+	if r.Rule == "custom" {
+		return r.Name
+	}
+	return r.Rule
+}
+
+func (v *formValidator) thaiMessage(f FormField, r FormRule, index int) string {
+	template := r.MessageTh
+	if template == "" {
+		template = v.messages[r.Rule+"."+f.Type]
+	}
+	if template == "" {
+		template = v.messages[r.Rule]
+	}
+	value := strconv.FormatFloat(v.formBound(r.Value), 'f', -1, 64)
+	message := strings.NewReplacer("{label}", f.Label, "{value}", value).Replace(template)
+	return strings.ReplaceAll(message, "{number}", strconv.Itoa(index+1))
+}
+
+func (v *formValidator) checkRule(r FormRule, value any, scope any, index int) error {
+	ok := true
+	switch r.Rule {
+	case "required":
+		ok = !isEmptyFormValue(value)
+	case "min", "max":
+		n, isNumber := value.(float64)
+		if !isNumber {
+			return nil
+		}
+		bound := v.formBound(r.Value)
+		ok = (r.Rule == "min" && n >= bound) || (r.Rule == "max" && n <= bound)
+	case "minLength", "maxLength":
+		s, isString := value.(string)
+		if !isString {
+			return nil
+		}
+		length := float64(utf8.RuneCountInString(s))
+		bound := v.formBound(r.Value)
+		ok = (r.Rule == "minLength" && length >= bound) || (r.Rule == "maxLength" && length <= bound)
+	case "pattern":
+		s, isString := value.(string)
+		if !isString {
+			return nil
+		}
+		ok = r.pattern.MatchString(s)
+	case "maxItems":
+		items, _ := value.([]any)
+		ok = float64(len(items)) <= v.formBound(r.Value)
+	case "enum":
+		if value == nil {
+			return nil
+		}
+		ok = false
+		for _, allowed := range r.Values {
+			if value == allowed {
+				ok = true
+				break
+			}
+		}
+	case "const":
+		ok = value == r.Value
+	case "notBlank":
+		s, _ := value.(string)
+		ok = strings.TrimSpace(s) != ""
+	case "anyTrue":
+		ok = false
+		for _, name := range r.Fields {
+			if lookupFormPath(value, name) == true {
+				ok = true
+				break
+			}
+		}
+	case "someItems":
+		items, _ := value.([]any)
+		ok = false
+		for _, item := range items {
+			if r.Where.matches(item) {
+				ok = true
+				break
+			}
+		}
+	case "dayOfMonth":
+		day, isNumber := value.(float64)
+		if !isNumber {
+			return nil
+		}
+		year, _ := lookupFormPath(scope, r.Year).(float64)
+		month, _ := lookupFormPath(scope, r.Month).(float64)
+		ok = isValidDay(int(year), int(month), int(day))
+	case "custom":
+		return formCustomRules[r.Name](v, index)
+	}
+	if ok {
+		return nil
+	}
+	return fmt.Errorf("%s", strings.ReplaceAll(r.Message, "{index}", strconv.Itoa(index)))
+}
+
+// formBound reads a numeric rule value, "currentYear" is the year in Thailand today
+func (v *formValidator) formBound(value any) float64 {
+	if value == "currentYear" {
+		return float64(v.currentYear)
+	}
+	n, _ := value.(float64)
+	return n
+}
+
+func (c *FormCondition) matches(scope any) bool {
+	for _, sub := range c.All {
+		if !sub.matches(scope) {
+			return false
+		}
+	}
+	if len(c.AnyPresent) > 0 {
+		found := false
+		for _, path := range c.AnyPresent {
+			if !isEmptyFormValue(lookupFormPath(scope, path)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if c.Present && isEmptyFormValue(lookupFormPath(scope, c.Path)) {
+		return false
+	}
+	if c.Equals != nil && lookupFormPath(scope, c.Path) != c.Equals {
+		return false
+	}
+	return true
+}
+
+func lookupFormPath(scope any, path string) any {
+	if path == "" {
+		return scope
+	}
+	value := scope
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = obj[key]
+	}
+	return value
+}
+
+// joinFormPointer appends a dotted schema path to a JSON pointer
+func joinFormPointer(prefix, path string) string {
+	if path == "" {
+		return prefix
+	}
+	escape := strings.NewReplacer("~", "~0", "/", "~1")
+	for _, key := range strings.Split(path, ".") {
+		prefix += "/" + escape.Replace(key)
+	}
+	return prefix
+}
+
+func isEmptyFormValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	}
+	return false
+}
+
+// Assume we have a valid year and month already
+func isValidDay(year, month, day int) bool {
+	if day < 1 || day > 31 {
+		return false
+	}
+	if month == 2 {
+		leapYear := isLeapYear(year)
+		if leapYear && day > 29 {
+			return false
+		}
+		if !leapYear && day > 28 {
+			return false
+		}
+	}
+	_, isThirtyDayMonth := thirtyDaysMonth[month]
+	if isThirtyDayMonth && day > 30 {
+		return false
+	}
+	return true
+}
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && year%100 != 0 || year%400 == 0
+}
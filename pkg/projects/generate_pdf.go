@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"math"
 	"os"
 	"path/filepath"
 	"strings"
@@ -28,7 +29,7 @@ var expectedParticipantsMap = map[string]string{
 
 var scoreMeaning = []string{"", "ไม่มั่นใจอย่างยิ่ง", "ไม่มั่นใจ", "กลาง ๆ", "มั่นใจ", "มั่นใจอย่างยิ่ง"}
 
-func (s *store) generateApplicantFormPdf(userId int, projectCode string, payload AddProjectRequest) (string, error) {
+func (s *store) generateApplicantFormPdf(userId int, projectCode string, payload AddProjectRequest, criteriaVersion int) (string, error) {
 	pdf := gofpdf.New(gofpdf.OrientationPortrait, gofpdf.UnitPoint, "A4", "")
 	w, h := pdf.GetPageSize()
 	pdf.AddUTF8Font(sr, "", "../home/fonts/THSarabunNew.ttf")
@@ -68,7 +69,7 @@ func (s *store) generateApplicantFormPdf(userId int, projectCode string, payload
 	}
 
 	// 3. Details
-	s.generateDetailsSection(pdf, payload)
+	s.generateDetailsSection(pdf, payload, criteriaVersion)
 
 	// 4. Experience
 	s.generateExperienceSection(pdf, payload)
@@ -91,6 +92,109 @@ func (s *store) generateApplicantFormPdf(userId int, projectCode string, payload
 	return targetPath, nil
 }
 
+var budgetItemCategoryThai = map[string]string{
+	"venue":     "สถานที่",
+	"equipment": "อุปกรณ์",
+	"bib":       "เบอร์วิ่ง",
+	"medal":     "เหรียญรางวัล",
+	"medical":   "การแพทย์และความปลอดภัย",
+	"staff":     "บุคลากร",
+	"marketing": "ประชาสัมพันธ์",
+	"prize":     "ของรางวัล",
+	"other":     "อื่น ๆ",
+}
+
+var budgetItemFundingSourceThai = map[string]string{
+	"runningFund":  "ขอรับการสนับสนุน",
+	"organization": "ผู้จัดงาน",
+	"sponsor":      "ผู้สนับสนุน",
+	"registration": "ค่าสมัคร",
+	"other":        "อื่น ๆ",
+}
+
+func generateBudgetItemsTable(pdf *gofpdf.Fpdf, budget Budget) {
+	const lineHeight = 16.0
+	headers := []string{"ลำดับ", "หมวด", "รายการ", "จำนวน", "ราคาต่อหน่วย", "รวม (บาท)", "แหล่งงบประมาณ"}
+	pageWidth, pageHeight := pdf.GetPageSize()
+	leftMargin, _, rightMargin, bottomMargin := pdf.GetMargins()
+	// column widths as fractions of the printable width
+	widths := []float64{0.06, 0.16, 0.28, 0.09, 0.13, 0.13, 0.15}
+	for i := range widths {
+		widths[i] *= pageWidth - leftMargin - rightMargin
+	}
+
+	drawRow := func(cells []string, aligns []string) {
+		lines := make([][]string, len(cells))
+		rowLines := 1
+		for i, cell := range cells {
+			lines[i] = pdf.SplitText(cell, widths[i]-2)
+			if len(lines[i]) > rowLines {
+				rowLines = len(lines[i])
+			}
+		}
+		rowHeight := float64(rowLines) * lineHeight
+		if pdf.GetY()+rowHeight > pageHeight-bottomMargin {
+			pdf.AddPage()
+		}
+		x, y := pdf.GetXY()
+		for i := range cells {
+			pdf.Rect(x, y, widths[i], rowHeight, "D")
+			for j, line := range lines[i] {
+				pdf.SetXY(x, y+float64(j)*lineHeight)
+				pdf.CellFormat(widths[i], lineHeight, line, gofpdf.BorderNone, 0, aligns[i], false, 0, "")
+			}
+			x += widths[i]
+		}
+		pdf.SetXY(x-sum(widths), y+rowHeight)
+	}
+
+	pdf.SetFont(srB, "B", 14)
+	headerAligns := []string{"CM", "CM", "CM", "CM", "CM", "CM", "CM"}
+	drawRow(headers, headerAligns)
+
+	pdf.SetFont(sr, "", 14)
+	rowAligns := []string{"CM", "LM", "LM", "RM", "RM", "RM", "LM"}
+	requestedTotal := 0
+	for i, item := range budget.Items {
+		amount := item.Quantity * item.UnitCost
+		if item.FundingSource == "runningFund" {
+			requestedTotal += amount
+		}
+		drawRow([]string{
+			fmt.Sprint(i + 1),
+			budgetItemCategoryThai[item.Category],
+			item.Description,
+			utils.FormatInt(int64(item.Quantity)),
+			utils.FormatInt(int64(item.UnitCost)),
+			utils.FormatInt(int64(amount)),
+			budgetItemFundingSourceThai[item.FundingSource],
+		}, rowAligns)
+	}
+
+	pdf.SetFont(srB, "B", 14)
+	summaryWidth := sum(widths[:5])
+	for _, summary := range []struct {
+		label  string
+		amount int
+	}{
+		{label: "รวมงบประมาณทั้งหมด", amount: budget.Total},
+		{label: "รวมงบประมาณที่ขอรับการสนับสนุน", amount: requestedTotal},
+	} {
+		pdf.CellFormat(summaryWidth, lineHeight, summary.label, gofpdf.BorderFull, 0, "RM", false, 0, "")
+		pdf.CellFormat(widths[5], lineHeight, utils.FormatInt(int64(summary.amount)), gofpdf.BorderFull, 0, "RM", false, 0, "")
+		pdf.CellFormat(widths[6], lineHeight, "", gofpdf.BorderFull, 1, "LM", false, 0, "")
+	}
+	pdf.SetFont(sr, "", 16)
+}
+
+func sum(values []float64) float64 {
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
 func indent(input string, n int) string {
 	return fmt.Sprintf("%s%s", strings.Repeat(" ", n), input)
 }
@@ -285,9 +389,93 @@ func (s *store) generateGeneralDetailsSection(pdf *gofpdf.Fpdf, payload AddProje
 		organizerText = "ไม่ใช้"
 	}
 	pdf.MultiCell(0, 16, indent(organizerText, 6), gofpdf.BorderNone, gofpdf.AlignLeft, false)
+
+	if len(payload.RouteTracks) > 0 {
+		pdf.Ln(4)
+		generateRouteTrackPreview(pdf, payload)
+	}
 	return nil
 }
 
+const routePreviewHeight = 220
+
+// generateRouteTrackPreview draws each GPX/KML track scaled into a box with its measured length
+func generateRouteTrackPreview(pdf *gofpdf.Fpdf, payload AddProjectRequest) {
+	pdf.SetFont(srB, "B", 16)
+	pdf.MultiCell(0, 16, "1.8 เส้นทางจากไฟล์ GPX/KML", gofpdf.BorderNone, gofpdf.AlignLeft, false)
+
+	check := CheckRouteDistances(payload.General.EventDetails.DistanceAndFee, payload.RouteTracks)
+	pageWidth, pageHeight := pdf.GetPageSize()
+	boxWidth := pageWidth - 2*padding
+	for i, track := range check.Tracks {
+		name := track.Name
+		if name == "" {
+			name = track.FileName
+		}
+		summary := fmt.Sprintf("- %s: ระยะทาง %.2f km", name, track.LengthKm)
+		if track.ElevationGainM != nil {
+			summary += fmt.Sprintf(", ความสูงสะสม %.0f m", *track.ElevationGainM)
+		}
+		if track.Mismatch {
+			summary += " (ไม่ตรงกับระยะทางที่ระบุ)"
+		}
+		pdf.SetFont(sr, "", 16)
+		if pdf.GetY()+16+routePreviewHeight > pageHeight-padding {
+			pdf.AddPage()
+		}
+		pdf.MultiCell(0, 16, indent(summary, 6), gofpdf.BorderNone, gofpdf.AlignLeft, false)
+
+		top := pdf.GetY() + 4
+		pdf.SetDrawColor(160, 160, 160)
+		pdf.SetLineWidth(0.5)
+		pdf.Rect(padding, top, boxWidth, routePreviewHeight, "D")
+		drawRouteTrack(pdf, payload.RouteTracks[i], padding, top, boxWidth, routePreviewHeight)
+		pdf.SetDrawColor(0, 0, 0)
+		pdf.SetLineWidth(0.2)
+		pdf.SetY(top + routePreviewHeight + 8)
+	}
+}
+
+func drawRouteTrack(pdf *gofpdf.Fpdf, track RouteTrack, left, top, width, height float64) {
+	const inset = 10
+	box := track.BoundingBox
+	// longitude degrees shrink towards the poles, scale them so the shape is not stretched
+	lonScale := math.Cos((box.MinLat + box.MaxLat) / 2 * math.Pi / 180)
+	spanX := math.Max((box.MaxLon-box.MinLon)*lonScale, 1e-9)
+	spanY := math.Max(box.MaxLat-box.MinLat, 1e-9)
+	scale := math.Min((width-2*inset)/spanX, (height-2*inset)/spanY)
+	offsetX := left + (width-spanX*scale)/2
+	offsetY := top + (height-spanY*scale)/2
+	project := func(p RoutePoint) (float64, float64) {
+		return offsetX + (p.Lon-box.MinLon)*lonScale*scale, offsetY + (box.MaxLat-p.Lat)*scale
+	}
+
+	pdf.SetDrawColor(220, 38, 38)
+	pdf.SetLineWidth(1.5)
+	for _, segment := range track.Preview {
+		if len(segment) < 2 {
+			continue
+		}
+		pdf.MoveTo(project(segment[0]))
+		for _, p := range segment[1:] {
+			pdf.LineTo(project(p))
+		}
+		pdf.DrawPath("D")
+	}
+
+	first := track.Preview[0]
+	last := track.Preview[len(track.Preview)-1]
+	if len(first) > 0 && len(last) > 0 {
+		x, y := project(first[0])
+		pdf.SetFillColor(22, 163, 74)
+		pdf.Circle(x, y, 4, "F")
+		x, y = project(last[len(last)-1])
+		pdf.SetFillColor(37, 99, 235)
+		pdf.Circle(x, y, 4, "F")
+		pdf.SetFillColor(255, 255, 255)
+	}
+}
+
 func (s *store) generateContactSection(pdf *gofpdf.Fpdf, payload AddProjectRequest) error {
 	pdf.Ln(12)
 	pdf.SetFont(srB, "B", 16)
@@ -484,7 +672,7 @@ func (s *store) generateContactSection(pdf *gofpdf.Fpdf, payload AddProjectReque
 	return nil
 }
 
-func (s *store) generateDetailsSection(pdf *gofpdf.Fpdf, payload AddProjectRequest) error {
+func (s *store) generateDetailsSection(pdf *gofpdf.Fpdf, payload AddProjectRequest, criteriaVersion int) error {
 	pdf.Ln(12)
 	pdf.SetFont(srB, "B", 16)
 	pdf.MultiCell(0, 16, "ส่วนที่ 3 ข้อมูลข้อเสนอโครงการ และแผนบริหารจัดการงานวิ่งเพื่อสุขภาพ", gofpdf.BorderNone, gofpdf.AlignLeft, false)
@@ -547,7 +735,7 @@ func (s *store) generateDetailsSection(pdf *gofpdf.Fpdf, payload AddProjectReque
 	pdf.SetFont(srB, "B", 16)
 	pdf.MultiCell(0, 16, indent("3.3 ความมั่นใจในการวางแผนการจัดเตรียม อุปกรณ์ สถานที่ และสิ่งอำนวยความสะดวกให้นักวิ่ง", 0), gofpdf.BorderNone, gofpdf.AlignLeft, false)
 
-	criteria, err := s.GetApplicantCriteriaForPDF(1)
+	criteria, err := s.GetApplicantCriteriaForPDF(criteriaVersion)
 	if err != nil {
 		return err
 	}
@@ -832,6 +1020,12 @@ func (s *store) generateFundRequestSection(pdf *gofpdf.Fpdf, payload AddProjectR
 	}
 	pdf.Ln(4)
 
+	pdf.SetFont(srB, "B", 16)
+	pdf.MultiCell(0, 16, indent("5.1.4 รายละเอียดงบประมาณ", 8), gofpdf.BorderNone, gofpdf.AlignLeft, false)
+	pdf.Ln(4)
+	generateBudgetItemsTable(pdf, payload.Fund.Budget)
+	pdf.Ln(8)
+
 	pdf.SetFont(srB, "B", 16)
 	pdf.MultiCell(0, 16, indent("5.2 ความต้องการการสนับสนุนจากสสส. และสมาพันธ์ฯ", 0), gofpdf.BorderNone, gofpdf.AlignLeft, false)
 	pdf.SetFont(sr, "", 16)
@@ -1,5 +1,7 @@
 package projects
 
+import "time"
+
 func hasPrimaryStatusChanged(currentStatus, primaryStatus string) bool {
 	currentVal := PROJECT_STATUS[currentStatus]
 	if currentVal <= 3 {
@@ -13,3 +15,23 @@ func hasPrimaryStatusChanged(currentStatus, primaryStatus string) bool {
 	}
 	return false
 }
+
+// adminUpdateStatus resolves the status an admin update moves the project to,
+// a primary status change wins over the secondary one
+func adminUpdateStatus(currentStatus string, payload AdminUpdateProjectRequest) string {
+	if hasPrimaryStatusChanged(currentStatus, payload.ProjectStatusPrimary) && payload.ProjectStatusPrimary != "CurrentBeforeApprove" {
+		return payload.ProjectStatusPrimary
+	}
+	return payload.ProjectStatusSecondary
+}
+
+// nextReviseDueAt keeps the revise deadline only while the project stays in Revise
+func nextReviseDueAt(status string, payload AdminUpdateProjectRequest, current AdminUpdateParam) *time.Time {
+	if status != "Revise" {
+		return nil
+	}
+	if payload.ReviseDueAt != nil {
+		return payload.ReviseDueAt
+	}
+	return current.ReviseDueAt
+}
@@ -2,6 +2,7 @@ package projects
 
 import (
 	"encoding/json"
+	"errors"
 	"mime/multipart"
 	"net/http"
 	"strings"
@@ -40,6 +41,15 @@ func (h *ProjectHandler) AdminUpdateProject(w http.ResponseWriter, r *http.Reque
 		utils.ErrorJSON(w, err, "", http.StatusNotFound)
 		return
 	}
+	if CLOSED_STATUS[currentProject.ProjectStatus] {
+		utils.ErrorJSON(w, &ProjectClosedError{Status: currentProject.ProjectStatus}, "projectStatus", http.StatusBadRequest)
+		return
+	}
+	newStatus := adminUpdateStatus(currentProject.ProjectStatus, payload)
+	if newStatus != currentProject.ProjectStatus && !canChangeStatus(currentProject.ProjectStatus, newStatus) {
+		utils.ErrorJSON(w, &ProjectStatusTransitionInvalidError{From: currentProject.ProjectStatus, To: newStatus}, "projectStatusSecondary", http.StatusBadRequest)
+		return
+	}
 	err = h.doUpdateProject(currentProject, payload, projectCode, additionFiles, etcFiles)
 	if err != nil {
 		utils.ErrorJSON(w, err, "", http.StatusBadRequest)
@@ -48,6 +58,43 @@ func (h *ProjectHandler) AdminUpdateProject(w http.ResponseWriter, r *http.Reque
 	utils.WriteJSON(w, http.StatusCreated, currentProject.ProjectHistoryId)
 }
 
+func (h *ProjectHandler) AdminCancelProject(w http.ResponseWriter, r *http.Request) {
+	projectCode := chi.URLParam(r, "projectCode")
+	if projectCode == "" {
+		utils.ErrorJSON(w, &ProjectCodeRequiredError{}, "projectCode")
+		return
+	}
+
+	var payload CancelProjectRequest
+	err := utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errField, err := validateCancelProjectPayload(payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	currentProject, err := h.store.GetProjectStatusByProjectCode(projectCode)
+	if err != nil {
+		utils.ErrorJSON(w, err, "", http.StatusNotFound)
+		return
+	}
+	if !canChangeStatus(currentProject.ProjectStatus, "Cancelled") {
+		utils.ErrorJSON(w, &ProjectNotCancellableError{}, "projectStatus", http.StatusBadRequest)
+		return
+	}
+
+	err = h.store.CancelProject(currentProject.ProjectHistoryId, payload.Reason, time.Now())
+	if err != nil {
+		utils.ErrorJSON(w, err, "", http.StatusBadRequest)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, CommonSuccessResponse{Success: true, Message: "cancel project successfully"})
+}
+
 func (h *ProjectHandler) GetAdminRequestDashboard(w http.ResponseWriter, r *http.Request) {
 	var payload GetAdminDashboardRequest
 	err := utils.ReadJSON(w, r, &payload)
@@ -65,16 +112,37 @@ func (h *ProjectHandler) GetAdminRequestDashboard(w http.ResponseWriter, r *http
 		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
 		return
 	}
-	offset := (payload.PageNo - 1) * payload.PageSize
-	orderByStmt := strings.Join(payload.SortBy, ", ")
-	if payload.IsAsc {
-		orderByStmt += " ASC"
-	} else {
-		orderByStmt += " DESC"
+	fromDate := time.Date(payload.FromYear, time.Month(payload.FromMonth), payload.FromDay, 0, 0, 0, 0, loc)
+	toDate := time.Date(payload.ToYear, time.Month(payload.ToMonth), payload.ToDay+1, 0, 0, 0, 0, loc)
+	records, err := h.store.GetAdminRequestDashboard(fromDate, toDate, payload.Sort, payload.PageSize, payload.Cursor, payload.AdminDashboardFilter)
+	if err != nil {
+		writeDashboardError(w, err)
+		return
 	}
+	utils.WriteJSON(w, http.StatusOK, records)
+}
+
+func (h *ProjectHandler) SearchProjects(w http.ResponseWriter, r *http.Request) {
+	var payload SearchProjectsRequest
+	err := utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errField, err := validateSearchProjectsPayload(payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+	loc, err := getTimeLocation()
+	if err != nil {
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	offset := (payload.PageNo - 1) * payload.PageSize
 	fromDate := time.Date(payload.FromYear, time.Month(payload.FromMonth), payload.FromDay, 0, 0, 0, 0, loc)
 	toDate := time.Date(payload.ToYear, time.Month(payload.ToMonth), payload.ToDay+1, 0, 0, 0, 0, loc)
-	records, err := h.store.GetAdminRequestDashboard(fromDate, toDate, orderByStmt, payload.PageSize, offset, payload.ProjectCode, payload.ProjectName, payload.ProjectStatus)
+	records, err := h.store.SearchProjects(payload.Dashboard, strings.TrimSpace(payload.Query), fromDate, toDate, payload.PageSize, offset, payload.AdminDashboardFilter)
 	if err != nil {
 		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
 		return
@@ -99,21 +167,50 @@ func (h *ProjectHandler) GetAdminStartedDashboard(w http.ResponseWriter, r *http
 		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
 		return
 	}
-	offset := (payload.PageNo - 1) * payload.PageSize
-	orderByStmt := strings.Join(payload.SortBy, ", ")
-	if payload.IsAsc {
-		orderByStmt += " ASC"
-	} else {
-		orderByStmt += " DESC"
+	fromDate := time.Date(payload.FromYear, time.Month(payload.FromMonth), payload.FromDay, 0, 0, 0, 0, loc)
+	toDate := time.Date(payload.ToYear, time.Month(payload.ToMonth), payload.ToDay+1, 0, 0, 0, 0, loc)
+	records, err := h.store.GetAdminStartedDashboard(fromDate, toDate, payload.Sort, payload.PageSize, payload.Cursor, payload.AdminDashboardFilter)
+	if err != nil {
+		writeDashboardError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, records)
+}
+
+func (h *ProjectHandler) GetAdminDashboardCount(w http.ResponseWriter, r *http.Request) {
+	var payload GetAdminDashboardCountRequest
+	err := utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errField, err := validateGetAdminDashboardCountPayload(payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+	loc, err := getTimeLocation()
+	if err != nil {
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
 	}
 	fromDate := time.Date(payload.FromYear, time.Month(payload.FromMonth), payload.FromDay, 0, 0, 0, 0, loc)
 	toDate := time.Date(payload.ToYear, time.Month(payload.ToMonth), payload.ToDay+1, 0, 0, 0, 0, loc)
-	records, err := h.store.GetAdminStartedDashboard(fromDate, toDate, orderByStmt, payload.PageSize, offset, payload.ProjectCode, payload.ProjectName, payload.ProjectStatus)
+	count, err := h.store.GetAdminDashboardCount(payload.Dashboard, fromDate, toDate, payload.AdminDashboardFilter)
 	if err != nil {
 		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
 		return
 	}
-	utils.WriteJSON(w, http.StatusOK, records)
+	utils.WriteJSON(w, http.StatusOK, count)
+}
+
+func writeDashboardError(w http.ResponseWriter, err error) {
+	var cursorErr *DashboardCursorInvalidError
+	if errors.As(err, &cursorErr) {
+		utils.ErrorJSON(w, err, "cursor", http.StatusBadRequest)
+		return
+	}
+	utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
 }
 
 func (h *ProjectHandler) GetAdminSummary(w http.ResponseWriter, r *http.Request) {
@@ -135,7 +232,7 @@ func (h *ProjectHandler) GetAdminSummary(w http.ResponseWriter, r *http.Request)
 	}
 	fromDate := time.Date(payload.FromYear, time.Month(payload.FromMonth), payload.FromDay, 0, 0, 0, 0, loc)
 	toDate := time.Date(payload.ToYear, time.Month(payload.ToMonth), payload.ToDay+1, 0, 0, 0, 0, loc)
-	records, err := h.store.GetAdminSummary(fromDate, toDate)
+	records, err := h.store.GetAdminSummary(fromDate, toDate, payload.FundingRoundId)
 	if err != nil {
 		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
 		return
@@ -164,7 +261,7 @@ func (h *ProjectHandler) GenerateAdminReport(w http.ResponseWriter, r *http.Requ
 	fromDate := time.Date(payload.FromYear, time.Month(payload.FromMonth), payload.FromDay, 0, 0, 0, 0, loc)
 	toDate := time.Date(payload.ToYear, time.Month(payload.ToMonth), payload.ToDay+1, 0, 0, 0, 0, loc)
 
-	buffer, err := h.store.GenerateAdminReport(fromDate, toDate)
+	buffer, err := h.store.GenerateAdminReport(fromDate, toDate, payload.FundingRoundId)
 	if err != nil {
 		utils.ErrorJSON(w, err, "report", http.StatusInternalServerError)
 		return
@@ -194,6 +291,7 @@ func (h *ProjectHandler) doUpdateProject(
 				AdminComment:       payload.AdminComment,
 				AdminApprovedAt:    currentProject.AdminApprovedAt,
 				UpdatedAt:          now,
+				ReviseDueAt:        nextReviseDueAt(currentStatus, payload, currentProject),
 			},
 			currentProject.CreatedBy,
 			projectCode,
@@ -206,13 +304,7 @@ func (h *ProjectHandler) doUpdateProject(
 		return nil
 	}
 
-	var newStatus string
-	newStatus = currentStatus
-	if primaryStatusChanged {
-		newStatus = payload.ProjectStatusPrimary
-	} else if secondaryStatusChanged {
-		newStatus = payload.ProjectStatusSecondary
-	}
+	newStatus := adminUpdateStatus(currentStatus, payload)
 
 	if newStatus == "Approved" {
 		// update admin_approved_at to now
@@ -231,6 +323,7 @@ func (h *ProjectHandler) doUpdateProject(
 				AdminComment:       payload.AdminComment,
 				AdminApprovedAt:    approvedAt,
 				UpdatedAt:          now,
+				ReviseDueAt:        nextReviseDueAt(newStatus, payload, currentProject),
 			},
 			currentProject.CreatedBy,
 			projectCode,
@@ -253,6 +346,7 @@ func (h *ProjectHandler) doUpdateProject(
 				AdminComment:       payload.AdminComment,
 				AdminApprovedAt:    nil,
 				UpdatedAt:          now,
+				ReviseDueAt:        nextReviseDueAt(newStatus, payload, currentProject),
 			},
 			currentProject.CreatedBy,
 			projectCode,
@@ -273,6 +367,7 @@ func (h *ProjectHandler) doUpdateProject(
 			AdminComment:       payload.AdminComment,
 			AdminApprovedAt:    currentProject.AdminApprovedAt,
 			UpdatedAt:          now,
+			ReviseDueAt:        nextReviseDueAt(newStatus, payload, currentProject),
 		},
 		currentProject.CreatedBy,
 		projectCode,
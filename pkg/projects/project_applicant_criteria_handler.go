@@ -0,0 +1,126 @@
+package projects
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+func (h *ProjectHandler) GetApplicantCriteriaVersions(w http.ResponseWriter, r *http.Request) {
+	versions, err := h.store.GetApplicantCriteriaVersions()
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, versions)
+}
+
+func (h *ProjectHandler) GetApplicantCriteriaPreview(w http.ResponseWriter, r *http.Request) {
+	criteriaVersion, err := strconv.Atoi(chi.URLParam(r, "criteriaVersion"))
+	if err != nil {
+		utils.ErrorJSON(w, &ApplicantCriteriaVersionNotFoundError{}, "criteriaVersion", http.StatusNotFound)
+		return
+	}
+	preview, err := h.store.GetApplicantCriteriaPreview(criteriaVersion)
+	if err != nil {
+		writeApplicantCriteriaError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, preview)
+}
+
+func (h *ProjectHandler) AddApplicantCriteriaDraft(w http.ResponseWriter, r *http.Request) {
+	var payload ApplicantCriteriaRequest
+	err := utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errField, err := validateApplicantCriteriaPayload(payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	criteriaVersion, err := h.store.AddApplicantCriteriaDraft(payload.Items)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusCreated, criteriaVersion)
+}
+
+func (h *ProjectHandler) UpdateApplicantCriteriaDraft(w http.ResponseWriter, r *http.Request) {
+	criteriaVersion, err := strconv.Atoi(chi.URLParam(r, "criteriaVersion"))
+	if err != nil {
+		utils.ErrorJSON(w, &ApplicantCriteriaVersionNotFoundError{}, "criteriaVersion", http.StatusNotFound)
+		return
+	}
+	var payload ApplicantCriteriaRequest
+	err = utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errField, err := validateApplicantCriteriaPayload(payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	err = h.store.UpdateApplicantCriteriaDraft(criteriaVersion, payload.Items)
+	if err != nil {
+		writeApplicantCriteriaError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, criteriaVersion)
+}
+
+func (h *ProjectHandler) DeleteApplicantCriteriaDraft(w http.ResponseWriter, r *http.Request) {
+	criteriaVersion, err := strconv.Atoi(chi.URLParam(r, "criteriaVersion"))
+	if err != nil {
+		utils.ErrorJSON(w, &ApplicantCriteriaVersionNotFoundError{}, "criteriaVersion", http.StatusNotFound)
+		return
+	}
+	err = h.store.DeleteApplicantCriteriaDraft(criteriaVersion)
+	if err != nil {
+		writeApplicantCriteriaError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, criteriaVersion)
+}
+
+func (h *ProjectHandler) PublishApplicantCriteria(w http.ResponseWriter, r *http.Request) {
+	criteriaVersion, err := strconv.Atoi(chi.URLParam(r, "criteriaVersion"))
+	if err != nil {
+		utils.ErrorJSON(w, &ApplicantCriteriaVersionNotFoundError{}, "criteriaVersion", http.StatusNotFound)
+		return
+	}
+	err = h.store.PublishApplicantCriteria(criteriaVersion)
+	if err != nil {
+		writeApplicantCriteriaError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, criteriaVersion)
+}
+
+func writeApplicantCriteriaError(w http.ResponseWriter, err error) {
+	var notFoundErr *ApplicantCriteriaVersionNotFoundError
+	if errors.As(err, &notFoundErr) {
+		utils.ErrorJSON(w, err, "criteriaVersion", http.StatusNotFound)
+		return
+	}
+	var publishedErr *ApplicantCriteriaVersionPublishedError
+	if errors.As(err, &publishedErr) {
+		utils.ErrorJSON(w, err, "criteriaVersion", http.StatusConflict)
+		return
+	}
+	slog.Error(err.Error())
+	utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+}
@@ -0,0 +1,130 @@
+package projects
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+func (h *ProjectHandler) BulkUpdateProjects(w http.ResponseWriter, r *http.Request) {
+	var payload BulkAdminUpdateRequest
+	err := utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errField, err := validateBulkAdminUpdatePayload(payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	current, err := h.store.GetProjectsForAdminUpdate(payload.ProjectCodes)
+	if err != nil {
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	previous := map[string]AdminBulkUpdateParam{}
+	for _, p := range current {
+		previous[p.ProjectCode] = p
+	}
+
+	items, params := planBulkAdminUpdate(payload, previous, time.Now())
+	res := BulkAdminUpdateResponse{DryRun: payload.DryRun, Items: items}
+	for _, item := range items {
+		if !item.Ok {
+			res.Rejected++
+		}
+	}
+	if res.Rejected > 0 {
+		status := http.StatusBadRequest
+		if payload.DryRun {
+			status = http.StatusOK
+		}
+		utils.WriteJSON(w, status, res)
+		return
+	}
+	if payload.DryRun || len(params) == 0 {
+		utils.WriteJSON(w, http.StatusOK, res)
+		return
+	}
+
+	err = h.store.BulkUpdateProjectsByAdmin(params)
+	if err != nil {
+		var conflict *BulkUpdateConflictError
+		if errors.As(err, &conflict) {
+			utils.ErrorJSON(w, err, "projectCodes", http.StatusConflict)
+			return
+		}
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	res.Applied = true
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+// planBulkAdminUpdate validates every project and lists its changes, following the status transitions
+// and approval date rules of AdminUpdateProject
+func planBulkAdminUpdate(payload BulkAdminUpdateRequest, previous map[string]AdminBulkUpdateParam, now time.Time) ([]BulkAdminUpdateItem, []AdminBulkUpdateParam) {
+	items := []BulkAdminUpdateItem{}
+	params := []AdminBulkUpdateParam{}
+	for _, code := range payload.ProjectCodes {
+		item := BulkAdminUpdateItem{ProjectCode: code, Changes: []BulkFieldChange{}}
+		cur, found := previous[code]
+		if !found {
+			item.Error = (&ProjectNotFoundError{}).Error()
+			items = append(items, item)
+			continue
+		}
+		if CLOSED_STATUS[cur.ProjectStatus] {
+			item.Error = (&ProjectClosedError{Status: cur.ProjectStatus}).Error()
+			items = append(items, item)
+			continue
+		}
+		if payload.ProjectStatus != nil && *payload.ProjectStatus != cur.ProjectStatus && !canChangeStatus(cur.ProjectStatus, *payload.ProjectStatus) {
+			item.Error = (&ProjectStatusTransitionInvalidError{From: cur.ProjectStatus, To: *payload.ProjectStatus}).Error()
+			items = append(items, item)
+			continue
+		}
+
+		next := cur
+		next.UpdatedAt = now
+		next.ExpectedUpdatedAt = cur.UpdatedAt
+		if payload.ProjectStatus != nil && *payload.ProjectStatus != cur.ProjectStatus {
+			next.ProjectStatus = *payload.ProjectStatus
+			item.Changes = append(item.Changes, BulkFieldChange{Field: "projectStatus", From: cur.ProjectStatus, To: next.ProjectStatus})
+			if next.ProjectStatus == "Approved" {
+				next.AdminApprovedAt = &now
+			} else if next.ProjectStatus == "NotApproved" {
+				next.AdminApprovedAt = nil
+			}
+			if !sameTime(cur.AdminApprovedAt, next.AdminApprovedAt) {
+				item.Changes = append(item.Changes, BulkFieldChange{Field: "adminApprovedAt", From: cur.AdminApprovedAt, To: next.AdminApprovedAt})
+			}
+		}
+		if payload.AdminComment != nil && (cur.AdminComment == nil || *cur.AdminComment != *payload.AdminComment) {
+			next.AdminComment = payload.AdminComment
+			item.Changes = append(item.Changes, BulkFieldChange{Field: "adminComment", From: cur.AdminComment, To: next.AdminComment})
+		}
+		if payload.FundApprovedAmount != nil && (cur.FundApprovedAmount == nil || *cur.FundApprovedAmount != *payload.FundApprovedAmount) {
+			next.FundApprovedAmount = payload.FundApprovedAmount
+			item.Changes = append(item.Changes, BulkFieldChange{Field: "fundApprovedAmount", From: cur.FundApprovedAmount, To: next.FundApprovedAmount})
+		}
+
+		item.Ok = true
+		items = append(items, item)
+		if len(item.Changes) > 0 {
+			params = append(params, next)
+		}
+	}
+	return items, params
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
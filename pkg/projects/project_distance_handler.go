@@ -0,0 +1,77 @@
+package projects
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+func (h *ProjectHandler) GetDistanceCatalogue(w http.ResponseWriter, r *http.Request) {
+	catalogue, err := h.store.GetDistanceCatalogue()
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, catalogue)
+}
+
+// MapDistances is the tool moving legacy free text distances onto the catalogue,
+// dryRun shows what would be mapped so admins can add overrides for the rest
+func (h *ProjectHandler) MapDistances(w http.ResponseWriter, r *http.Request) {
+	var payload MapDistancesRequest
+	err := utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errName, err := validateMapDistancesPayload(payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, errName, http.StatusBadRequest)
+		return
+	}
+
+	types, err := h.store.GetUnmappedDistanceTypes()
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	mapped, unmapped := mapDistanceTypes(types, payload.Overrides)
+	response := MapDistancesResponse{DryRun: payload.DryRun, Mapped: mapped, Unmapped: unmapped}
+	if !payload.DryRun && len(mapped) > 0 {
+		response.RowsUpdated, err = h.store.MapDistanceTypes(mapped)
+		if err != nil {
+			slog.Error(err.Error())
+			utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+			return
+		}
+	}
+	utils.WriteJSON(w, http.StatusOK, response)
+}
+
+func (h *ProjectHandler) GetDistanceFeeAnalytics(w http.ResponseWriter, r *http.Request) {
+	var payload DistanceFeeAnalyticsRequest
+	err := utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errName, err := validateDistanceFeeAnalyticsPayload(payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, errName, http.StatusBadRequest)
+		return
+	}
+	statuses := payload.Statuses
+	if statuses == nil {
+		statuses = []string{}
+	}
+	analytics, err := h.store.GetDistanceFeeAnalytics(payload.Year, statuses)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, analytics)
+}
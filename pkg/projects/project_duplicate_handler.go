@@ -0,0 +1,61 @@
+package projects
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+func (h *ProjectHandler) GetProjectDuplicates(w http.ResponseWriter, r *http.Request) {
+	projectCode := chi.URLParam(r, "projectCode")
+	if projectCode == "" {
+		utils.ErrorJSON(w, &ProjectCodeRequiredError{}, "projectCode")
+		return
+	}
+	duplicates, err := h.store.GetProjectDuplicates(projectCode)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, duplicates)
+}
+
+func (h *ProjectHandler) ResolveDuplicate(w http.ResponseWriter, r *http.Request) {
+	userId, err := utils.GetUserIdFromRequestHeader(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, "userId", http.StatusForbidden)
+		return
+	}
+	duplicateId, err := strconv.Atoi(chi.URLParam(r, "duplicateId"))
+	if err != nil {
+		utils.ErrorJSON(w, &DuplicateNotFoundError{}, "duplicateId", http.StatusNotFound)
+		return
+	}
+	var payload ResolveDuplicateRequest
+	err = utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	if _, ok := DUPLICATE_ACTION[payload.Action]; !ok {
+		utils.ErrorJSON(w, &DuplicateActionInvalidError{}, "action", http.StatusBadRequest)
+		return
+	}
+
+	err = h.store.ResolveDuplicate(duplicateId, payload.Action, userId)
+	if err != nil {
+		var notFound *DuplicateNotFoundError
+		if errors.As(err, &notFound) {
+			utils.ErrorJSON(w, err, "duplicateId", http.StatusNotFound)
+			return
+		}
+		utils.ErrorJSON(w, err, "action", http.StatusBadRequest)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, duplicateId)
+}
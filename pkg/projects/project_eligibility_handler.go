@@ -0,0 +1,109 @@
+package projects
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+func (h *ProjectHandler) CheckEligibility(w http.ResponseWriter, r *http.Request) {
+	fundingRoundId, _, err := utils.GetFundingRoundFromRequestHeader(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, "fundingRoundId", http.StatusBadRequest)
+		return
+	}
+	var payload AddProjectRequest
+	err = utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+
+	failures, err := h.checkEligibility(payload, fundingRoundId)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, EligibilityResponse{
+		Eligible: len(failures) == 0,
+		Failures: failures,
+	})
+}
+
+func (h *ProjectHandler) checkEligibility(payload AddProjectRequest, fundingRoundId int) ([]EligibilityFailure, error) {
+	rules, err := h.store.GetEnabledEligibilityRules(fundingRoundId)
+	if err != nil {
+		return nil, err
+	}
+	facts := EligibilityFacts{Now: time.Now()}
+	if needsOrganizationProposalCount(rules) {
+		facts.ActiveOrganizationProposals, err = h.store.CountActiveOrganizationProposals(fundingRoundId, payload.Contact.Organization.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return evaluateEligibility(rules, payload, facts), nil
+}
+
+func (h *ProjectHandler) GetEligibilityRules(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.store.GetAllEligibilityRules()
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, rules)
+}
+
+func (h *ProjectHandler) AddEligibilityRule(w http.ResponseWriter, r *http.Request) {
+	var payload EligibilityRuleRequest
+	err := utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errField, err := validateEligibilityRulePayload(payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	id, err := h.store.AddEligibilityRule(payload)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusBadRequest)
+		return
+	}
+	utils.WriteJSON(w, http.StatusCreated, id)
+}
+
+func (h *ProjectHandler) UpdateEligibilityRule(w http.ResponseWriter, r *http.Request) {
+	ruleId, err := strconv.Atoi(chi.URLParam(r, "ruleId"))
+	if err != nil {
+		utils.ErrorJSON(w, &EligibilityRuleNotFoundError{}, "ruleId", http.StatusNotFound)
+		return
+	}
+	var payload EligibilityRuleRequest
+	err = utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errField, err := validateEligibilityRulePayload(payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	err = h.store.UpdateEligibilityRule(ruleId, payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "ruleId", http.StatusNotFound)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, ruleId)
+}
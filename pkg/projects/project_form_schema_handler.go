@@ -0,0 +1,28 @@
+package projects
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+// GetFormSchema serves the current proposal form schema, or an older one when formSchemaVersion is given
+func (h *ProjectHandler) GetFormSchema(w http.ResponseWriter, r *http.Request) {
+	version := FORM_SCHEMA_VERSION
+	if raw := chi.URLParam(r, "formSchemaVersion"); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil {
+			utils.ErrorJSON(w, &FormSchemaNotFoundError{}, "formSchemaVersion", http.StatusNotFound)
+			return
+		}
+		version = v
+	}
+	schema, found := GetFormSchema(version)
+	if !found {
+		utils.ErrorJSON(w, &FormSchemaNotFoundError{}, "formSchemaVersion", http.StatusNotFound)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, schema)
+}
@@ -22,20 +22,53 @@ import (
 const MAX_UPLOAD_SIZE = 25 * 1024 * 1024 // 25MB
 
 type projectStore interface {
-	GetReviewerDashboard(userId int, from time.Time, to time.Time) ([]ReviewDashboardRow, error)
+	GetReviewerDashboard(userId int, now time.Time, fundingRoundId *int) ([]ReviewDashboardRow, error)
 	GetReviewerProjectDetails(reviewerId int, projectCode string) (ProjectReviewDetailsResponse, error)
 	GetProjectCriteria(criteriaVersion int) ([]ProjectReviewCriteria, error)
 	GetApplicantCriteria(version int) ([]ApplicantSelfScoreCriteria, error)
-	AddProject(addProject AddProjectRequest, userId int, criteria []ApplicantSelfScoreCriteria, attachments []Attachments) (int, error)
+	AddProject(addProject AddProjectRequest, userId int, fundingRoundId int, criteria []ApplicantSelfScoreCriteria, attachments []Attachments) (int, error)
 	GetAllProjectDashboardByApplicantId(applicantId int) ([]ApplicantDashboardItem, error)
 	GetApplicantProjectDetails(isAdmin bool, projectCode string, userId int) ([]ApplicantDetailsData, error)
 	HasPermissionToAddAdditionalFiles(userId int, projectCode string) bool
 	GetProjectStatusByProjectCode(projectCode string) (AdminUpdateParam, error)
 	UpdateProjectByAdmin(payload AdminUpdateParam, userId int, projectCode string, additionFiles []*multipart.FileHeader, etcFiles []*multipart.FileHeader) error
-	GetAdminRequestDashboard(fromDate, toDate time.Time, orderBy string, limit, offset int, projectCode, projectName, projectStatus *string) ([]AdminRequestDashboardRow, error)
-	GetAdminStartedDashboard(fromDate, toDate time.Time, orderBy string, limit, offset int, projectCode, projectName, projectStatus *string) ([]AdminRequestDashboardRow, error)
-	GetAdminSummary(fromDate, toDate time.Time) ([]AdminSummaryData, error)
-	GenerateAdminReport(fromDate, toDate time.Time) (*bytes.Buffer, error)
+	GetAdminRequestDashboard(fromDate, toDate time.Time, sort []DashboardSort, limit int, cursor *string, filter AdminDashboardFilter) (AdminDashboardPage, error)
+	GetAdminStartedDashboard(fromDate, toDate time.Time, sort []DashboardSort, limit int, cursor *string, filter AdminDashboardFilter) (AdminDashboardPage, error)
+	GetProjectsForAdminUpdate(projectCodes []string) ([]AdminBulkUpdateParam, error)
+	BulkUpdateProjectsByAdmin(params []AdminBulkUpdateParam) error
+	GetAdminDashboardCount(dashboardType string, fromDate, toDate time.Time, filter AdminDashboardFilter) (AdminDashboardCount, error)
+	GetAdminSummary(fromDate, toDate time.Time, fundingRoundId *int) ([]AdminSummaryData, error)
+	GenerateAdminReport(fromDate, toDate time.Time, fundingRoundId *int) (*bytes.Buffer, error)
+	WithdrawProject(projectHistoryId int, reason string, withdrawnAt time.Time) error
+	CancelProject(projectHistoryId int, reason string, cancelledAt time.Time) error
+	GetProjectFullDetails(projectCode string, version int) (ProjectFullDetailsResponse, error)
+	GetEnabledEligibilityRules(fundingRoundId int) ([]EligibilityRule, error)
+	GetAllEligibilityRules() ([]EligibilityRule, error)
+	CountActiveOrganizationProposals(fundingRoundId int, organizationName string) (int, error)
+	AddEligibilityRule(payload EligibilityRuleRequest) (int, error)
+	UpdateEligibilityRule(ruleId int, payload EligibilityRuleRequest) error
+	GetProjectDuplicates(projectCode string) ([]ProjectDuplicate, error)
+	ResolveDuplicate(duplicateId int, action string, adminId int) error
+	GetProjectNotes(projectCode string) ([]ProjectNote, error)
+	AddProjectNote(projectCode string, parentId *int, body string, userId int, createdAt time.Time) (int, error)
+	GetProjectTags(projectCode string) ([]string, error)
+	ReplaceProjectTags(projectCode string, tags []string, userId int) error
+	GetAllProjectTags() ([]ProjectTagCount, error)
+	GetProjectOwner(projectCode string) (ProjectOwner, error)
+	GetProjectMessages(projectCode string) ([]ProjectMessage, error)
+	AddProjectMessage(param AddProjectMessageParam, attachments []*multipart.FileHeader) (int, error)
+	MarkProjectMessagesRead(projectCode string, readerRole string, readerId int, readAt time.Time) (int64, error)
+	SearchProjects(dashboardType string, query string, fromDate, toDate time.Time, limit, offset int, filter AdminDashboardFilter) ([]ProjectSearchRow, error)
+	GetDistanceCatalogue() ([]DistanceCatalogueItem, error)
+	GetUnmappedDistanceTypes() ([]DistanceTypeCount, error)
+	MapDistanceTypes(mappings []MappedDistanceType) (int64, error)
+	GetDistanceFeeAnalytics(year *int, statuses []string) (DistanceFeeAnalytics, error)
+	GetApplicantCriteriaVersions() ([]ApplicantCriteriaVersion, error)
+	GetApplicantCriteriaPreview(criteriaVersion int) (ApplicantCriteriaPreview, error)
+	AddApplicantCriteriaDraft(items []ApplicantCriteriaItem) (int, error)
+	UpdateApplicantCriteriaDraft(criteriaVersion int, items []ApplicantCriteriaItem) error
+	DeleteApplicantCriteriaDraft(criteriaVersion int) error
+	PublishApplicantCriteria(criteriaVersion int) error
 }
 
 type ProjectHandler struct {
@@ -68,7 +101,7 @@ func (h *ProjectHandler) GetReviewerDashboard(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	projects, err := h.store.GetReviewerDashboard(userId, payload.FromDate, payload.ToDate)
+	projects, err := h.store.GetReviewerDashboard(userId, time.Now(), payload.FundingRoundId)
 	if err != nil {
 		slog.Error(err.Error())
 		utils.ErrorJSON(w, err, "")
@@ -219,10 +252,11 @@ func (h *ProjectHandler) AddProject(w http.ResponseWriter, r *http.Request) {
 			Files:           marketingFiles,
 		},
 		{
-			DirName:         fmt.Sprintf("%s/เส้นทางจุดเริ่มต้นถึงจุดสิ้นสุดและเส้นทางวิ่งในทุกระยะ", attachmentsStr),
-			ZipName:         attachmentsStr,
-			InZipFilePrefix: "เส้นทางจุดเริ่มต้นถึงจุดสิ้นสุดและเส้นทางวิ่งในทุกระยะ",
-			Files:           routeFiles,
+			DirName:          fmt.Sprintf("%s/เส้นทางจุดเริ่มต้นถึงจุดสิ้นสุดและเส้นทางวิ่งในทุกระยะ", attachmentsStr),
+			ZipName:          attachmentsStr,
+			InZipFilePrefix:  "เส้นทางจุดเริ่มต้นถึงจุดสิ้นสุดและเส้นทางวิ่งในทุกระยะ",
+			Files:            routeFiles,
+			AllowRouteTracks: true,
 		},
 		{
 			DirName:         fmt.Sprintf("%s/แผนผังบริเวณการจัดงาน", attachmentsStr),
@@ -244,11 +278,10 @@ func (h *ProjectHandler) AddProject(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
-	v := os.Getenv("APPLICANT_CRITERIA_VERSION")
-	criteriaVersion, err := strconv.Atoi(v)
+	fundingRoundId, criteriaVersion, err := utils.GetFundingRoundFromRequestHeader(r)
 	if err != nil {
 		slog.Error(err.Error(), "criteriaVersion", criteriaVersion)
-		utils.ErrorJSON(w, err, "APPLICANT_CRITERIA_VERSION", http.StatusBadRequest)
+		utils.ErrorJSON(w, err, "fundingRoundId", http.StatusBadRequest)
 		return
 	}
 	criteria, err := h.store.GetApplicantCriteria(criteriaVersion)
@@ -261,11 +294,35 @@ func (h *ProjectHandler) AddProject(w http.ResponseWriter, r *http.Request) {
 	err = validateAddProjectPayload(payload, criteria, marketingFiles, routeFiles, eventMapFiles, eventDetailsFiles)
 	if err != nil {
 		slog.Error("error validateAddProjectPayload", "error", err.Error(), "payload", payload)
-		utils.ErrorJSON(w, err, "", http.StatusBadRequest)
+		errName := ""
+		var validationErr *utils.ValidationError
+		if errors.As(err, &validationErr) {
+			errName = validationErr.Errors[0].Path
+		}
+		utils.ErrorJSON(w, err, errName, http.StatusBadRequest)
 		return
 	}
 
-	projectId, err := h.store.AddProject(payload, userId, criteria, attachments)
+	payload.RouteTracks, err = parseRouteFiles(routeFiles)
+	if err != nil {
+		slog.Error("error parseRouteFiles", "error", err.Error())
+		utils.ErrorJSON(w, err, "routeFiles", http.StatusBadRequest)
+		return
+	}
+
+	failures, err := h.checkEligibility(payload, fundingRoundId)
+	if err != nil {
+		slog.Error("error checkEligibility", "error", err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	if len(failures) > 0 {
+		errs := eligibilityValidationError(failures)
+		utils.ErrorJSON(w, errs, errs.Errors[0].Path, http.StatusBadRequest)
+		return
+	}
+
+	projectId, err := h.store.AddProject(payload, userId, fundingRoundId, criteria, attachments)
 	if err != nil {
 		slog.Error("error add project store", "error", err.Error(), "payload", payload)
 		utils.ErrorJSON(w, err, "", http.StatusBadRequest)
@@ -298,9 +355,69 @@ func (h *ProjectHandler) GetApplicantProjectDetails(w http.ResponseWriter, r *ht
 		utils.ErrorJSON(w, err, "userId + projectCode", http.StatusNotFound)
 		return
 	}
+	// the bare array stays the default shape, messages are opt-in with ?include=messages
+	if r.URL.Query().Get("include") != "messages" {
+		utils.WriteJSON(w, http.StatusOK, projectDetails)
+		return
+	}
 
-	utils.WriteJSON(w, http.StatusOK, projectDetails)
+	response := ApplicantProjectDetailsResponse{ProjectDetails: projectDetails, Messages: []ProjectMessage{}}
+	// no rows means the applicant does not own the project, so there are no messages to show either
+	if len(projectDetails) > 0 {
+		response.Messages, err = h.store.GetProjectMessages(projectCode)
+		if err != nil {
+			slog.Error(err.Error())
+			utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+			return
+		}
+	}
+	utils.WriteJSON(w, http.StatusOK, response)
+}
+
+func (h *ProjectHandler) GetProjectFullDetails(w http.ResponseWriter, r *http.Request) {
+	userRole := utils.GetUserRoleFromRequestHeader(r)
+	if userRole != "admin" && userRole != "reviewer" {
+		utils.ErrorJSON(w, errors.New("access denied. No permission"), "userRole", http.StatusForbidden)
+		return
+	}
+	projectCode := chi.URLParam(r, "projectCode")
+	if projectCode == "" {
+		utils.ErrorJSON(w, &ProjectCodeRequiredError{}, "projectCode")
+		return
+	}
+	// version is optional, the current version is returned when it is omitted
+	version := 0
+	rawVersion := r.URL.Query().Get("version")
+	if rawVersion != "" {
+		v, err := strconv.Atoi(rawVersion)
+		if err != nil || v < 1 {
+			utils.ErrorJSON(w, &ProjectVersionInvalidError{}, "version")
+			return
+		}
+		version = v
+	}
 
+	details, err := h.store.GetProjectFullDetails(projectCode, version)
+	if err != nil {
+		slog.Error(err.Error())
+		var notFound *ProjectNotFoundError
+		if errors.As(err, &notFound) {
+			utils.ErrorJSON(w, err, "projectCode", http.StatusNotFound)
+			return
+		}
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	// reviewers are not part of the applicant conversation
+	if userRole == "admin" {
+		details.Messages, err = h.store.GetProjectMessages(projectCode)
+		if err != nil {
+			slog.Error(err.Error())
+			utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+			return
+		}
+	}
+	utils.WriteJSON(w, http.StatusOK, details)
 }
 
 func (h *ProjectHandler) ListApplicantFiles(w http.ResponseWriter, r *http.Request) {
@@ -436,3 +553,47 @@ func (h *ProjectHandler) AddProjectAdditionFiles(w http.ResponseWriter, r *http.
 
 	utils.WriteJSON(w, http.StatusOK, CommonSuccessResponse{Success: true, Message: "upload files successfully"})
 }
+
+func (h *ProjectHandler) WithdrawProject(w http.ResponseWriter, r *http.Request) {
+	userId, err := utils.GetUserIdFromRequestHeader(r)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "userId", http.StatusForbidden)
+		return
+	}
+	projectCode := chi.URLParam(r, "projectCode")
+	if projectCode == "" {
+		utils.ErrorJSON(w, &ProjectCodeRequiredError{}, "projectCode")
+		return
+	}
+
+	var payload WithdrawProjectRequest
+	err = utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errField, err := validateWithdrawProjectPayload(payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, errField, http.StatusBadRequest)
+		return
+	}
+
+	currentProject, err := h.store.GetProjectStatusByProjectCode(projectCode)
+	if err != nil || currentProject.CreatedBy != userId {
+		utils.ErrorJSON(w, &ProjectNotFoundError{}, "projectCode", http.StatusNotFound)
+		return
+	}
+	if !canChangeStatus(currentProject.ProjectStatus, "Withdrawn") {
+		utils.ErrorJSON(w, &ProjectNotWithdrawableError{}, "projectStatus", http.StatusBadRequest)
+		return
+	}
+
+	err = h.store.WithdrawProject(currentProject.ProjectHistoryId, payload.Reason, time.Now())
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusBadRequest)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, CommonSuccessResponse{Success: true, Message: "withdraw project successfully"})
+}
@@ -0,0 +1,72 @@
+package projects
+
+import (
+	"fmt"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	PROJECT_MESSAGE_MAX_LENGTH      = 4000
+	PROJECT_MESSAGE_MAX_ATTACHMENTS = 5
+)
+
+// ProjectMessage is part of the conversation between the applicant and admins, both sides can see it
+type ProjectMessage struct {
+	Id          int                        `json:"id"`
+	ProjectCode string                     `json:"projectCode"`
+	SenderId    int                        `json:"senderId"`
+	SenderRole  string                     `json:"senderRole"`
+	SenderName  string                     `json:"senderName"`
+	Body        string                     `json:"body"`
+	Attachments []ProjectMessageAttachment `json:"attachments"`
+	CreatedAt   time.Time                  `json:"createdAt"`
+	ReadBy      *int                       `json:"readBy,omitempty"`
+	ReadAt      *time.Time                 `json:"readAt,omitempty"`
+}
+
+// Path is relative to the project owner's prefix so it can be passed to the presigned url endpoint
+type ProjectMessageAttachment struct {
+	Id       int    `json:"id"`
+	FileName string `json:"fileName"`
+	Path     string `json:"path"`
+}
+
+type AddProjectMessageRequest struct {
+	Body string `json:"body"`
+}
+
+type AddProjectMessageParam struct {
+	ProjectCode string
+	ProjectName string
+	OwnerId     int
+	SenderId    int
+	SenderRole  string
+	Body        string
+	CreatedAt   time.Time
+}
+
+type ProjectOwner struct {
+	UserId      int
+	ProjectName string
+}
+
+type ApplicantProjectDetailsResponse struct {
+	ProjectDetails []ApplicantDetailsData `json:"projectDetails"`
+	Messages       []ProjectMessage       `json:"messages"`
+}
+
+func projectMessageObjectPrefix(ownerId int, projectCode string, messageId int) string {
+	return fmt.Sprintf("applicant/user_%d/%s", ownerId, projectMessagePath(projectCode, messageId))
+}
+
+func projectMessagePath(projectCode string, messageId int) string {
+	return fmt.Sprintf("%s/messages/%d", projectCode, messageId)
+}
+
+// uploadedFileName matches the object name UploadFilesToS3 gives a file
+func uploadedFileName(fileHeader *multipart.FileHeader) string {
+	return fmt.Sprintf("%s%s", strings.Split(fileHeader.Filename, ".")[0], filepath.Ext(fileHeader.Filename))
+}
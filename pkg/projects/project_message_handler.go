@@ -0,0 +1,118 @@
+package projects
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+func (h *ProjectHandler) GetProjectMessages(w http.ResponseWriter, r *http.Request) {
+	projectCode := chi.URLParam(r, "projectCode")
+	_, _, ok := h.getProjectOwnerForMessages(w, r, projectCode)
+	if !ok {
+		return
+	}
+	messages, err := h.store.GetProjectMessages(projectCode)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, messages)
+}
+
+func (h *ProjectHandler) AddProjectMessage(w http.ResponseWriter, r *http.Request) {
+	projectCode := chi.URLParam(r, "projectCode")
+	userId, owner, ok := h.getProjectOwnerForMessages(w, r, projectCode)
+	if !ok {
+		return
+	}
+
+	if err := r.ParseMultipartForm(MAX_UPLOAD_SIZE); err != nil {
+		utils.ErrorJSON(w, err, "", http.StatusBadRequest)
+		return
+	}
+	var payload AddProjectMessageRequest
+	err := json.Unmarshal([]byte(r.FormValue("form")), &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "form", http.StatusBadRequest)
+		return
+	}
+	attachments := r.MultipartForm.File["attachments"]
+	errName, err := validateAddProjectMessagePayload(payload, attachments)
+	if err != nil {
+		utils.ErrorJSON(w, err, errName, http.StatusBadRequest)
+		return
+	}
+
+	messageId, err := h.store.AddProjectMessage(AddProjectMessageParam{
+		ProjectCode: projectCode,
+		ProjectName: owner.ProjectName,
+		OwnerId:     owner.UserId,
+		SenderId:    userId,
+		SenderRole:  utils.GetUserRoleFromRequestHeader(r),
+		Body:        payload.Body,
+		CreatedAt:   time.Now(),
+	}, attachments)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusCreated, messageId)
+}
+
+func (h *ProjectHandler) MarkProjectMessagesRead(w http.ResponseWriter, r *http.Request) {
+	projectCode := chi.URLParam(r, "projectCode")
+	userId, _, ok := h.getProjectOwnerForMessages(w, r, projectCode)
+	if !ok {
+		return
+	}
+	count, err := h.store.MarkProjectMessagesRead(projectCode, utils.GetUserRoleFromRequestHeader(r), userId, time.Now())
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, count)
+}
+
+// getProjectOwnerForMessages allows admins and the applicant who owns the project, it writes the error response itself
+func (h *ProjectHandler) getProjectOwnerForMessages(w http.ResponseWriter, r *http.Request, projectCode string) (int, ProjectOwner, bool) {
+	userId, err := utils.GetUserIdFromRequestHeader(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, "userId", http.StatusForbidden)
+		return 0, ProjectOwner{}, false
+	}
+	userRole := utils.GetUserRoleFromRequestHeader(r)
+	if userRole != "admin" && userRole != "applicant" {
+		utils.ErrorJSON(w, errors.New("access denied. No permission"), "userRole", http.StatusForbidden)
+		return 0, ProjectOwner{}, false
+	}
+	if projectCode == "" {
+		utils.ErrorJSON(w, &ProjectCodeRequiredError{}, "projectCode")
+		return 0, ProjectOwner{}, false
+	}
+	owner, err := h.store.GetProjectOwner(projectCode)
+	if err != nil {
+		var notFound *ProjectNotFoundError
+		if errors.As(err, &notFound) {
+			utils.ErrorJSON(w, err, "projectCode", http.StatusNotFound)
+			return 0, ProjectOwner{}, false
+		}
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return 0, ProjectOwner{}, false
+	}
+	// applicants must not learn whether other applicants' projects exist
+	if userRole == "applicant" && owner.UserId != userId {
+		utils.ErrorJSON(w, &ProjectNotFoundError{}, "projectCode", http.StatusNotFound)
+		return 0, ProjectOwner{}, false
+	}
+	return userId, owner, true
+}
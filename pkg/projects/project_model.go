@@ -111,6 +111,8 @@ type Attachments struct {
 	ZipName         string
 	InZipFilePrefix string
 	Files           []*multipart.FileHeader
+	// AllowRouteTracks lets GPX/KML files through the upload type check
+	AllowRouteTracks bool
 }
 
 type ApplicantDashboardItem struct {
@@ -146,6 +148,39 @@ type AdminUpdateParam struct {
 	AdminComment       *string    `json:"adminComment,omitempty"`
 	AdminApprovedAt    *time.Time `json:"adminApprovedAt,omitempty"`
 	UpdatedAt          time.Time  `json:"updatedAt,omitempty"`
+	ReviseDueAt        *time.Time `json:"reviseDueAt,omitempty"`
+}
+
+type AdminBulkUpdateParam struct {
+	ProjectCode        string     `json:"projectCode,omitempty"`
+	ProjectHistoryId   int        `json:"projectHistoryId,omitempty"`
+	ProjectStatus      string     `json:"projectStatus,omitempty"`
+	FundApprovedAmount *int64     `json:"fundApprovedAmount,omitempty"`
+	AdminComment       *string    `json:"adminComment,omitempty"`
+	AdminApprovedAt    *time.Time `json:"adminApprovedAt,omitempty"`
+	UpdatedAt          time.Time  `json:"updatedAt,omitempty"`
+	// the batch is only applied if the row still has the updated_at it was planned from
+	ExpectedUpdatedAt time.Time `json:"-"`
+}
+
+type BulkFieldChange struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+type BulkAdminUpdateItem struct {
+	ProjectCode string            `json:"projectCode"`
+	Ok          bool              `json:"ok"`
+	Error       string            `json:"error,omitempty"`
+	Changes     []BulkFieldChange `json:"changes"`
+}
+
+type BulkAdminUpdateResponse struct {
+	DryRun   bool                  `json:"dryRun"`
+	Applied  bool                  `json:"applied"`
+	Rejected int                   `json:"rejected"`
+	Items    []BulkAdminUpdateItem `json:"items"`
 }
 
 type S3ObjectDetails struct {
@@ -166,20 +201,45 @@ type AddressDetails struct {
 }
 
 type AdminRequestDashboardRow struct {
-	ProjectCode      string    `json:"projectCode,omitempty"`
-	ProjectCreatedAt time.Time `json:"projectCreatedAt,omitempty"`
-	ProjectName      string    `json:"projectName,omitempty"`
-	ProjectStatus    string    `json:"projectStatus,omitempty"`
-	ProjectUpdatedAt time.Time `json:"projectUpdatedAt,omitempty"`
-	AdminComment     *string   `json:"adminComment,omitempty"`
-	AvgScore         *float64  `json:"avgScore,omitempty"`
-	Count            int       `json:"count,omitempty"`
+	ProjectCode        string     `json:"projectCode,omitempty"`
+	ProjectCreatedAt   time.Time  `json:"projectCreatedAt,omitempty"`
+	ProjectName        string     `json:"projectName,omitempty"`
+	ProjectStatus      string     `json:"projectStatus,omitempty"`
+	ProjectUpdatedAt   time.Time  `json:"projectUpdatedAt,omitempty"`
+	AdminComment       *string    `json:"adminComment,omitempty"`
+	AvgScore           *float64   `json:"avgScore,omitempty"`
+	SuspectedDuplicate bool       `json:"suspectedDuplicate"`
+	ReviseDueAt        *time.Time `json:"reviseDueAt,omitempty"`
+	ReviseOverdue      bool       `json:"reviseOverdue"`
+	Tags               []string   `json:"tags"`
+	Count              int        `json:"count,omitempty"`
+}
+
+type AdminDashboardPage struct {
+	Items      []AdminRequestDashboardRow `json:"items"`
+	NextCursor *string                    `json:"nextCursor"`
+}
+
+type AdminDashboardCount struct {
+	Total                  int            `json:"total"`
+	ByStatus               map[string]int `json:"byStatus"`
+	ByExpectedParticipants map[string]int `json:"byExpectedParticipants"`
+	ByCategory             struct {
+		RoadRace     int `json:"roadRace"`
+		TrailRunning int `json:"trailRunning"`
+	} `json:"byCategory"`
+}
+
+type ProjectSearchRow struct {
+	AdminRequestDashboardRow
+	Rank float64 `json:"rank"`
 }
 
 type AdminSummaryData struct {
 	Status  string `json:"status"`
 	Count   int    `json:"count"`
 	FundSum *int64 `json:"fundSum"`
+	PaidSum *int64 `json:"paidSum"`
 }
 
 type AdminReportRow struct {
@@ -188,4 +248,19 @@ type AdminReportRow struct {
 	CreatedAt          time.Time
 	FromDate           time.Time
 	FundApprovedAmount *int64
+	Tags               string
+}
+
+type ProjectFullDetailsResponse struct {
+	ProjectCode      string            `json:"projectCode"`
+	ProjectVersion   int               `json:"projectVersion"`
+	ProjectHistoryId int               `json:"projectHistoryId"`
+	UserId           int               `json:"userId"`
+	ProjectStatus    string            `json:"projectStatus"`
+	CreatedAt        time.Time         `json:"createdAt"`
+	UpdatedAt        time.Time         `json:"updatedAt"`
+	Versions         []int             `json:"versions"`
+	Form             AddProjectRequest `json:"form"`
+	RouteCheck       RouteCheck        `json:"routeCheck"`
+	Messages         []ProjectMessage  `json:"messages,omitempty"`
 }
@@ -0,0 +1,54 @@
+package projects
+
+import (
+	"strings"
+	"time"
+)
+
+// ProjectNote is an internal admin note, applicants never see these
+type ProjectNote struct {
+	Id            int           `json:"id"`
+	ProjectCode   string        `json:"projectCode"`
+	ParentId      *int          `json:"parentId,omitempty"`
+	Body          string        `json:"body"`
+	CreatedBy     int           `json:"createdBy"`
+	CreatedByName string        `json:"createdByName"`
+	CreatedAt     time.Time     `json:"createdAt"`
+	Replies       []ProjectNote `json:"replies,omitempty"`
+}
+
+type AddProjectNoteRequest struct {
+	Body     string `json:"body"`
+	ParentId *int   `json:"parentId,omitempty"`
+}
+
+type UpdateProjectTagsRequest struct {
+	Tags []string `json:"tags"`
+}
+
+type ProjectTagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// normaliseTag folds case and whitespace so "Needs  Site Visit" and "needs site visit" are the same tag
+func normaliseTag(tag string) string {
+	return strings.ToLower(strings.Join(strings.Fields(tag), " "))
+}
+
+// threadProjectNotes nests replies under their root note, notes must be ordered by created_at
+func threadProjectNotes(notes []ProjectNote) []ProjectNote {
+	roots := []ProjectNote{}
+	rootIndex := map[int]int{}
+	for _, n := range notes {
+		if n.ParentId == nil {
+			rootIndex[n.Id] = len(roots)
+			roots = append(roots, n)
+			continue
+		}
+		if i, ok := rootIndex[*n.ParentId]; ok {
+			roots[i].Replies = append(roots[i].Replies, n)
+		}
+	}
+	return roots
+}
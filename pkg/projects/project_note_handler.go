@@ -0,0 +1,130 @@
+package projects
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+func (h *ProjectHandler) GetProjectNotes(w http.ResponseWriter, r *http.Request) {
+	projectCode := chi.URLParam(r, "projectCode")
+	if projectCode == "" {
+		utils.ErrorJSON(w, &ProjectCodeRequiredError{}, "projectCode")
+		return
+	}
+	notes, err := h.store.GetProjectNotes(projectCode)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, notes)
+}
+
+func (h *ProjectHandler) AddProjectNote(w http.ResponseWriter, r *http.Request) {
+	userId, err := utils.GetUserIdFromRequestHeader(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, "userId", http.StatusForbidden)
+		return
+	}
+	projectCode := chi.URLParam(r, "projectCode")
+	if projectCode == "" {
+		utils.ErrorJSON(w, &ProjectCodeRequiredError{}, "projectCode")
+		return
+	}
+	var payload AddProjectNoteRequest
+	err = utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	errName, err := validateAddProjectNotePayload(payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, errName, http.StatusBadRequest)
+		return
+	}
+
+	noteId, err := h.store.AddProjectNote(projectCode, payload.ParentId, payload.Body, userId, time.Now())
+	if err != nil {
+		var projectNotFound *ProjectNotFoundError
+		if errors.As(err, &projectNotFound) {
+			utils.ErrorJSON(w, err, "projectCode", http.StatusNotFound)
+			return
+		}
+		var parentNotFound *ProjectNoteParentNotFoundError
+		if errors.As(err, &parentNotFound) {
+			utils.ErrorJSON(w, err, "parentId", http.StatusBadRequest)
+			return
+		}
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusCreated, noteId)
+}
+
+func (h *ProjectHandler) GetProjectTags(w http.ResponseWriter, r *http.Request) {
+	projectCode := chi.URLParam(r, "projectCode")
+	if projectCode == "" {
+		utils.ErrorJSON(w, &ProjectCodeRequiredError{}, "projectCode")
+		return
+	}
+	tags, err := h.store.GetProjectTags(projectCode)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, tags)
+}
+
+func (h *ProjectHandler) UpdateProjectTags(w http.ResponseWriter, r *http.Request) {
+	userId, err := utils.GetUserIdFromRequestHeader(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, "userId", http.StatusForbidden)
+		return
+	}
+	projectCode := chi.URLParam(r, "projectCode")
+	if projectCode == "" {
+		utils.ErrorJSON(w, &ProjectCodeRequiredError{}, "projectCode")
+		return
+	}
+	var payload UpdateProjectTagsRequest
+	err = utils.ReadJSON(w, r, &payload)
+	if err != nil {
+		utils.ErrorJSON(w, err, "payload", http.StatusBadRequest)
+		return
+	}
+	tags, errName, err := normaliseProjectTags(payload.Tags)
+	if err != nil {
+		utils.ErrorJSON(w, err, errName, http.StatusBadRequest)
+		return
+	}
+
+	err = h.store.ReplaceProjectTags(projectCode, tags, userId)
+	if err != nil {
+		var projectNotFound *ProjectNotFoundError
+		if errors.As(err, &projectNotFound) {
+			utils.ErrorJSON(w, err, "projectCode", http.StatusNotFound)
+			return
+		}
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, tags)
+}
+
+func (h *ProjectHandler) GetAllProjectTags(w http.ResponseWriter, r *http.Request) {
+	tags, err := h.store.GetAllProjectTags()
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "", http.StatusInternalServerError)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, tags)
+}
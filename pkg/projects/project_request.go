@@ -3,8 +3,7 @@ package projects
 import "time"
 
 type GetReviewerDashboardRequest struct {
-	FromDate time.Time `json:"fromDate"`
-	ToDate   time.Time `json:"toDate"`
+	FundingRoundId *int `json:"fundingRoundId,omitempty"`
 }
 
 type AddReviewRequest struct {
@@ -37,19 +36,22 @@ type AddProjectRequest struct {
 	Details      Details                  `json:"details,omitempty"`
 	Experience   Experience               `json:"experience,omitempty"`
 	Fund         Fund                     `json:"fund,omitempty"`
+	// RouteTracks are parsed from the GPX/KML routeFiles by the handler
+	RouteTracks []RouteTrack `json:"-"`
 }
 
 // Sub-types for AddProjectRequest
 type AddProjectGeneralDetails struct {
-	ProjectName          string       `json:"projectName,omitempty"`
-	EventDate            EventDate    `json:"eventDate,omitempty"`
-	Address              Address      `json:"address,omitempty"`
-	StartPoint           string       `json:"startPoint,omitempty"`
-	FinishPoint          string       `json:"finishPoint,omitempty"`
-	EventDetails         EventDetails `json:"eventDetails,omitempty"`
-	ExpectedParticipants string       `json:"expectedParticipants,omitempty"`
-	HasOrganizer         *bool        `json:"hasOrganizer,omitempty"`
-	OrganizerName        string       `json:"organizerName,omitempty"`
+	ProjectName            string       `json:"projectName,omitempty"`
+	EventDate              EventDate    `json:"eventDate,omitempty"`
+	Address                Address      `json:"address,omitempty"`
+	StartPoint             string       `json:"startPoint,omitempty"`
+	FinishPoint            string       `json:"finishPoint,omitempty"`
+	EventDetails           EventDetails `json:"eventDetails,omitempty"`
+	ExpectedParticipants   string       `json:"expectedParticipants,omitempty"`
+	HasOrganizer           *bool        `json:"hasOrganizer,omitempty"`
+	OrganizerName          string       `json:"organizerName,omitempty"`
+	OrganizerPublicConsent bool         `json:"organizerPublicConsent,omitempty"`
 }
 
 type EventDate struct {
@@ -91,6 +93,8 @@ type Available struct {
 type DistanceAndFee struct {
 	Checked bool     `json:"checked,omitempty"`
 	Type    string   `json:"type,omitempty"`
+	Code    string   `json:"code,omitempty"`
+	Km      *float64 `json:"km,omitempty"`
 	Fee     *float64 `json:"fee,omitempty"`
 	Dynamic *bool    `json:"dynamic,omitempty"`
 }
@@ -279,9 +283,18 @@ type Fund struct {
 }
 
 type Budget struct {
-	Total               int    `json:"total,omitempty"`
-	SupportOrganization string `json:"supportOrganization,omitempty"`
-	NoAlcoholSponsor    bool   `json:"noAlcoholSponsor,omitempty"`
+	Total               int          `json:"total,omitempty"`
+	SupportOrganization string       `json:"supportOrganization,omitempty"`
+	NoAlcoholSponsor    bool         `json:"noAlcoholSponsor,omitempty"`
+	Items               []BudgetItem `json:"items,omitempty"`
+}
+
+type BudgetItem struct {
+	Category      string `json:"category,omitempty"`
+	Description   string `json:"description,omitempty"`
+	Quantity      int    `json:"quantity,omitempty"`
+	UnitCost      int    `json:"unitCost,omitempty"`
+	FundingSource string `json:"fundingSource,omitempty"`
 }
 
 type FundRequest struct {
@@ -322,38 +335,118 @@ type AdminUpdateProjectRequest struct {
 	FundApprovedAmount     *int64     `json:"fundApprovedAmount,omitempty"`
 	AdminComment           *string    `json:"adminComment,omitempty"`
 	AdminApprovedAt        *time.Time `json:"adminApprovedAt,omitempty"`
+	// ReviseDueAt is the deadline for the applicant to revise, only accepted with the Revise status
+	ReviseDueAt *time.Time `json:"reviseDueAt,omitempty"`
+}
+
+type WithdrawProjectRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+type CancelProjectRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// BulkAdminUpdateRequest applies the same change to every project, a nil field is left unchanged
+type BulkAdminUpdateRequest struct {
+	ProjectCodes       []string `json:"projectCodes,omitempty"`
+	ProjectStatus      *string  `json:"projectStatus,omitempty"`
+	AdminComment       *string  `json:"adminComment,omitempty"`
+	FundApprovedAmount *int64   `json:"fundApprovedAmount,omitempty"`
+	DryRun             bool     `json:"dryRun,omitempty"`
 }
 
 type GetAdminDashboardRequest struct {
-	FromYear      int      `json:"fromYear,omitempty"`
-	FromMonth     int      `json:"fromMonth,omitempty"`
-	FromDay       int      `json:"fromDay,omitempty"`
-	ToYear        int      `json:"toYear,omitempty"`
-	ToMonth       int      `json:"toMonth,omitempty"`
-	ToDay         int      `json:"toDay,omitempty"`
-	PageNo        int      `json:"pageNo,omitempty"`
-	PageSize      int      `json:"pageSize,omitempty"`
-	SortBy        []string `json:"sortBy,omitempty"`
-	IsAsc         bool     `json:"isAsc,omitempty"`
-	ProjectCode   *string  `json:"projectCode,omitempty"`
-	ProjectName   *string  `json:"projectName,omitempty"`
-	ProjectStatus *string  `json:"projectStatus,omitempty"`
+	FromYear  int             `json:"fromYear,omitempty"`
+	FromMonth int             `json:"fromMonth,omitempty"`
+	FromDay   int             `json:"fromDay,omitempty"`
+	ToYear    int             `json:"toYear,omitempty"`
+	ToMonth   int             `json:"toMonth,omitempty"`
+	ToDay     int             `json:"toDay,omitempty"`
+	Cursor    *string         `json:"cursor,omitempty"`
+	PageSize  int             `json:"pageSize,omitempty"`
+	Sort      []DashboardSort `json:"sort,omitempty"`
+	AdminDashboardFilter
+}
+
+type GetAdminDashboardCountRequest struct {
+	Dashboard string `json:"dashboard,omitempty"`
+	FromYear  int    `json:"fromYear,omitempty"`
+	FromMonth int    `json:"fromMonth,omitempty"`
+	FromDay   int    `json:"fromDay,omitempty"`
+	ToYear    int    `json:"toYear,omitempty"`
+	ToMonth   int    `json:"toMonth,omitempty"`
+	ToDay     int    `json:"toDay,omitempty"`
+	AdminDashboardFilter
+}
+
+// AdminDashboardFilter holds the optional filters shared by the admin dashboards and search,
+// a nil or empty field is not filtered on
+type AdminDashboardFilter struct {
+	ProjectCode          *string        `json:"projectCode,omitempty"`
+	ProjectName          *string        `json:"projectName,omitempty"`
+	ProjectStatus        *string        `json:"projectStatus,omitempty"`
+	FundingRoundId       *int           `json:"fundingRoundId,omitempty"`
+	ProvinceIds          []int          `json:"provinceIds,omitempty"`
+	DistrictIds          []int          `json:"districtIds,omitempty"`
+	CatRoadRace          *bool          `json:"catRoadRace,omitempty"`
+	CatTrailRunning      *bool          `json:"catTrailRunning,omitempty"`
+	EventFromDate        *DashboardDate `json:"eventFromDate,omitempty"`
+	EventToDate          *DashboardDate `json:"eventToDate,omitempty"`
+	ExpectedParticipants []string       `json:"expectedParticipants,omitempty"`
+	RequestedAmountMin   *int64         `json:"requestedAmountMin,omitempty"`
+	RequestedAmountMax   *int64         `json:"requestedAmountMax,omitempty"`
+	ApprovedAmountMin    *int64         `json:"approvedAmountMin,omitempty"`
+	ApprovedAmountMax    *int64         `json:"approvedAmountMax,omitempty"`
+	Collaborated         *bool          `json:"collaborated,omitempty"`
+	AvgScoreMin          *float64       `json:"avgScoreMin,omitempty"`
+	AvgScoreMax          *float64       `json:"avgScoreMax,omitempty"`
+	Tags                 []string       `json:"tags,omitempty"`
+	ReviseOverdue        *bool          `json:"reviseOverdue,omitempty"`
+}
+
+// DashboardSort orders by a named key, the store maps keys to SQL
+type DashboardSort struct {
+	Key       string `json:"key,omitempty"`
+	Direction string `json:"direction,omitempty"`
+}
+
+type DashboardDate struct {
+	Year  int `json:"year,omitempty"`
+	Month int `json:"month,omitempty"`
+	Day   int `json:"day,omitempty"`
+}
+
+type SearchProjectsRequest struct {
+	Query     string `json:"query,omitempty"`
+	Dashboard string `json:"dashboard,omitempty"`
+	FromYear  int    `json:"fromYear,omitempty"`
+	FromMonth int    `json:"fromMonth,omitempty"`
+	FromDay   int    `json:"fromDay,omitempty"`
+	ToYear    int    `json:"toYear,omitempty"`
+	ToMonth   int    `json:"toMonth,omitempty"`
+	ToDay     int    `json:"toDay,omitempty"`
+	PageNo    int    `json:"pageNo,omitempty"`
+	PageSize  int    `json:"pageSize,omitempty"`
+	AdminDashboardFilter
 }
 
 type GetAdminSummaryRequest struct {
-	FromYear  int `json:"fromYear,omitempty"`
-	FromMonth int `json:"fromMonth,omitempty"`
-	FromDay   int `json:"fromDay,omitempty"`
-	ToYear    int `json:"toYear,omitempty"`
-	ToMonth   int `json:"toMonth,omitempty"`
-	ToDay     int `json:"toDay,omitempty"`
+	FromYear       int  `json:"fromYear,omitempty"`
+	FromMonth      int  `json:"fromMonth,omitempty"`
+	FromDay        int  `json:"fromDay,omitempty"`
+	ToYear         int  `json:"toYear,omitempty"`
+	ToMonth        int  `json:"toMonth,omitempty"`
+	ToDay          int  `json:"toDay,omitempty"`
+	FundingRoundId *int `json:"fundingRoundId,omitempty"`
 }
 
 type GenerateAdminReportRequest struct {
-	FromYear  int `json:"fromYear,omitempty"`
-	FromMonth int `json:"fromMonth,omitempty"`
-	FromDay   int `json:"fromDay,omitempty"`
-	ToYear    int `json:"toYear,omitempty"`
-	ToMonth   int `json:"toMonth,omitempty"`
-	ToDay     int `json:"toDay,omitempty"`
+	FromYear       int  `json:"fromYear,omitempty"`
+	FromMonth      int  `json:"fromMonth,omitempty"`
+	FromDay        int  `json:"fromDay,omitempty"`
+	ToYear         int  `json:"toYear,omitempty"`
+	ToMonth        int  `json:"toMonth,omitempty"`
+	ToDay          int  `json:"toDay,omitempty"`
+	FundingRoundId *int `json:"fundingRoundId,omitempty"`
 }
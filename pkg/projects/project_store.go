@@ -1,6 +1,7 @@
 package projects
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/jordan-wright/email"
 	"github.com/patrickmn/go-cache"
 	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
 	"github.com/poomipat-k/running-fund/pkg/utils"
@@ -17,17 +19,25 @@ const applicantCriteriaCachePrefix = "applicant_criteria"
 const applicantCriteriaPdfCachePrefix = "applicant_criteria_pdf"
 const reviewerCriteriaCachePrefix = "reviewer_criteria"
 
+type EmailService interface {
+	SendEmail(email email.Email) error
+	BuildProjectMessageEmail(to []string, projectCode, projectName, messageLink string) email.Email
+	BuildReviseReminderEmail(to, projectCode, projectName, dueDate, projectLink string) email.Email
+}
+
 type store struct {
 	db           *sql.DB
 	c            *cache.Cache
 	awsS3Service s3Service.S3Service
+	emailService EmailService
 }
 
-func NewStore(db *sql.DB, c *cache.Cache, s3service s3Service.S3Service) *store {
+func NewStore(db *sql.DB, c *cache.Cache, s3service s3Service.S3Service, es EmailService) *store {
 	return &store{
 		db:           db,
 		c:            c,
 		awsS3Service: s3service,
+		emailService: es,
 	}
 }
 
@@ -65,9 +75,9 @@ func (s *store) HasPermissionToAddAdditionalFiles(userId int, projectCode string
 	}
 }
 
-// Get project [fromDate, toDate)
-func (s *store) GetReviewerDashboard(reviewerId int, fromDate, toDate time.Time) ([]ReviewDashboardRow, error) {
-	rows, err := s.db.Query(getReviewerDashboardSQL, reviewerId, fromDate, toDate)
+// GetReviewerDashboard lists the projects of the rounds whose review window contains now
+func (s *store) GetReviewerDashboard(reviewerId int, now time.Time, fundingRoundId *int) ([]ReviewDashboardRow, error) {
+	rows, err := s.db.Query(getReviewerDashboardSQL, reviewerId, now, fundingRoundId)
 	if err != nil {
 		return nil, err
 	}
@@ -509,29 +519,25 @@ func (s *store) GetAllProjectDashboardByApplicantId(applicantId int) ([]Applican
 	return data, nil
 }
 
-func (s *store) generateProjectCode() (string, error) {
-	rawYear, rawMonth, day := getLocalYearMonthDay()
-	year2digitsBud := (rawYear + 543) % 100
-	month := monthMap[rawMonth.String()]
-	cnt, found := s.c.Get(fmt.Sprintf("projectCode_%d_%s_%d", year2digitsBud, month, day))
-	newCount := 0
-	if found {
-		newCount = cnt.(int) + 1
-		s.c.Set(fmt.Sprintf("projectCode_%d_%s_%d", year2digitsBud, month, day), newCount, time.Duration(24*time.Hour))
-	} else {
-		// Query count from database
-		row := s.db.QueryRow(countProjectCreatedToday)
-		var count int
-		err := row.Scan(&count)
-		if err != nil {
-			return "", err
-		}
-		newCount = count + 1
-		s.c.Set(fmt.Sprintf("projectCode_%d_%s_%d", year2digitsBud, month, day), newCount, cache.NoExpiration)
-
+// NextProjectCode allocates the next MMMYY_DDNN project code for the local day of now.
+// Concurrent callers queue on the day counter until the holding transaction ends,
+// so a code is never handed out twice, even across API replicas, and a rolled back
+// submission gives its code back.
+func NextProjectCode(ctx context.Context, tx *sql.Tx, now time.Time) (string, error) {
+	loc, err := time.LoadLocation(TIMEZONE)
+	if err != nil {
+		return "", err
+	}
+	localNow := now.In(loc)
+	dayStart := time.Date(localNow.Year(), localNow.Month(), localNow.Day(), 0, 0, 0, 0, loc)
+	var sequence int
+	err = tx.QueryRowContext(ctx, nextProjectCodeSequenceSQL, localNow.Format(time.DateOnly), dayStart, dayStart.AddDate(0, 0, 1)).Scan(&sequence)
+	if err != nil {
+		return "", err
 	}
-	projectCode := fmt.Sprintf("%s%d_%02d%02d", month, year2digitsBud, day, newCount)
-	return projectCode, nil
+	year2digitsBud := (localNow.Year() + 543) % 100
+	month := monthMap[localNow.Month().String()]
+	return fmt.Sprintf("%s%d_%02d%02d", month, year2digitsBud, localNow.Day(), sequence), nil
 }
 
 func getLocalYearMonthDay() (int, time.Month, int) {
@@ -24,17 +24,14 @@ const (
 func (s *store) AddProject(
 	payload AddProjectRequest,
 	userId int,
+	fundingRoundId int,
 	criteria []ApplicantSelfScoreCriteria,
 	attachments []Attachments,
 ) (int, error) {
-	projectCode, err := s.generateProjectCode()
-	if err != nil {
-		return 0, err
-	}
-	// start transaction
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// start transaction
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return failAdd("tx", err)
@@ -42,7 +39,12 @@ func (s *store) AddProject(
 
 	defer tx.Rollback()
 
+	// the code is allocated inside the transaction so a failed submission gives it back
 	now := time.Now()
+	projectCode, err := NextProjectCode(ctx, tx, now)
+	if err != nil {
+		return failAdd("projectCode", err)
+	}
 	// Add address rows
 	addressId, err := addGeneralAddress(ctx, tx, payload)
 	if err != nil {
@@ -120,16 +122,41 @@ func (s *store) AddProject(
 	}
 
 	// Add project
-	projectId, err := addProjectRow(ctx, tx, projectCode, now, projectHistoryId, userId)
+	projectId, err := addProjectRow(ctx, tx, projectCode, now, projectHistoryId, userId, fundingRoundId)
 	if err != nil {
 		return failAdd("projectId", err)
 	}
 
+	// Index the proposal for admin search
+	_, err = tx.ExecContext(ctx, refreshProjectSearchSQL, projectCode)
+	if err != nil {
+		return failAdd("projectSearch", err)
+	}
+
 	// Add distance
 	_, err = addDistances(ctx, tx, payload, projectHistoryId)
 	if err != nil {
 		return failAdd("distanceRowsAffected", err)
 	}
+	// Add budget items
+	_, err = addBudgetItems(ctx, tx, payload, projectHistoryId)
+	if err != nil {
+		return failAdd("budgetItemRowsAffected", err)
+	}
+	// Add route tracks measured from GPX/KML route files
+	err = addRouteTracks(ctx, tx, payload.RouteTracks, projectHistoryId)
+	if err != nil {
+		return failAdd("routeTracks", err)
+	}
+	// Flag suspected duplicates of proposals already submitted
+	fromDate, _, _, err := buildTimeFromPayload(payload)
+	if err != nil {
+		return failAdd("fromDate", err)
+	}
+	_, err = flagDuplicates(ctx, tx, payload, projectCode, fromDate, now)
+	if err != nil {
+		return failAdd("duplicateRowsAffected", err)
+	}
 	// Add applicant scores
 	_, err = addApplicantScores(ctx, tx, payload, projectHistoryId, criteria)
 	if err != nil {
@@ -137,7 +164,7 @@ func (s *store) AddProject(
 	}
 
 	// Write zip, upload files and zips
-	err = s.handleCreateProjectFiles(baseFilePrefix, userId, projectCode, payload, attachments)
+	err = s.handleCreateProjectFiles(baseFilePrefix, userId, projectCode, payload, criteria[0].CriteriaVersion, attachments)
 	if err != nil {
 		return 0, err
 	}
@@ -295,6 +322,7 @@ func addProjectHistory(
 		payload.Fund.Request.Type.Other,
 		payload.Fund.Request.Details.Other,
 		payload.Fund.Budget.NoAlcoholSponsor,
+		payload.General.HasOrganizer != nil && *payload.General.HasOrganizer && payload.General.OrganizerPublicConsent,
 		baseFilePrefix,
 	).Scan(&id)
 	if err != nil {
@@ -324,8 +352,9 @@ func addDistances(ctx context.Context, tx *sql.Tx, payload AddProjectRequest, pr
 	values := []any{}
 
 	for i := 0; i < len(checkedDistances); i++ {
-		valuesStrPlaceholder = append(valuesStrPlaceholder, fmt.Sprintf("($%d, $%d, $%d, $%d)", 4*i+1, 4*i+2, 4*i+3, 4*i+4))
-		values = append(values, checkedDistances[i].Type, checkedDistances[i].Fee, checkedDistances[i].Dynamic, projectHistoryId)
+		code, km := resolveDistance(checkedDistances[i])
+		valuesStrPlaceholder = append(valuesStrPlaceholder, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)", 6*i+1, 6*i+2, 6*i+3, 6*i+4, 6*i+5, 6*i+6))
+		values = append(values, checkedDistances[i].Type, checkedDistances[i].Fee, checkedDistances[i].Dynamic, projectHistoryId, code, km)
 	}
 	customSQL := addManyDistanceSQL + strings.Join(valuesStrPlaceholder, ",") + ";"
 	stmt, err := tx.Prepare(customSQL)
@@ -340,6 +369,53 @@ func addDistances(ctx context.Context, tx *sql.Tx, payload AddProjectRequest, pr
 	return result.RowsAffected()
 }
 
+func addRouteTracks(ctx context.Context, tx *sql.Tx, tracks []RouteTrack, projectHistoryId int) error {
+	for _, track := range tracks {
+		_, err := tx.ExecContext(
+			ctx,
+			addRouteTrackSQL,
+			projectHistoryId,
+			track.FileName,
+			track.Name,
+			track.LengthKm,
+			track.ElevationGainM,
+			track.BoundingBox.MinLat,
+			track.BoundingBox.MinLon,
+			track.BoundingBox.MaxLat,
+			track.BoundingBox.MaxLon,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addBudgetItems(ctx context.Context, tx *sql.Tx, payload AddProjectRequest, projectHistoryId int) (int64, error) {
+	items := payload.Fund.Budget.Items
+	if len(items) == 0 {
+		return 0, nil
+	}
+	valuesStrPlaceholder := []string{}
+	values := []any{}
+
+	for i := 0; i < len(items); i++ {
+		valuesStrPlaceholder = append(valuesStrPlaceholder, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)", 7*i+1, 7*i+2, 7*i+3, 7*i+4, 7*i+5, 7*i+6, 7*i+7))
+		values = append(values, projectHistoryId, i+1, items[i].Category, items[i].Description, items[i].Quantity, items[i].UnitCost, items[i].FundingSource)
+	}
+	customSQL := addManyBudgetItemSQL + strings.Join(valuesStrPlaceholder, ",") + ";"
+	stmt, err := tx.Prepare(customSQL)
+	if err != nil {
+		return 0, err
+	}
+	result, err := stmt.ExecContext(ctx, values...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
 func addApplicantScores(
 	ctx context.Context,
 	tx *sql.Tx,
@@ -489,7 +565,7 @@ func addProjectRaceDirectorContactId(ctx context.Context, tx *sql.Tx, payload Ad
 	return id, nil
 }
 
-func addProjectRow(ctx context.Context, tx *sql.Tx, projectCode string, now time.Time, projectHistoryId int, userId int) (int, error) {
+func addProjectRow(ctx context.Context, tx *sql.Tx, projectCode string, now time.Time, projectHistoryId int, userId int, fundingRoundId int) (int, error) {
 	var id int
 	err := tx.QueryRowContext(
 		ctx,
@@ -498,6 +574,7 @@ func addProjectRow(ctx context.Context, tx *sql.Tx, projectCode string, now time
 		now,
 		projectHistoryId,
 		userId,
+		fundingRoundId,
 	).Scan(&id)
 	if err != nil {
 		return 0, err
@@ -552,7 +629,7 @@ func buildTimeFromPayload(payload AddProjectRequest) (time.Time, time.Time, time
 	return fromDate, toDate, thisSeriesLatestDate, nil
 }
 
-func (s *store) handleCreateProjectFiles(baseFilePrefix string, userId int, projectCode string, payload AddProjectRequest, attachments []Attachments) error {
+func (s *store) handleCreateProjectFiles(baseFilePrefix string, userId int, projectCode string, payload AddProjectRequest, criteriaVersion int, attachments []Attachments) error {
 	// Write users uploaded file to zip files
 	zipTmpPath := filepath.Join("../home", fmt.Sprintf("tmp/%s", baseFilePrefix))
 	err := os.MkdirAll(zipTmpPath, os.ModePerm)
@@ -595,6 +672,7 @@ func (s *store) handleCreateProjectFiles(baseFilePrefix string, userId int, proj
 		userId,
 		projectCode,
 		payload,
+		criteriaVersion,
 	)
 	if err != nil {
 		slog.Error("error generating a pdf for", "projectCode", projectCode)
@@ -624,7 +702,7 @@ func (s *store) handleCreateProjectFiles(baseFilePrefix string, userId int, proj
 	for _, attachment := range attachments {
 		zipWriters := zipWriterMap[attachment.ZipName]
 		s3FilePrefix := fmt.Sprintf("%s/%s", baseFilePrefix, attachment.DirName)
-		err = s.awsS3Service.ZipAndUploadFileToS3(attachment.Files, zipWriters, fmt.Sprintf("%s_%s", projectCode, attachment.InZipFilePrefix), s3FilePrefix)
+		err = s.awsS3Service.ZipAndUploadFileToS3(attachment.Files, zipWriters, fmt.Sprintf("%s_%s", projectCode, attachment.InZipFilePrefix), s3FilePrefix, attachment.AllowRouteTracks)
 		if err != nil {
 			return err
 		}
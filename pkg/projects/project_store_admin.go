@@ -9,8 +9,6 @@ import (
 	"log/slog"
 	"mime/multipart"
 	"os"
-	"strconv"
-	"strings"
 	"time"
 
 	myCsv "github.com/poomipat-k/running-fund/pkg/csv-app"
@@ -28,6 +26,7 @@ func (s *store) GetProjectStatusByProjectCode(projectCode string) (AdminUpdatePa
 		&payload.AdminComment,
 		&payload.AdminApprovedAt,
 		&payload.UpdatedAt,
+		&payload.ReviseDueAt,
 	)
 	switch err {
 	case sql.ErrNoRows:
@@ -71,6 +70,7 @@ func (s *store) UpdateProjectByAdmin(payload AdminUpdateParam, userId int, proje
 		payload.AdminComment,
 		payload.AdminApprovedAt,
 		payload.UpdatedAt,
+		payload.ReviseDueAt,
 	).Scan(&id)
 
 	if err != nil {
@@ -102,71 +102,31 @@ func (s *store) UpdateProjectByAdmin(payload AdminUpdateParam, userId int, proje
 	return nil
 }
 
-func (s *store) GetAdminRequestDashboard(
-	fromDate, toDate time.Time,
-	orderBy string,
-	limit, offset int,
-	projectCode, projectName, projectStatus *string,
-) ([]AdminRequestDashboardRow, error) {
-	queryStmt, values := prepareAdminDashboardQuery("request", fromDate, toDate, orderBy, limit, offset, projectCode, projectName, projectStatus)
-	rows, err := s.db.Query(queryStmt, values...)
+func (s *store) GetAdminSummary(fromDate, toDate time.Time, fundingRoundId *int) ([]AdminSummaryData, error) {
+	rows, err := s.db.Query(getAdminSummarySQL, fromDate, toDate, fundingRoundId)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var data []AdminRequestDashboardRow
+
+	var data []AdminSummaryData
 	for rows.Next() {
-		var row AdminRequestDashboardRow
+		var row AdminSummaryData
 		err := rows.Scan(
-			&row.ProjectCode,
-			&row.ProjectCreatedAt,
-			&row.ProjectName,
-			&row.ProjectStatus,
-			&row.ProjectUpdatedAt,
-			&row.AdminComment,
-			&row.AvgScore,
+			&row.Status,
 			&row.Count,
+			&row.FundSum,
+			&row.PaidSum,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		data = append(data, row)
-	}
-	err = rows.Err()
-	if err != nil {
-		return nil, err
-	}
-	return data, nil
-}
-
-func (s *store) GetAdminStartedDashboard(
-	fromDate, toDate time.Time,
-	orderBy string,
-	limit, offset int,
-	projectCode, projectName, projectStatus *string,
-) ([]AdminRequestDashboardRow, error) {
-	queryStmt, values := prepareAdminDashboardQuery("started", fromDate, toDate, orderBy, limit, offset, projectCode, projectName, projectStatus)
-	rows, err := s.db.Query(queryStmt, values...)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var data []AdminRequestDashboardRow
-	for rows.Next() {
-		var row AdminRequestDashboardRow
-		err := rows.Scan(
-			&row.ProjectCode,
-			&row.ProjectCreatedAt,
-			&row.ProjectName,
-			&row.ProjectStatus,
-			&row.ProjectUpdatedAt,
-			&row.AdminComment,
-			&row.AvgScore,
-			&row.Count,
-		)
-		if err != nil {
-			return nil, err
+		if row.FundSum == nil {
+			row.FundSum = newInt64(0)
+		}
+		if row.PaidSum == nil {
+			row.PaidSum = newInt64(0)
 		}
 
 		data = append(data, row)
@@ -175,49 +135,56 @@ func (s *store) GetAdminStartedDashboard(
 	if err != nil {
 		return nil, err
 	}
+	// always report closed projects separately, even when there are none
+	for _, status := range []string{"Withdrawn", "Cancelled"} {
+		found := false
+		for _, row := range data {
+			if row.Status == status {
+				found = true
+				break
+			}
+		}
+		if !found {
+			data = append(data, AdminSummaryData{Status: status, Count: 0, FundSum: newInt64(0), PaidSum: newInt64(0)})
+		}
+	}
 	return data, nil
 }
 
-func (s *store) GetAdminSummary(fromDate, toDate time.Time) ([]AdminSummaryData, error) {
-	rows, err := s.db.Query(getAdminSummarySQL, fromDate, toDate)
+func (s *store) WithdrawProject(projectHistoryId int, reason string, withdrawnAt time.Time) error {
+	var id int
+	err := s.db.QueryRow(withdrawProjectSQL, projectHistoryId, reason, withdrawnAt).Scan(&id)
+	if err == sql.ErrNoRows {
+		return &ProjectNotWithdrawableError{}
+	}
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer rows.Close()
-
-	var data []AdminSummaryData
-	for rows.Next() {
-		var row AdminSummaryData
-		err := rows.Scan(
-			&row.Status,
-			&row.Count,
-			&row.FundSum,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		if row.FundSum == nil {
-			row.FundSum = newInt64(0)
-		}
+	slog.Info("project withdrawn", "projectHistoryId", id)
+	return nil
+}
 
-		data = append(data, row)
+func (s *store) CancelProject(projectHistoryId int, reason string, cancelledAt time.Time) error {
+	var id int
+	err := s.db.QueryRow(cancelProjectSQL, projectHistoryId, reason, cancelledAt).Scan(&id)
+	if err == sql.ErrNoRows {
+		return &ProjectNotCancellableError{}
 	}
-	err = rows.Err()
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return data, nil
+	slog.Info("project cancelled", "projectHistoryId", id)
+	return nil
 }
 
-func (s *store) GenerateAdminReport(fromDate, toDate time.Time) (*bytes.Buffer, error) {
-	rows, err := s.db.Query(getAdminReportSQL, fromDate, toDate)
+func (s *store) GenerateAdminReport(fromDate, toDate time.Time, fundingRoundId *int) (*bytes.Buffer, error) {
+	rows, err := s.db.Query(getAdminReportSQL, fromDate, toDate, fundingRoundId)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 	var items [][]string
-	headers := []string{"ลำดับ", "รหัสโครงการ", "ชื่อโครงการ", "วันที่ขอทุน", "วันที่ดำเนินโครงการ", "จำนวนเงินที่ได้รับ"}
+	headers := []string{"ลำดับ", "รหัสโครงการ", "ชื่อโครงการ", "วันที่ขอทุน", "วันที่ดำเนินโครงการ", "จำนวนเงินที่ได้รับ", "แท็ก"}
 	items = append(items, headers)
 	count := 1
 	loc, err := getTimeLocation()
@@ -232,6 +199,7 @@ func (s *store) GenerateAdminReport(fromDate, toDate time.Time) (*bytes.Buffer,
 			&row.CreatedAt,
 			&row.FromDate,
 			&row.FundApprovedAmount,
+			&row.Tags,
 		)
 		if err != nil {
 			return nil, err
@@ -244,7 +212,7 @@ func (s *store) GenerateAdminReport(fromDate, toDate time.Time) (*bytes.Buffer,
 		createdAt := getDateString(createdAtLocal.Year(), int(createdAtLocal.Month()), createdAtLocal.Day())
 		fromDateLocal := row.FromDate.In(loc)
 		fromDate := getDateTimeString(fromDateLocal.Year(), int(fromDateLocal.Month()), fromDateLocal.Day(), fromDateLocal.Hour(), fromDateLocal.Minute())
-		csvRow := []string{fmt.Sprint(count), row.ProjectCode, row.ProjectName, createdAt, fromDate, fmt.Sprint(supportAmount)}
+		csvRow := []string{fmt.Sprint(count), row.ProjectCode, row.ProjectName, createdAt, fromDate, fmt.Sprint(supportAmount), row.Tags}
 		items = append(items, csvRow)
 		count++
 	}
@@ -267,75 +235,3 @@ func newInt64(val int64) *int64 {
 	v := val
 	return &v
 }
-
-func prepareAdminDashboardQuery(
-	dashboardType string,
-	fromDate, toDate time.Time,
-	orderBy string,
-	limit, offset int,
-	projectCode, projectName, projectStatus *string,
-) (string, []any) {
-	curPlaceholder := 3
-	var where []string
-	if dashboardType == "request" {
-		where = []string{"project.created_at >= $1 AND project.created_at < $2 AND (project_history.status != 'Start' AND project_history.status != 'Completed' AND project_history.status != 'NotApproved')"}
-	} else {
-		where = []string{"project.created_at >= $1 AND project.created_at < $2 AND (project_history.status = 'Start' OR project_history.status = 'Completed' OR project_history.status = 'NotApproved')"}
-	}
-	values := []any{fromDate, toDate}
-	if projectCode != nil {
-		where = append(where, fmt.Sprintf("AND project_history.project_code = $%d", curPlaceholder))
-		values = append(values, *projectCode)
-		curPlaceholder++
-	}
-	if projectName != nil {
-		strContainStmt := "AND project_history.project_name LIKE '%' || $" + strconv.Itoa(curPlaceholder) + " || '%'"
-		where = append(where, strContainStmt)
-		values = append(values, *projectName)
-		curPlaceholder++
-	}
-	if projectStatus != nil {
-		where = append(where, fmt.Sprintf("AND project_history.status = $%d", curPlaceholder))
-		values = append(values, *projectStatus)
-		curPlaceholder++
-	}
-	whereStmt := strings.Join(where, " ")
-	// orderBy must be safe string
-	orderLimitOffsetStmt := fmt.Sprintf("ORDER BY %s LIMIT $%d OFFSET $%d", orderBy, curPlaceholder, curPlaceholder+1)
-	values = append(values, limit, offset)
-	countStmt := fmt.Sprintf(`
-	(
-		SELECT COUNT(*) FROM project 
-		INNER JOIN project_history ON project.project_history_id = project_history.id
-		WHERE %s
-	) as count`, whereStmt)
-
-	getAdminRequestDashboardSQL := fmt.Sprintf(`
-	SELECT
-project.project_code as project_code,
-project.created_at as created_at,
-project_history.project_name as project_name,
-project_history.status as project_status,
-project_history.updated_at as updated_at,
-project_history.admin_comment,
-(
-SELECT ROUND(AVG(sum_score), 2)
-	FROM (
-		SELECT
-		review.project_history_id as project_history_id,
-		review.id as review_id,
-		SUM(review_details.score) as sum_score
-		FROM review
-		INNER JOIN review_details ON review.id = review_details.review_id
-		WHERE project_history_id = project.project_history_id
-		GROUP BY  review.project_history_id, review.id
-		)
-) as avg_score,
-%s
-FROM project 
-INNER JOIN project_history ON project.project_history_id = project_history.id
-WHERE `, countStmt)
-
-	queryStmt := strings.Join([]string{getAdminRequestDashboardSQL, whereStmt, orderLimitOffsetStmt}, " ") + ";"
-	return queryStmt, values
-}
@@ -0,0 +1,231 @@
+package projects
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+func (s *store) GetApplicantCriteriaVersions() ([]ApplicantCriteriaVersion, error) {
+	rows, err := s.db.Query(getApplicantCriteriaVersionsSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []ApplicantCriteriaVersion{}
+	for rows.Next() {
+		var row ApplicantCriteriaVersion
+		err := rows.Scan(
+			&row.CriteriaVersion,
+			&row.Status,
+			&row.ItemCount,
+			&row.FundingRoundCount,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+			&row.PublishedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetApplicantCriteriaPreview reads a version straight from the db, drafts are never cached
+func (s *store) GetApplicantCriteriaPreview(criteriaVersion int) (ApplicantCriteriaPreview, error) {
+	var preview ApplicantCriteriaPreview
+	err := s.db.QueryRow(getApplicantCriteriaVersionSQL, criteriaVersion).Scan(
+		&preview.CriteriaVersion,
+		&preview.Status,
+		&preview.CreatedAt,
+		&preview.UpdatedAt,
+		&preview.PublishedAt,
+	)
+	if err == sql.ErrNoRows {
+		return ApplicantCriteriaPreview{}, &ApplicantCriteriaVersionNotFoundError{}
+	}
+	if err != nil {
+		return ApplicantCriteriaPreview{}, err
+	}
+
+	rows, err := s.db.Query(getApplicantCriteriaPreviewSQL, criteriaVersion)
+	if err != nil {
+		return ApplicantCriteriaPreview{}, err
+	}
+	defer rows.Close()
+
+	preview.Items = []ApplicantSelfScoreCriteria{}
+	for rows.Next() {
+		var row ApplicantSelfScoreCriteria
+		err := rows.Scan(&row.Id, &row.CriteriaVersion, &row.OrderNumber, &row.Display, &row.PdfDisplay)
+		if err != nil {
+			return ApplicantCriteriaPreview{}, err
+		}
+		preview.Items = append(preview.Items, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return ApplicantCriteriaPreview{}, err
+	}
+	return preview, nil
+}
+
+func (s *store) AddApplicantCriteriaDraft(items []ApplicantCriteriaItem) (int, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var criteriaVersion int
+	err = tx.QueryRowContext(ctx, addApplicantCriteriaVersionSQL, time.Now()).Scan(&criteriaVersion)
+	if err != nil {
+		return 0, err
+	}
+	err = addApplicantCriteriaItems(ctx, tx, criteriaVersion, items)
+	if err != nil {
+		return 0, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+	slog.Info("applicant criteria draft added", "criteriaVersion", criteriaVersion)
+	return criteriaVersion, nil
+}
+
+// UpdateApplicantCriteriaDraft replaces every item of a draft, nothing refers to draft items yet
+func (s *store) UpdateApplicantCriteriaDraft(criteriaVersion int, items []ApplicantCriteriaItem) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = lockApplicantCriteriaDraft(ctx, tx, criteriaVersion)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, deleteApplicantCriteriaItemsSQL, criteriaVersion)
+	if err != nil {
+		return err
+	}
+	err = addApplicantCriteriaItems(ctx, tx, criteriaVersion, items)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, touchApplicantCriteriaVersionSQL, criteriaVersion, time.Now())
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	slog.Info("applicant criteria draft updated", "criteriaVersion", criteriaVersion)
+	return nil
+}
+
+func (s *store) DeleteApplicantCriteriaDraft(criteriaVersion int) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = lockApplicantCriteriaDraft(ctx, tx, criteriaVersion)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, deleteApplicantCriteriaItemsSQL, criteriaVersion)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, deleteApplicantCriteriaVersionSQL, criteriaVersion)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	slog.Info("applicant criteria draft deleted", "criteriaVersion", criteriaVersion)
+	return nil
+}
+
+// PublishApplicantCriteria makes a draft usable by funding rounds, published versions can not be edited anymore
+func (s *store) PublishApplicantCriteria(criteriaVersion int) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = lockApplicantCriteriaDraft(ctx, tx, criteriaVersion)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, publishApplicantCriteriaVersionSQL, criteriaVersion, time.Now())
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	// drop whatever was cached under this version number so the form and the PDF read the published text
+	s.c.Delete(fmt.Sprintf("%s_%d", applicantCriteriaCachePrefix, criteriaVersion))
+	s.c.Delete(fmt.Sprintf("%s_%d", applicantCriteriaPdfCachePrefix, criteriaVersion))
+	slog.Info("applicant criteria published", "criteriaVersion", criteriaVersion)
+	return nil
+}
+
+// lockApplicantCriteriaDraft holds the version row until the transaction ends so a publish can not race an edit
+func lockApplicantCriteriaDraft(ctx context.Context, tx *sql.Tx, criteriaVersion int) error {
+	var status string
+	err := tx.QueryRowContext(ctx, getApplicantCriteriaVersionForUpdateSQL, criteriaVersion).Scan(&status)
+	if err == sql.ErrNoRows {
+		return &ApplicantCriteriaVersionNotFoundError{}
+	}
+	if err != nil {
+		return err
+	}
+	if status != APPLICANT_CRITERIA_DRAFT {
+		return &ApplicantCriteriaVersionPublishedError{Version: criteriaVersion}
+	}
+	return nil
+}
+
+func addApplicantCriteriaItems(ctx context.Context, tx *sql.Tx, criteriaVersion int, items []ApplicantCriteriaItem) error {
+	valuesStrPlaceholder := []string{}
+	values := []any{}
+	for i, item := range items {
+		valuesStrPlaceholder = append(valuesStrPlaceholder, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)", 5*i+1, 5*i+2, 5*i+3, 5*i+4, 5*i+5))
+		values = append(values, "project_self_score", criteriaVersion, item.OrderNumber, item.Display, item.PdfDisplay)
+	}
+	customSQL := addManyApplicantCriteriaSQL + strings.Join(valuesStrPlaceholder, ",") + ";"
+	_, err := tx.ExecContext(ctx, customSQL, values...)
+	return err
+}
@@ -0,0 +1,80 @@
+package projects
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/lib/pq"
+)
+
+func (s *store) GetProjectsForAdminUpdate(projectCodes []string) ([]AdminBulkUpdateParam, error) {
+	rows, err := s.db.Query(getProjectsForAdminUpdateByProjectCodesSQL, pq.Array(projectCodes))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var data []AdminBulkUpdateParam
+	for rows.Next() {
+		var row AdminBulkUpdateParam
+		err := rows.Scan(
+			&row.ProjectCode,
+			&row.ProjectHistoryId,
+			&row.ProjectStatus,
+			&row.FundApprovedAmount,
+			&row.AdminComment,
+			&row.AdminApprovedAt,
+			&row.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// BulkUpdateProjectsByAdmin applies every change or none, a project updated since it was read aborts the whole batch
+func (s *store) BulkUpdateProjectsByAdmin(params []AdminBulkUpdateParam) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, p := range params {
+		var id int
+		err := tx.QueryRowContext(
+			ctx,
+			bulkUpdateProjectByAdminSQL,
+			p.ProjectHistoryId,
+			p.ExpectedUpdatedAt,
+			p.ProjectStatus,
+			p.FundApprovedAmount,
+			p.AdminComment,
+			p.AdminApprovedAt,
+			p.UpdatedAt,
+		).Scan(&id)
+		if err == sql.ErrNoRows {
+			return &BulkUpdateConflictError{ProjectCode: p.ProjectCode}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	slog.Info("bulk update projects by admin", "count", len(params))
+	return nil
+}
@@ -0,0 +1,433 @@
+package projects
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+func (s *store) GetAdminRequestDashboard(
+	fromDate, toDate time.Time,
+	sort []DashboardSort,
+	limit int,
+	cursor *string,
+	filter AdminDashboardFilter,
+) (AdminDashboardPage, error) {
+	return s.getAdminDashboard("request", fromDate, toDate, sort, limit, cursor, filter)
+}
+
+func (s *store) GetAdminStartedDashboard(
+	fromDate, toDate time.Time,
+	sort []DashboardSort,
+	limit int,
+	cursor *string,
+	filter AdminDashboardFilter,
+) (AdminDashboardPage, error) {
+	return s.getAdminDashboard("started", fromDate, toDate, sort, limit, cursor, filter)
+}
+
+func (s *store) getAdminDashboard(
+	dashboardType string,
+	fromDate, toDate time.Time,
+	sort []DashboardSort,
+	limit int,
+	cursor *string,
+	filter AdminDashboardFilter,
+) (AdminDashboardPage, error) {
+	var after []any
+	if cursor != nil {
+		values, err := decodeDashboardCursor(*cursor, dashboardType, sort)
+		if err != nil {
+			return AdminDashboardPage{}, err
+		}
+		after = values
+	}
+	queryStmt, values, err := prepareAdminDashboardQuery(dashboardType, fromDate, toDate, sort, limit, after, filter)
+	if err != nil {
+		return AdminDashboardPage{}, err
+	}
+	rows, err := s.db.Query(queryStmt, values...)
+	if err != nil {
+		return AdminDashboardPage{}, err
+	}
+	defer rows.Close()
+
+	page := AdminDashboardPage{Items: []AdminRequestDashboardRow{}}
+	var lastCursorValues string
+	for rows.Next() {
+		var row AdminRequestDashboardRow
+		var cursorValues string
+		err := rows.Scan(
+			&row.ProjectCode,
+			&row.ProjectCreatedAt,
+			&row.ProjectName,
+			&row.ProjectStatus,
+			&row.ProjectUpdatedAt,
+			&row.AdminComment,
+			&row.AvgScore,
+			&row.SuspectedDuplicate,
+			&row.ReviseDueAt,
+			&row.ReviseOverdue,
+			pq.Array(&row.Tags),
+			&cursorValues,
+		)
+		if err != nil {
+			return AdminDashboardPage{}, err
+		}
+		// one extra row is fetched only to know whether there is a next page
+		if len(page.Items) == limit {
+			next := encodeDashboardCursor(dashboardType, sort, lastCursorValues)
+			page.NextCursor = &next
+			break
+		}
+		page.Items = append(page.Items, row)
+		lastCursorValues = cursorValues
+	}
+	err = rows.Err()
+	if err != nil {
+		return AdminDashboardPage{}, err
+	}
+	return page, nil
+}
+
+func (s *store) GetAdminDashboardCount(dashboardType string, fromDate, toDate time.Time, filter AdminDashboardFilter) (AdminDashboardCount, error) {
+	where := []string{"project.created_at >= $1 AND project.created_at < $2 AND " + adminDashboardStatusFilter(dashboardType)}
+	values := []any{fromDate, toDate}
+	where, values, err := appendAdminDashboardFilters(where, values, filter)
+	if err != nil {
+		return AdminDashboardCount{}, err
+	}
+	queryStmt := fmt.Sprintf(`
+SELECT
+project_history.status,
+project_history.expected_participants,
+GROUPING(project_history.status),
+GROUPING(project_history.expected_participants),
+COUNT(*),
+COUNT(*) FILTER (WHERE project_history.cat_road_race),
+COUNT(*) FILTER (WHERE project_history.cat_trail_running)
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+WHERE %s
+GROUP BY GROUPING SETS ((project_history.status), (project_history.expected_participants), ());`, strings.Join(where, " "))
+
+	rows, err := s.db.Query(queryStmt, values...)
+	if err != nil {
+		return AdminDashboardCount{}, err
+	}
+	defer rows.Close()
+
+	data := AdminDashboardCount{
+		ByStatus:               map[string]int{},
+		ByExpectedParticipants: map[string]int{},
+	}
+	for rows.Next() {
+		var status, participants *string
+		var statusGrouped, participantsGrouped, count, roadRace, trailRunning int
+		err := rows.Scan(&status, &participants, &statusGrouped, &participantsGrouped, &count, &roadRace, &trailRunning)
+		if err != nil {
+			return AdminDashboardCount{}, err
+		}
+		switch {
+		case statusGrouped == 0:
+			data.ByStatus[*status] = count
+		case participantsGrouped == 0:
+			data.ByExpectedParticipants[*participants] = count
+		default:
+			data.Total = count
+			data.ByCategory.RoadRace = roadRace
+			data.ByCategory.TrailRunning = trailRunning
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		return AdminDashboardCount{}, err
+	}
+	return data, nil
+}
+
+// prepareAdminDashboardQuery pages with a keyset on the sort terms instead of OFFSET,
+// after holds the sort values of the last row of the previous page
+func prepareAdminDashboardQuery(
+	dashboardType string,
+	fromDate, toDate time.Time,
+	sort []DashboardSort,
+	limit int,
+	after []any,
+	filter AdminDashboardFilter,
+) (string, []any, error) {
+	where := []string{"project.created_at >= $1 AND project.created_at < $2 AND " + adminDashboardStatusFilter(dashboardType)}
+	values := []any{fromDate, toDate}
+	where, values, err := appendAdminDashboardFilters(where, values, filter)
+	if err != nil {
+		return "", nil, err
+	}
+	terms := adminDashboardSortTerms(dashboardType, sort)
+	if after != nil {
+		var keyset string
+		keyset, values = adminDashboardKeysetCondition(terms, after, values)
+		where = append(where, "AND "+keyset)
+	}
+	var orderBy, cursorColumns []string
+	for _, t := range terms {
+		orderBy = append(orderBy, fmt.Sprintf("%s %s", t.column, t.direction()))
+		cursorColumns = append(cursorColumns, t.column)
+	}
+	values = append(values, limit+1)
+
+	queryStmt := fmt.Sprintf(`
+	SELECT
+%s,
+json_build_array(%s)::text as cursor_values
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+WHERE %s
+ORDER BY %s
+LIMIT $%d;`, adminDashboardColumnsSQL, strings.Join(cursorColumns, ", "), strings.Join(where, " "), strings.Join(orderBy, ", "), len(values))
+	return queryStmt, values, nil
+}
+
+type adminDashboardSortTerm struct {
+	column string
+	asc    bool
+}
+
+func (t adminDashboardSortTerm) direction() string {
+	if t.asc {
+		return "ASC"
+	}
+	return "DESC"
+}
+
+// adminDashboardSortTerms expects validated keys and always ends with project.id so paging is stable
+func adminDashboardSortTerms(dashboardType string, sort []DashboardSort) []adminDashboardSortTerm {
+	var terms []adminDashboardSortTerm
+	for _, s := range sort {
+		column, ok := adminDashboardSortColumn(dashboardType, s.Key)
+		if !ok {
+			continue
+		}
+		terms = append(terms, adminDashboardSortTerm{column: column, asc: s.Direction != "desc"})
+	}
+	return append(terms, adminDashboardSortTerm{column: "project.id", asc: false})
+}
+
+// adminDashboardKeysetCondition expands (a, b, c) > (x, y, z) by hand because every term has its own direction
+func adminDashboardKeysetCondition(terms []adminDashboardSortTerm, after []any, values []any) (string, []any) {
+	placeholders := make([]string, len(terms))
+	for i := range terms {
+		values = append(values, after[i])
+		placeholders[i] = fmt.Sprintf("$%d", len(values))
+	}
+	var or []string
+	for i, t := range terms {
+		var and []string
+		for j := 0; j < i; j++ {
+			and = append(and, fmt.Sprintf("%s = %s", terms[j].column, placeholders[j]))
+		}
+		op := "<"
+		if t.asc {
+			op = ">"
+		}
+		and = append(and, fmt.Sprintf("%s %s %s", t.column, op, placeholders[i]))
+		or = append(or, "("+strings.Join(and, " AND ")+")")
+	}
+	return "(" + strings.Join(or, " OR ") + ")", values
+}
+
+type dashboardCursor struct {
+	Sort   string          `json:"s"`
+	Values json.RawMessage `json:"v"`
+}
+
+// a cursor is only valid for the dashboard and sort it was issued for
+func dashboardCursorSortKey(dashboardType string, sort []DashboardSort) string {
+	keys := []string{dashboardType}
+	for _, s := range sort {
+		keys = append(keys, s.Key+":"+s.Direction)
+	}
+	return strings.Join(keys, ",")
+}
+
+func encodeDashboardCursor(dashboardType string, sort []DashboardSort, cursorValues string) string {
+	b, _ := json.Marshal(dashboardCursor{Sort: dashboardCursorSortKey(dashboardType, sort), Values: json.RawMessage(cursorValues)})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeDashboardCursor(cursor, dashboardType string, sort []DashboardSort) ([]any, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, &DashboardCursorInvalidError{}
+	}
+	var c dashboardCursor
+	err = json.Unmarshal(b, &c)
+	if err != nil || c.Sort != dashboardCursorSortKey(dashboardType, sort) {
+		return nil, &DashboardCursorInvalidError{}
+	}
+	decoder := json.NewDecoder(bytes.NewReader(c.Values))
+	decoder.UseNumber()
+	var raw []any
+	err = decoder.Decode(&raw)
+	if err != nil || len(raw) != len(adminDashboardSortTerms(dashboardType, sort)) {
+		return nil, &DashboardCursorInvalidError{}
+	}
+	// values are bound as text and Postgres casts them to the type of the sort column
+	values := make([]any, len(raw))
+	for i, v := range raw {
+		switch val := v.(type) {
+		case json.Number:
+			values[i] = val.String()
+		case string:
+			values[i] = val
+		default:
+			return nil, &DashboardCursorInvalidError{}
+		}
+	}
+	return values, nil
+}
+
+// appendAdminDashboardFilters adds a placeholder condition for every filter that is set,
+// continuing the placeholder numbering after the values already bound
+func appendAdminDashboardFilters(where []string, values []any, filter AdminDashboardFilter) ([]string, []any, error) {
+	add := func(cond string, value any) {
+		values = append(values, value)
+		where = append(where, fmt.Sprintf("AND "+cond, len(values)))
+	}
+	if filter.ProjectCode != nil {
+		add("project_history.project_code = $%d", *filter.ProjectCode)
+	}
+	if filter.ProjectName != nil {
+		add("project_history.project_name LIKE '%%' || $%d || '%%'", *filter.ProjectName)
+	}
+	if filter.ProjectStatus != nil {
+		add("project_history.status = $%d", *filter.ProjectStatus)
+	}
+	if filter.FundingRoundId != nil {
+		add("project.funding_round_id = $%d", *filter.FundingRoundId)
+	}
+	if len(filter.ProvinceIds) > 0 {
+		add(`project_history.address_id IN (`+addressIdsInAreaSQL+` WHERE district.province_id = ANY($%d))`, pq.Array(filter.ProvinceIds))
+	}
+	if len(filter.DistrictIds) > 0 {
+		add(`project_history.address_id IN (`+addressIdsInAreaSQL+` WHERE district.id = ANY($%d))`, pq.Array(filter.DistrictIds))
+	}
+	if filter.CatRoadRace != nil {
+		add("project_history.cat_road_race = $%d", *filter.CatRoadRace)
+	}
+	if filter.CatTrailRunning != nil {
+		add("project_history.cat_trail_running = $%d", *filter.CatTrailRunning)
+	}
+	if filter.EventFromDate != nil || filter.EventToDate != nil {
+		loc, err := getTimeLocation()
+		if err != nil {
+			return nil, nil, err
+		}
+		if filter.EventFromDate != nil {
+			d := filter.EventFromDate
+			add("project_history.from_date >= $%d", time.Date(d.Year, time.Month(d.Month), d.Day, 0, 0, 0, 0, loc))
+		}
+		if filter.EventToDate != nil {
+			d := filter.EventToDate
+			add("project_history.from_date < $%d", time.Date(d.Year, time.Month(d.Month), d.Day+1, 0, 0, 0, 0, loc))
+		}
+	}
+	if len(filter.ExpectedParticipants) > 0 {
+		add("project_history.expected_participants = ANY($%d)", pq.Array(filter.ExpectedParticipants))
+	}
+	if filter.RequestedAmountMin != nil {
+		add("project_history.fund_req_fund_amount >= $%d", *filter.RequestedAmountMin)
+	}
+	if filter.RequestedAmountMax != nil {
+		add("project_history.fund_req_fund_amount <= $%d", *filter.RequestedAmountMax)
+	}
+	if filter.ApprovedAmountMin != nil {
+		add("project_history.fund_approved_amount >= $%d", *filter.ApprovedAmountMin)
+	}
+	if filter.ApprovedAmountMax != nil {
+		add("project_history.fund_approved_amount <= $%d", *filter.ApprovedAmountMax)
+	}
+	if filter.Collaborated != nil {
+		add("project_history.collaborated = $%d", *filter.Collaborated)
+	}
+	if filter.AvgScoreMin != nil {
+		add("project_history.avg_review_score >= $%d", *filter.AvgScoreMin)
+	}
+	if filter.AvgScoreMax != nil {
+		add("project_history.avg_review_score <= $%d", *filter.AvgScoreMax)
+	}
+	if filter.ReviseOverdue != nil {
+		add("(project_history.revise_overdue_at IS NOT NULL) = $%d", *filter.ReviseOverdue)
+	}
+	if len(filter.Tags) > 0 {
+		tags := make([]string, len(filter.Tags))
+		for i, tag := range filter.Tags {
+			tags[i] = normaliseTag(tag)
+		}
+		add("project.project_code IN (SELECT project_tag.project_code FROM project_tag WHERE project_tag.tag = ANY($%d))", pq.Array(tags))
+	}
+	return where, values, nil
+}
+
+// sort keys map to fixed SQL so clients never send SQL fragments,
+// every expression is non-null so the keyset comparison holds
+var adminDashboardSortColumns = map[string]string{
+	"code":      "project.project_code",
+	"name":      "project_history.project_name",
+	"createdAt": "project.created_at",
+	"updatedAt": "project_history.updated_at",
+	"eventDate": "project_history.from_date",
+	// projects without a review rank below every reviewed project
+	"avgScore": "COALESCE(project_history.avg_review_score, -1)",
+}
+
+func adminDashboardSortColumn(dashboardType, key string) (string, bool) {
+	if key == "status" {
+		// status follows the workflow order of each dashboard rather than the alphabet
+		if dashboardType == "request" {
+			return "POSITION(project_history.status::text IN 'Reviewing,Reviewed,Revise,Approved')", true
+		}
+		return "POSITION(project_history.status::text IN 'Start,Completed,NotApproved,Withdrawn,Cancelled,ClosedAsDuplicate')", true
+	}
+	column, ok := adminDashboardSortColumns[key]
+	return column, ok
+}
+
+// adminDashboardStatusFilter splits projects between the request (in progress) and started (settled) dashboards
+func adminDashboardStatusFilter(dashboardType string) string {
+	if dashboardType == "request" {
+		return "project_history.status NOT IN ('Start', 'Completed', 'NotApproved', 'Withdrawn', 'Cancelled', 'ClosedAsDuplicate')"
+	}
+	return "project_history.status IN ('Start', 'Completed', 'NotApproved', 'Withdrawn', 'Cancelled', 'ClosedAsDuplicate')"
+}
+
+const adminDashboardColumnsSQL = `
+project.project_code as project_code,
+project.created_at as created_at,
+project_history.project_name as project_name,
+project_history.status as project_status,
+project_history.updated_at as updated_at,
+project_history.admin_comment,
+project_history.avg_review_score as avg_score,
+EXISTS (
+	SELECT 1 FROM project_duplicate
+	WHERE project_duplicate.status = 'Suspected'
+	AND (project_duplicate.project_code = project.project_code OR project_duplicate.duplicate_of_project_code = project.project_code)
+) as suspected_duplicate,
+project_history.revise_due_at as revise_due_at,
+project_history.revise_overdue_at IS NOT NULL as revise_overdue,
+ARRAY(
+	SELECT project_tag.tag FROM project_tag
+	WHERE project_tag.project_code = project.project_code
+	ORDER BY project_tag.tag
+) as tags`
+
+const addressIdsInAreaSQL = `
+SELECT address.id FROM address
+INNER JOIN postcode ON address.postcode_id = postcode.id
+INNER JOIN subdistrict ON postcode.subdistrict_id = subdistrict.id
+INNER JOIN district ON subdistrict.district_id = district.id`
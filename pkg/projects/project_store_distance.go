@@ -0,0 +1,125 @@
+package projects
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+	"github.com/patrickmn/go-cache"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+const distanceCatalogueCacheKey = "distance_catalogue"
+
+func (s *store) GetDistanceCatalogue() ([]DistanceCatalogueItem, error) {
+	raw, found := s.c.Get(distanceCatalogueCacheKey)
+	if found {
+		cachedData, ok := raw.([]DistanceCatalogueItem)
+		if ok {
+			return cachedData, nil
+		}
+	}
+
+	rows, err := s.db.Query(getDistanceCatalogueSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []DistanceCatalogueItem{}
+	for rows.Next() {
+		var row DistanceCatalogueItem
+		err := rows.Scan(&row.Code, &row.Name, &row.Km, &row.KmRequired, &row.OrderNumber)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	s.c.Set(distanceCatalogueCacheKey, data, cache.NoExpiration)
+	return data, nil
+}
+
+func (s *store) GetUnmappedDistanceTypes() ([]DistanceTypeCount, error) {
+	rows, err := s.db.Query(getUnmappedDistanceTypesSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []DistanceTypeCount{}
+	for rows.Next() {
+		var row DistanceTypeCount
+		err := rows.Scan(&row.Type, &row.Rows)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// MapDistanceTypes sets the catalogue code of every unmapped row of each type in one transaction
+func (s *store) MapDistanceTypes(mappings []MappedDistanceType) (int64, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var updated int64
+	for _, m := range mappings {
+		result, err := tx.ExecContext(ctx, mapDistanceTypeSQL, m.Code, m.Km, m.Type)
+		if err != nil {
+			return 0, err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		updated += n
+	}
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+func (s *store) GetDistanceFeeAnalytics(year *int, statuses []string) (DistanceFeeAnalytics, error) {
+	rows, err := s.db.Query(getDistanceFeeAnalyticsSQL, year, pq.Array(statuses), utils.TIMEZONE)
+	if err != nil {
+		return DistanceFeeAnalytics{}, err
+	}
+	defer rows.Close()
+
+	analytics := DistanceFeeAnalytics{Distances: []DistanceFeeStat{}}
+	for rows.Next() {
+		var row DistanceFeeStat
+		err := rows.Scan(&row.Code, &row.Name, &row.Count, &row.MinFee, &row.MaxFee, &row.AvgFee, &row.MedianFee)
+		if err != nil {
+			return DistanceFeeAnalytics{}, err
+		}
+		row.AvgFee = roundTo(row.AvgFee, 2)
+		analytics.Distances = append(analytics.Distances, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return DistanceFeeAnalytics{}, err
+	}
+
+	err = s.db.QueryRow(countUnmappedDistanceFeesSQL, year, pq.Array(statuses), utils.TIMEZONE).Scan(&analytics.UnmappedCount)
+	if err != nil {
+		return DistanceFeeAnalytics{}, err
+	}
+	return analytics, nil
+}
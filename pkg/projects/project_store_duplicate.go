@@ -0,0 +1,230 @@
+package projects
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+)
+
+// flagDuplicates compares a new submission against current proposals held around the same event date
+func flagDuplicates(ctx context.Context, tx *sql.Tx, payload AddProjectRequest, projectCode string, fromDate time.Time, now time.Time) (int, error) {
+	searchWindow := time.Duration(DUPLICATE_SEARCH_DAYS) * 24 * time.Hour
+	rows, err := tx.QueryContext(ctx, getDuplicateCandidatesSQL, projectCode, fromDate.Add(-searchWindow), fromDate.Add(searchWindow))
+	if err != nil {
+		return 0, err
+	}
+	var candidates []duplicateCandidate
+	for rows.Next() {
+		var c duplicateCandidate
+		err := rows.Scan(
+			&c.ProjectCode,
+			&c.ProjectName,
+			&c.FromDate,
+			&c.ProvinceId,
+			&c.DistrictId,
+			&c.OrganizerName,
+			&c.OrganizationName,
+		)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		candidates = append(candidates, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	subject := duplicateSubject{
+		ProjectName:      payload.General.ProjectName,
+		FromDate:         fromDate,
+		ProvinceId:       payload.General.Address.ProvinceId,
+		DistrictId:       payload.General.Address.DistrictId,
+		OrganizerName:    payload.General.OrganizerName,
+		OrganizationName: payload.Contact.Organization.Name,
+	}
+	flagged := 0
+	for _, c := range candidates {
+		score, reasons := scoreDuplicate(subject, c)
+		if score < DUPLICATE_SCORE_THRESHOLD {
+			continue
+		}
+		_, err := tx.ExecContext(ctx, addProjectDuplicateSQL, projectCode, c.ProjectCode, score, strings.Join(reasons, ","), now)
+		if err != nil {
+			return 0, err
+		}
+		flagged++
+	}
+	if flagged > 0 {
+		slog.Info("suspected duplicate proposals flagged", "projectCode", projectCode, "count", flagged)
+	}
+	return flagged, nil
+}
+
+func (s *store) GetProjectDuplicates(projectCode string) ([]ProjectDuplicate, error) {
+	rows, err := s.db.Query(getProjectDuplicatesSQL, projectCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []ProjectDuplicate{}
+	for rows.Next() {
+		var row ProjectDuplicate
+		var reasons string
+		err := rows.Scan(
+			&row.Id,
+			&row.ProjectCode,
+			&row.DuplicateOfProjectCode,
+			&row.OtherProjectName,
+			&row.OtherProjectStatus,
+			&row.Score,
+			&reasons,
+			&row.Status,
+			&row.ResolvedBy,
+			&row.ResolvedAt,
+			&row.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		row.Reasons = []string{}
+		if reasons != "" {
+			row.Reasons = strings.Split(reasons, ",")
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ResolveDuplicate records the admin decision; close-as-duplicate and merge close the newer proposal,
+// the earlier one stays open and is recorded as duplicate_of_project_code
+func (s *store) ResolveDuplicate(duplicateId int, action string, adminId int) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var projectCode, duplicateOfProjectCode, status string
+	var projectHistoryId, ownerId int
+	err = tx.QueryRowContext(ctx, getProjectDuplicateForUpdateSQL, duplicateId).Scan(&projectCode, &duplicateOfProjectCode, &status, &projectHistoryId, &ownerId)
+	if err == sql.ErrNoRows {
+		return &DuplicateNotFoundError{}
+	}
+	if err != nil {
+		return err
+	}
+	if status != "Suspected" {
+		return &DuplicateAlreadyResolvedError{Status: status}
+	}
+
+	now := time.Now()
+	if action == "close-as-duplicate" || action == "merge" {
+		var id int
+		err = tx.QueryRowContext(ctx, closeProjectAsDuplicateSQL, projectHistoryId, now).Scan(&id)
+		if err == sql.ErrNoRows {
+			return &ProjectNotClosableAsDuplicateError{}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if action == "merge" {
+		err = s.mergeDuplicate(ctx, tx, projectCode, projectHistoryId, ownerId, duplicateOfProjectCode, adminId, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	var id int
+	err = tx.QueryRowContext(ctx, resolveProjectDuplicateSQL, duplicateId, DUPLICATE_ACTION[action], adminId, now).Scan(&id)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	slog.Info("duplicate resolved", "duplicateId", duplicateId, "action", action, "projectCode", projectCode)
+	return nil
+}
+
+// mergeDuplicate moves the reviews, notes, tags and messages of the closed proposal onto the surviving one
+// and copies its files under the survivor's merged/<projectCode> folder, the closed proposal keeps its own copy
+func (s *store) mergeDuplicate(
+	ctx context.Context,
+	tx *sql.Tx,
+	projectCode string,
+	projectHistoryId int,
+	ownerId int,
+	survivorCode string,
+	adminId int,
+	now time.Time,
+) error {
+	var survivorHistoryId, survivorOwnerId int
+	var survivorStatus string
+	err := tx.QueryRowContext(ctx, getDuplicateMergeTargetForUpdateSQL, survivorCode).Scan(&survivorHistoryId, &survivorOwnerId, &survivorStatus)
+	if err != nil {
+		return err
+	}
+	if CLOSED_STATUS[survivorStatus] {
+		return &DuplicateMergeTargetClosedError{ProjectCode: survivorCode, Status: survivorStatus}
+	}
+
+	_, err = tx.ExecContext(ctx, moveDuplicateReviewsSQL, projectHistoryId, survivorHistoryId)
+	if err != nil {
+		return err
+	}
+	for _, id := range []int{projectHistoryId, survivorHistoryId} {
+		_, err = tx.ExecContext(ctx, refreshProjectReviewScoreSQL, id)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = tx.ExecContext(ctx, moveDuplicateNotesSQL, projectCode, survivorCode)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, moveDuplicateTagsSQL, projectCode, survivorCode)
+	if err != nil {
+		return err
+	}
+	mergedPrefix := fmt.Sprintf("%s/%s/", survivorCode, DUPLICATE_MERGE_DIR)
+	_, err = tx.ExecContext(ctx, moveDuplicateMessageAttachmentsSQL, projectCode, mergedPrefix)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, moveDuplicateMessagesSQL, projectCode, survivorCode)
+	if err != nil {
+		return err
+	}
+	var noteId int
+	err = tx.QueryRowContext(ctx, addProjectNoteSQL, survivorCode, nil, fmt.Sprintf("merged %s into this project", projectCode), adminId, now).Scan(&noteId)
+	if err != nil {
+		return err
+	}
+
+	// the copy runs last so a failed query above does not leave files behind, a failed commit still can
+	err = s.awsS3Service.CopyObjects(
+		os.Getenv("AWS_S3_STORE_BUCKET_NAME"),
+		getBasePrefix(ownerId, projectCode)+"/",
+		getBasePrefix(survivorOwnerId, mergedPrefix+projectCode)+"/",
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package projects
+
+import (
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+	"time"
+)
+
+func (s *store) GetEnabledEligibilityRules(fundingRoundId int) ([]EligibilityRule, error) {
+	return s.queryEligibilityRules(getEnabledEligibilityRulesSQL, fundingRoundId)
+}
+
+func (s *store) GetAllEligibilityRules() ([]EligibilityRule, error) {
+	return s.queryEligibilityRules(getAllEligibilityRulesSQL)
+}
+
+func (s *store) CountActiveOrganizationProposals(fundingRoundId int, organizationName string) (int, error) {
+	var count int
+	err := s.db.QueryRow(countActiveOrganizationProposalsSQL, fundingRoundId, organizationName).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *store) AddEligibilityRule(payload EligibilityRuleRequest) (int, error) {
+	params, err := json.Marshal(payload.Params)
+	if err != nil {
+		return 0, err
+	}
+	var id int
+	err = s.db.QueryRow(
+		addEligibilityRuleSQL,
+		payload.RuleType,
+		params,
+		payload.Message,
+		payload.FundingRoundId,
+		*payload.Enabled,
+		time.Now(),
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	slog.Info("eligibility rule added", "ruleId", id, "ruleType", payload.RuleType)
+	return id, nil
+}
+
+func (s *store) UpdateEligibilityRule(ruleId int, payload EligibilityRuleRequest) error {
+	params, err := json.Marshal(payload.Params)
+	if err != nil {
+		return err
+	}
+	var id int
+	err = s.db.QueryRow(
+		updateEligibilityRuleSQL,
+		ruleId,
+		payload.RuleType,
+		params,
+		payload.Message,
+		payload.FundingRoundId,
+		*payload.Enabled,
+		time.Now(),
+	).Scan(&id)
+	if err == sql.ErrNoRows {
+		return &EligibilityRuleNotFoundError{}
+	}
+	if err != nil {
+		return err
+	}
+	slog.Info("eligibility rule updated", "ruleId", id)
+	return nil
+}
+
+func (s *store) queryEligibilityRules(query string, args ...any) ([]EligibilityRule, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []EligibilityRule{}
+	for rows.Next() {
+		var row EligibilityRule
+		var params []byte
+		err = rows.Scan(
+			&row.Id,
+			&row.RuleType,
+			&params,
+			&row.Message,
+			&row.FundingRoundId,
+			&row.Enabled,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(params, &row.Params)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
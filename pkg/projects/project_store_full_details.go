@@ -0,0 +1,492 @@
+package projects
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// GetProjectFullDetails rebuilds the AddProjectRequest document of a project version.
+// version = 0 means the current version of the project.
+func (s *store) GetProjectFullDetails(projectCode string, version int) (ProjectFullDetailsResponse, error) {
+	var projectHistoryId int
+	var userId int
+	var err error
+	if version == 0 {
+		err = s.db.QueryRow(getLatestProjectHistoryIdSQL, projectCode).Scan(&projectHistoryId, &userId)
+	} else {
+		err = s.db.QueryRow(getProjectHistoryIdByVersionSQL, projectCode, version).Scan(&projectHistoryId, &userId)
+	}
+	if err == sql.ErrNoRows {
+		return ProjectFullDetailsResponse{}, &ProjectNotFoundError{}
+	}
+	if err != nil {
+		return ProjectFullDetailsResponse{}, err
+	}
+
+	body := ProjectFullDetailsResponse{
+		ProjectHistoryId: projectHistoryId,
+		UserId:           userId,
+	}
+	contactIds, addressId, err := s.scanProjectHistoryFullDetails(projectHistoryId, &body)
+	if err != nil {
+		return ProjectFullDetailsResponse{}, err
+	}
+
+	if addressId != nil {
+		address, err := s.getAddressWithAreaIds(*addressId)
+		if err != nil {
+			return ProjectFullDetailsResponse{}, err
+		}
+		body.Form.General.Address = address
+	}
+
+	err = s.fillContacts(contactIds, &body.Form.Contact)
+	if err != nil {
+		return ProjectFullDetailsResponse{}, err
+	}
+
+	distances, err := s.getDistancesByProjectHistoryId(projectHistoryId)
+	if err != nil {
+		return ProjectFullDetailsResponse{}, err
+	}
+	body.Form.General.EventDetails.DistanceAndFee = distances
+
+	routeTracks, err := s.getRouteTracksByProjectHistoryId(projectHistoryId)
+	if err != nil {
+		return ProjectFullDetailsResponse{}, err
+	}
+	body.RouteCheck = CheckRouteDistances(distances, routeTracks)
+
+	budgetItems, err := s.getBudgetItemsByProjectHistoryId(projectHistoryId)
+	if err != nil {
+		return ProjectFullDetailsResponse{}, err
+	}
+	body.Form.Fund.Budget.Items = budgetItems
+
+	scores, err := s.getApplicantScoresByProjectHistoryId(projectHistoryId)
+	if err != nil {
+		return ProjectFullDetailsResponse{}, err
+	}
+	body.Form.Details.Score = scores
+
+	versions, err := s.getProjectVersions(projectCode)
+	if err != nil {
+		return ProjectFullDetailsResponse{}, err
+	}
+	body.Versions = versions
+
+	return body, nil
+}
+
+type projectContactIds struct {
+	projectHead        *int
+	projectManager     *int
+	projectCoordinator *int
+	raceDirector       *int
+}
+
+func (s *store) scanProjectHistoryFullDetails(projectHistoryId int, body *ProjectFullDetailsResponse) (projectContactIds, *int, error) {
+	var ids projectContactIds
+	var addressId *int
+	var fromDate, toDate time.Time
+	var thisSeriesLatestDate *time.Time
+	// Nullable columns
+	var vipFee *float64
+	var mktFacebook, mktWebsite, mktOnlinePage, mktOtherOnline, mktOtherOffline sql.NullString
+	var aedCount sql.NullInt64
+	var safetyAddition, selfTool, judgeOtherType, supportAddition sql.NullString
+	var thisCompleted2Year, thisCompleted2Participant, thisCompleted3Year, thisCompleted3Participant sql.NullInt64
+	var otherCompleted2Year, otherCompleted2Participant, otherCompleted3Year, otherCompleted3Participant sql.NullInt64
+	var otherCompleted2Name, otherCompleted3Name sql.NullString
+	var fundAmount, bibAmount sql.NullInt64
+	var seminarTopic, otherFundType sql.NullString
+	var noAlcoholSponsor sql.NullBool
+	var firstTime, doneBefore, collaborated, vip, hasOrganizer bool
+
+	f := &body.Form
+	err := s.db.QueryRow(getProjectHistoryFullDetailsSQL, projectHistoryId).Scan(
+		&body.ProjectCode,
+		&body.ProjectVersion,
+		&body.CreatedAt,
+		&body.UpdatedAt,
+		&body.ProjectStatus,
+		&collaborated,
+		&f.General.ProjectName,
+		&fromDate,
+		&toDate,
+		&addressId,
+		&f.General.StartPoint,
+		&f.General.FinishPoint,
+		&f.General.EventDetails.Category.Available.RoadRace,
+		&f.General.EventDetails.Category.Available.TrailRunning,
+		&f.General.EventDetails.Category.Available.Other,
+		&f.General.EventDetails.Category.OtherType,
+		&vip,
+		&vipFee,
+		&f.General.ExpectedParticipants,
+		&hasOrganizer,
+		&f.General.OrganizerName,
+		&f.General.OrganizerPublicConsent,
+		&ids.projectHead,
+		&ids.projectManager,
+		&ids.projectCoordinator,
+		&ids.raceDirector,
+		&f.Contact.Organization.Type,
+		&f.Contact.Organization.Name,
+		&f.Details.Background,
+		&f.Details.Objective,
+		&f.Details.Marketing.Online.Available.Facebook,
+		&mktFacebook,
+		&f.Details.Marketing.Online.Available.Website,
+		&mktWebsite,
+		&f.Details.Marketing.Online.Available.OnlinePage,
+		&mktOnlinePage,
+		&f.Details.Marketing.Online.Available.Other,
+		&mktOtherOnline,
+		&f.Details.Marketing.Offline.Available.PR,
+		&f.Details.Marketing.Offline.Available.LocalOfficial,
+		&f.Details.Marketing.Offline.Available.Booth,
+		&f.Details.Marketing.Offline.Available.Billboard,
+		&f.Details.Marketing.Offline.Available.TV,
+		&f.Details.Marketing.Offline.Available.Other,
+		&mktOtherOffline,
+		&f.Details.Safety.Ready.RunnerInformation,
+		&f.Details.Safety.Ready.HealthDecider,
+		&f.Details.Safety.Ready.Ambulance,
+		&f.Details.Safety.Ready.FirstAid,
+		&f.Details.Safety.Ready.AED,
+		&aedCount,
+		&f.Details.Safety.Ready.VolunteerDoctor,
+		&f.Details.Safety.Ready.Insurance,
+		&f.Details.Safety.Ready.Other,
+		&safetyAddition,
+		&f.Details.Route.Measurement.AthleticsAssociation,
+		&f.Details.Route.Measurement.CalibratedBicycle,
+		&f.Details.Route.Measurement.SelfMeasurement,
+		&selfTool,
+		&f.Details.Route.TrafficManagement.AskPermission,
+		&f.Details.Route.TrafficManagement.HasSupporter,
+		&f.Details.Route.TrafficManagement.RoadClosure,
+		&f.Details.Route.TrafficManagement.Signs,
+		&f.Details.Route.TrafficManagement.Lighting,
+		&f.Details.Judge.Type,
+		&judgeOtherType,
+		&f.Details.Support.Organization.ProvincialAdministration,
+		&f.Details.Support.Organization.Safety,
+		&f.Details.Support.Organization.Health,
+		&f.Details.Support.Organization.Volunteer,
+		&f.Details.Support.Organization.Community,
+		&f.Details.Support.Organization.Other,
+		&supportAddition,
+		&f.Details.Feedback,
+		&firstTime,
+		&f.Experience.ThisSeries.History.OrdinalNumber,
+		&thisSeriesLatestDate,
+		&f.Experience.ThisSeries.History.Completed1.Year,
+		&f.Experience.ThisSeries.History.Completed1.Participant,
+		&thisCompleted2Year,
+		&thisCompleted2Participant,
+		&thisCompleted3Year,
+		&thisCompleted3Participant,
+		&doneBefore,
+		&f.Experience.OtherSeries.History.Completed1.Year,
+		&f.Experience.OtherSeries.History.Completed1.Name,
+		&f.Experience.OtherSeries.History.Completed1.Participant,
+		&otherCompleted2Year,
+		&otherCompleted2Name,
+		&otherCompleted2Participant,
+		&otherCompleted3Year,
+		&otherCompleted3Name,
+		&otherCompleted3Participant,
+		&f.Fund.Budget.Total,
+		&f.Fund.Budget.SupportOrganization,
+		&f.Fund.Request.Type.Fund,
+		&fundAmount,
+		&f.Fund.Request.Type.BIB,
+		&bibAmount,
+		&f.Fund.Request.Type.Pr,
+		&f.Fund.Request.Type.Seminar,
+		&seminarTopic,
+		&f.Fund.Request.Type.Other,
+		&otherFundType,
+		&noAlcoholSponsor,
+	)
+	if err != nil {
+		return projectContactIds{}, nil, err
+	}
+
+	loc, err := getTimeLocation()
+	if err != nil {
+		return projectContactIds{}, nil, err
+	}
+	fromLocal := fromDate.In(loc)
+	toLocal := toDate.In(loc)
+	f.General.EventDate = EventDate{
+		Year:       fromLocal.Year(),
+		Month:      int(fromLocal.Month()),
+		Day:        fromLocal.Day(),
+		FromHour:   newInt(fromLocal.Hour()),
+		FromMinute: newInt(fromLocal.Minute()),
+		ToHour:     newInt(toLocal.Hour()),
+		ToMinute:   newInt(toLocal.Minute()),
+	}
+	if thisSeriesLatestDate != nil {
+		latestLocal := thisSeriesLatestDate.In(loc)
+		f.Experience.ThisSeries.History.Year = latestLocal.Year()
+		f.Experience.ThisSeries.History.Month = int(latestLocal.Month())
+		f.Experience.ThisSeries.History.Day = latestLocal.Day()
+	}
+
+	f.Collaborated = &collaborated
+	f.General.EventDetails.VIP = &vip
+	f.General.EventDetails.VIPFee = vipFee
+	f.General.HasOrganizer = &hasOrganizer
+	f.Details.Marketing.Online.HowTo.Facebook = mktFacebook.String
+	f.Details.Marketing.Online.HowTo.Website = mktWebsite.String
+	f.Details.Marketing.Online.HowTo.OnlinePage = mktOnlinePage.String
+	f.Details.Marketing.Online.HowTo.Other = mktOtherOnline.String
+	f.Details.Marketing.Offline.Addition = mktOtherOffline.String
+	f.Details.Safety.AEDCount = int(aedCount.Int64)
+	f.Details.Safety.Addition = safetyAddition.String
+	f.Details.Route.Tool = selfTool.String
+	f.Details.Judge.OtherType = judgeOtherType.String
+	f.Details.Support.Addition = supportAddition.String
+	f.Experience.ThisSeries.FirstTime = &firstTime
+	f.Experience.ThisSeries.History.Completed2 = HistoryCompleted{Year: int(thisCompleted2Year.Int64), Participant: int(thisCompleted2Participant.Int64)}
+	f.Experience.ThisSeries.History.Completed3 = HistoryCompleted{Year: int(thisCompleted3Year.Int64), Participant: int(thisCompleted3Participant.Int64)}
+	f.Experience.OtherSeries.DoneBefore = &doneBefore
+	f.Experience.OtherSeries.History.Completed2 = HistoryCompleted{Year: int(otherCompleted2Year.Int64), Name: otherCompleted2Name.String, Participant: int(otherCompleted2Participant.Int64)}
+	f.Experience.OtherSeries.History.Completed3 = HistoryCompleted{Year: int(otherCompleted3Year.Int64), Name: otherCompleted3Name.String, Participant: int(otherCompleted3Participant.Int64)}
+	f.Fund.Request.Details.FundAmount = int(fundAmount.Int64)
+	f.Fund.Request.Details.BibAmount = int(bibAmount.Int64)
+	f.Fund.Request.Details.Seminar = seminarTopic.String
+	f.Fund.Request.Details.Other = otherFundType.String
+	f.Fund.Budget.NoAlcoholSponsor = noAlcoholSponsor.Valid && noAlcoholSponsor.Bool
+
+	return ids, addressId, nil
+}
+
+func (s *store) fillContacts(ids projectContactIds, contact *Contact) error {
+	var err error
+	if ids.projectHead != nil {
+		contact.ProjectHead, err = s.getContactPerson(*ids.projectHead)
+		if err != nil {
+			return err
+		}
+	}
+	if ids.projectManager != nil {
+		contact.ProjectManager, err = s.getContactPerson(*ids.projectManager)
+		if err != nil {
+			return err
+		}
+	}
+	if ids.projectCoordinator != nil {
+		contact.ProjectCoordinator, err = s.getContactPerson(*ids.projectCoordinator)
+		if err != nil {
+			return err
+		}
+	}
+	if ids.raceDirector == nil {
+		return nil
+	}
+	// race director is stored as a reference to one of the contacts above or as a separate contact
+	switch {
+	case ids.projectHead != nil && *ids.raceDirector == *ids.projectHead:
+		contact.RaceDirector.Who = "projectHead"
+	case ids.projectManager != nil && *ids.raceDirector == *ids.projectManager:
+		contact.RaceDirector.Who = "projectManager"
+	case ids.projectCoordinator != nil && *ids.raceDirector == *ids.projectCoordinator:
+		contact.RaceDirector.Who = "projectCoordinator"
+	default:
+		rd, err := s.getContactPerson(*ids.raceDirector)
+		if err != nil {
+			return err
+		}
+		contact.RaceDirector.Who = "other"
+		contact.RaceDirector.Alternative = RaceDirectorAlternative{
+			Prefix:    rd.Prefix,
+			FirstName: rd.FirstName,
+			LastName:  rd.LastName,
+		}
+	}
+	return nil
+}
+
+func (s *store) getContactPerson(contactId int) (ContactPerson, error) {
+	var cp ContactPerson
+	var organizationPosition, eventPosition, email, lineId, phoneNumber sql.NullString
+	var addressId *int
+	err := s.db.QueryRow(getContactByIdSQL, contactId).Scan(
+		&cp.Prefix,
+		&cp.FirstName,
+		&cp.LastName,
+		&organizationPosition,
+		&eventPosition,
+		&addressId,
+		&email,
+		&lineId,
+		&phoneNumber,
+	)
+	if err != nil {
+		return ContactPerson{}, fmt.Errorf("getContactPerson id: %d, error: %w", contactId, err)
+	}
+	cp.OrganizationPosition = organizationPosition.String
+	cp.EventPosition = eventPosition.String
+	cp.Email = email.String
+	cp.LineId = lineId.String
+	cp.PhoneNumber = phoneNumber.String
+	if addressId != nil {
+		cp.Address, err = s.getAddressWithAreaIds(*addressId)
+		if err != nil {
+			return ContactPerson{}, err
+		}
+	}
+	return cp, nil
+}
+
+func (s *store) getAddressWithAreaIds(addressId int) (Address, error) {
+	var a Address
+	err := s.db.QueryRow(getAddressWithAreaIdsSQL, addressId).Scan(
+		&a.Address,
+		&a.PostcodeId,
+		&a.SubdistrictId,
+		&a.DistrictId,
+		&a.ProvinceId,
+	)
+	if err != nil {
+		return Address{}, fmt.Errorf("getAddressWithAreaIds id: %d, error: %w", addressId, err)
+	}
+	return a, nil
+}
+
+func (s *store) getDistancesByProjectHistoryId(projectHistoryId int) ([]DistanceAndFee, error) {
+	rows, err := s.db.Query(getDistancesByProjectHistoryIdSQL, projectHistoryId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var data []DistanceAndFee
+	for rows.Next() {
+		row := DistanceAndFee{Checked: true}
+		var code sql.NullString
+		err = rows.Scan(&row.Type, &row.Fee, &row.Dynamic, &code, &row.Km)
+		if err != nil {
+			return nil, err
+		}
+		row.Code = code.String
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *store) getRouteTracksByProjectHistoryId(projectHistoryId int) ([]RouteTrack, error) {
+	rows, err := s.db.Query(getRouteTracksByProjectHistoryIdSQL, projectHistoryId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []RouteTrack{}
+	for rows.Next() {
+		var row RouteTrack
+		err = rows.Scan(
+			&row.FileName,
+			&row.Name,
+			&row.LengthKm,
+			&row.ElevationGainM,
+			&row.BoundingBox.MinLat,
+			&row.BoundingBox.MinLon,
+			&row.BoundingBox.MaxLat,
+			&row.BoundingBox.MaxLon,
+		)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *store) getBudgetItemsByProjectHistoryId(projectHistoryId int) ([]BudgetItem, error) {
+	rows, err := s.db.Query(getBudgetItemsByProjectHistoryIdSQL, projectHistoryId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var data []BudgetItem
+	for rows.Next() {
+		var row BudgetItem
+		err = rows.Scan(&row.Category, &row.Description, &row.Quantity, &row.UnitCost, &row.FundingSource)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *store) getApplicantScoresByProjectHistoryId(projectHistoryId int) (map[string]int, error) {
+	rows, err := s.db.Query(getApplicantScoresByProjectHistoryIdSQL, projectHistoryId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	scores := map[string]int{}
+	for rows.Next() {
+		var criteriaVersion, orderNumber, score int
+		err = rows.Scan(&criteriaVersion, &orderNumber, &score)
+		if err != nil {
+			return nil, err
+		}
+		scores[fmt.Sprintf("q_%d_%d", criteriaVersion, orderNumber)] = score
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
+
+func (s *store) getProjectVersions(projectCode string) ([]int, error) {
+	rows, err := s.db.Query(getProjectVersionsSQL, projectCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var versions []int
+	for rows.Next() {
+		var v int
+		err = rows.Scan(&v)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+func newInt(val int) *int {
+	v := val
+	return &v
+}
@@ -0,0 +1,172 @@
+package projects
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"mime/multipart"
+	"os"
+	"time"
+)
+
+func (s *store) GetProjectOwner(projectCode string) (ProjectOwner, error) {
+	var owner ProjectOwner
+	err := s.db.QueryRow(getProjectOwnerSQL, projectCode).Scan(&owner.UserId, &owner.ProjectName)
+	if err == sql.ErrNoRows {
+		return ProjectOwner{}, &ProjectNotFoundError{}
+	}
+	if err != nil {
+		return ProjectOwner{}, err
+	}
+	return owner, nil
+}
+
+func (s *store) GetProjectMessages(projectCode string) ([]ProjectMessage, error) {
+	rows, err := s.db.Query(getProjectMessagesSQL, projectCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := []ProjectMessage{}
+	index := map[int]int{}
+	for rows.Next() {
+		var row ProjectMessage
+		err := rows.Scan(
+			&row.Id,
+			&row.ProjectCode,
+			&row.SenderId,
+			&row.SenderRole,
+			&row.SenderName,
+			&row.Body,
+			&row.CreatedAt,
+			&row.ReadBy,
+			&row.ReadAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		row.Attachments = []ProjectMessageAttachment{}
+		index[row.Id] = len(messages)
+		messages = append(messages, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	attachmentRows, err := s.db.Query(getProjectMessageAttachmentsSQL, projectCode)
+	if err != nil {
+		return nil, err
+	}
+	defer attachmentRows.Close()
+	for attachmentRows.Next() {
+		var messageId int
+		var a ProjectMessageAttachment
+		err := attachmentRows.Scan(&messageId, &a.Id, &a.FileName, &a.Path)
+		if err != nil {
+			return nil, err
+		}
+		if i, ok := index[messageId]; ok {
+			messages[i].Attachments = append(messages[i].Attachments, a)
+		}
+	}
+	err = attachmentRows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// AddProjectMessage stores attachments under the project owner's prefix and emails the other side once committed
+func (s *store) AddProjectMessage(param AddProjectMessageParam, attachments []*multipart.FileHeader) (int, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var messageId int
+	err = tx.QueryRowContext(ctx, addProjectMessageSQL, param.ProjectCode, param.SenderId, param.SenderRole, param.Body, param.CreatedAt).Scan(&messageId)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(attachments) > 0 {
+		bucketName := os.Getenv("AWS_S3_STORE_BUCKET_NAME")
+		err = s.awsS3Service.UploadFilesToS3(attachments, bucketName, projectMessageObjectPrefix(param.OwnerId, param.ProjectCode, messageId))
+		if err != nil {
+			return 0, err
+		}
+		for _, fileHeader := range attachments {
+			fileName := uploadedFileName(fileHeader)
+			path := fmt.Sprintf("%s/%s", projectMessagePath(param.ProjectCode, messageId), fileName)
+			_, err = tx.ExecContext(ctx, addProjectMessageAttachmentSQL, messageId, fileName, path)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+	slog.Info("project message added", "projectCode", param.ProjectCode, "messageId", messageId)
+
+	// the message is already saved, a failed notification must not fail the request
+	err = s.notifyProjectMessage(param)
+	if err != nil {
+		slog.Error("failed to notify project message", "projectCode", param.ProjectCode, "error", err.Error())
+	}
+	return messageId, nil
+}
+
+func (s *store) notifyProjectMessage(param AddProjectMessageParam) error {
+	var to []string
+	if param.SenderRole == "applicant" {
+		rows, err := s.db.Query(getActiveAdminEmailsSQL)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var email string
+			err := rows.Scan(&email)
+			if err != nil {
+				return err
+			}
+			to = append(to, email)
+		}
+		err = rows.Err()
+		if err != nil {
+			return err
+		}
+	} else {
+		var email string
+		err := s.db.QueryRow(getUserEmailByIdSQL, param.OwnerId).Scan(&email)
+		if err != nil {
+			return err
+		}
+		to = append(to, email)
+	}
+	if len(to) == 0 {
+		return nil
+	}
+	messageLink := fmt.Sprintf("http://%s/project/messages/%s", os.Getenv("UI_URL"), param.ProjectCode)
+	mail := s.emailService.BuildProjectMessageEmail(to, param.ProjectCode, param.ProjectName, messageLink)
+	return s.emailService.SendEmail(mail)
+}
+
+// MarkProjectMessagesRead records that the reader has seen every message sent by the other side
+func (s *store) MarkProjectMessagesRead(projectCode string, readerRole string, readerId int, readAt time.Time) (int64, error) {
+	result, err := s.db.Exec(markProjectMessagesReadSQL, projectCode, readerRole, readerId, readAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,143 @@
+package projects
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+func (s *store) GetProjectNotes(projectCode string) ([]ProjectNote, error) {
+	rows, err := s.db.Query(getProjectNotesSQL, projectCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notes []ProjectNote
+	for rows.Next() {
+		var row ProjectNote
+		err := rows.Scan(
+			&row.Id,
+			&row.ProjectCode,
+			&row.ParentId,
+			&row.Body,
+			&row.CreatedBy,
+			&row.CreatedByName,
+			&row.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return threadProjectNotes(notes), nil
+}
+
+// AddProjectNote keeps threads one level deep, a reply to a reply is attached to the thread's first note
+func (s *store) AddProjectNote(projectCode string, parentId *int, body string, userId int, createdAt time.Time) (int, error) {
+	if parentId != nil {
+		var threadId int
+		err := s.db.QueryRow(getProjectNoteThreadIdSQL, *parentId, projectCode).Scan(&threadId)
+		if err == sql.ErrNoRows {
+			return 0, &ProjectNoteParentNotFoundError{}
+		}
+		if err != nil {
+			return 0, err
+		}
+		parentId = &threadId
+	}
+	var id int
+	err := s.db.QueryRow(addProjectNoteSQL, projectCode, parentId, body, userId, createdAt).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, &ProjectNotFoundError{}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (s *store) GetProjectTags(projectCode string) ([]string, error) {
+	rows, err := s.db.Query(getProjectTagsSQL, projectCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := []string{}
+	for rows.Next() {
+		var tag string
+		err := rows.Scan(&tag)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// ReplaceProjectTags sets the project tags to exactly tags, tags kept from before keep their author
+func (s *store) ReplaceProjectTags(projectCode string, tags []string, userId int) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var projectId int
+	err = tx.QueryRowContext(ctx, lockProjectByCodeSQL, projectCode).Scan(&projectId)
+	if err == sql.ErrNoRows {
+		return &ProjectNotFoundError{}
+	}
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, removeProjectTagsSQL, projectCode, pq.Array(tags))
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, tag := range tags {
+		_, err = tx.ExecContext(ctx, addProjectTagSQL, projectCode, tag, userId, now)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *store) GetAllProjectTags() ([]ProjectTagCount, error) {
+	rows, err := s.db.Query(getAllProjectTagsSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []ProjectTagCount{}
+	for rows.Next() {
+		var row ProjectTagCount
+		err := rows.Scan(&row.Tag, &row.Count)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
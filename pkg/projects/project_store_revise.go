@@ -0,0 +1,99 @@
+package projects
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+func (s *store) GetReviseDeadlineConfig() (ReviseDeadlineConfig, error) {
+	var config ReviseDeadlineConfig
+	var days pq.Int64Array
+	err := s.db.QueryRow(getReviseDeadlineConfigSQL).Scan(&days, &config.OverdueStatus)
+	if err != nil {
+		return ReviseDeadlineConfig{}, err
+	}
+	for _, d := range days {
+		config.ReminderDays = append(config.ReminderDays, int(d))
+	}
+	return config, nil
+}
+
+func (s *store) GetReviseReminderCandidates(now time.Time, withinDays int) ([]ReviseReminderCandidate, error) {
+	rows, err := s.db.Query(getReviseReminderCandidatesSQL, now, withinDays)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var data []ReviseReminderCandidate
+	for rows.Next() {
+		var row ReviseReminderCandidate
+		err := rows.Scan(&row.ProjectHistoryId, &row.ProjectCode, &row.ProjectName, &row.DueAt, &row.Email)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ClaimReviseReminder returns false when the reminder was already sent, so running several instances does not send it twice
+func (s *store) ClaimReviseReminder(projectHistoryId int, dueAt time.Time, daysBefore int, now time.Time) (bool, error) {
+	var id int
+	err := s.db.QueryRow(claimReviseReminderSQL, projectHistoryId, dueAt, daysBefore, now).Scan(&id)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *store) ReleaseReviseReminders(projectHistoryId int, dueAt time.Time, daysBefore []int) error {
+	_, err := s.db.Exec(releaseReviseRemindersSQL, projectHistoryId, dueAt, pq.Array(daysBefore))
+	return err
+}
+
+func (s *store) SendReviseReminderEmail(c ReviseReminderCandidate) error {
+	loc, err := getTimeLocation()
+	if err != nil {
+		return err
+	}
+	dueAt := c.DueAt.In(loc)
+	dueDate := getDateTimeString(dueAt.Year(), int(dueAt.Month()), dueAt.Day(), dueAt.Hour(), dueAt.Minute())
+	projectLink := fmt.Sprintf("http://%s/project/messages/%s", os.Getenv("UI_URL"), c.ProjectCode)
+	mail := s.emailService.BuildReviseReminderEmail(c.Email, c.ProjectCode, c.ProjectName, dueDate, projectLink)
+	return s.emailService.SendEmail(mail)
+}
+
+func (s *store) MarkOverdueRevisions(now time.Time, overdueStatus *string) ([]string, error) {
+	rows, err := s.db.Query(markReviseOverdueSQL, now, overdueStatus)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var codes []string
+	for rows.Next() {
+		var code string
+		err := rows.Scan(&code)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
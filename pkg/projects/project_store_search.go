@@ -0,0 +1,98 @@
+package projects
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+func (s *store) SearchProjects(
+	dashboardType string,
+	query string,
+	fromDate, toDate time.Time,
+	limit, offset int,
+	filter AdminDashboardFilter,
+) ([]ProjectSearchRow, error) {
+	queryStmt, values, err := prepareSearchProjectsQuery(dashboardType, query, fromDate, toDate, limit, offset, filter)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.Query(queryStmt, values...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []ProjectSearchRow{}
+	for rows.Next() {
+		var row ProjectSearchRow
+		err := rows.Scan(
+			&row.ProjectCode,
+			&row.ProjectCreatedAt,
+			&row.ProjectName,
+			&row.ProjectStatus,
+			&row.ProjectUpdatedAt,
+			&row.AdminComment,
+			&row.AvgScore,
+			&row.SuspectedDuplicate,
+			&row.ReviseDueAt,
+			&row.ReviseOverdue,
+			pq.Array(&row.Tags),
+			&row.Rank,
+			&row.Count,
+		)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// prepareSearchProjectsQuery matches the Thai-aware full-text document first and falls back to
+// trigram word similarity so typos and partial codes still find a project
+func prepareSearchProjectsQuery(
+	dashboardType string,
+	query string,
+	fromDate, toDate time.Time,
+	limit, offset int,
+	filter AdminDashboardFilter,
+) (string, []any, error) {
+	where := []string{
+		"project.created_at >= $1 AND project.created_at < $2 AND " + adminDashboardStatusFilter(dashboardType),
+		fmt.Sprintf("AND (project_search.document @@ search_query($3) OR word_similarity(lower($3), project_search.search_text) >= %.2f)", SEARCH_SIMILARITY_THRESHOLD),
+	}
+	values := []any{fromDate, toDate, query}
+	where, values, err := appendAdminDashboardFilters(where, values, filter)
+	if err != nil {
+		return "", nil, err
+	}
+	curPlaceholder := len(values) + 1
+	whereStmt := strings.Join(where, " ")
+	orderLimitOffsetStmt := fmt.Sprintf("ORDER BY rank DESC, project.created_at DESC LIMIT $%d OFFSET $%d", curPlaceholder, curPlaceholder+1)
+	values = append(values, limit, offset)
+
+	searchProjectsSQL := fmt.Sprintf(`
+	SELECT
+%s,
+ROUND((ts_rank(project_search.document, search_query($3)) + word_similarity(lower($3), project_search.search_text))::numeric, 4) as rank,
+(
+	SELECT COUNT(*) FROM project
+	INNER JOIN project_history ON project.project_history_id = project_history.id
+	INNER JOIN project_search ON project_search.project_code = project.project_code
+	WHERE %s
+) as count
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+INNER JOIN project_search ON project_search.project_code = project.project_code
+WHERE `, adminDashboardColumnsSQL, whereStmt)
+
+	queryStmt := strings.Join([]string{searchProjectsSQL, whereStmt, orderLimitOffsetStmt}, " ") + ";"
+	return queryStmt, values, nil
+}
@@ -0,0 +1,146 @@
+package projects
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"time"
+)
+
+const REVISE_DEADLINE_JOB_INTERVAL = time.Hour
+
+type ReviseDeadlineConfig struct {
+	ReminderDays  []int
+	OverdueStatus *string
+}
+
+type ReviseReminderCandidate struct {
+	ProjectHistoryId int
+	ProjectCode      string
+	ProjectName      string
+	DueAt            time.Time
+	Email            string
+}
+
+type reviseDeadlineStore interface {
+	GetReviseDeadlineConfig() (ReviseDeadlineConfig, error)
+	GetReviseReminderCandidates(now time.Time, withinDays int) ([]ReviseReminderCandidate, error)
+	ClaimReviseReminder(projectHistoryId int, dueAt time.Time, daysBefore int, now time.Time) (bool, error)
+	ReleaseReviseReminders(projectHistoryId int, dueAt time.Time, daysBefore []int) error
+	SendReviseReminderEmail(candidate ReviseReminderCandidate) error
+	MarkOverdueRevisions(now time.Time, overdueStatus *string) ([]string, error)
+}
+
+// ReviseDeadlineJob reminds applicants before their revise deadline and handles projects past it
+type ReviseDeadlineJob struct {
+	store reviseDeadlineStore
+}
+
+func NewReviseDeadlineJob(s reviseDeadlineStore) *ReviseDeadlineJob {
+	return &ReviseDeadlineJob{store: s}
+}
+
+func (j *ReviseDeadlineJob) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		err := j.RunOnce(time.Now())
+		if err != nil {
+			slog.Error("revise deadline job failed", "error", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *ReviseDeadlineJob) RunOnce(now time.Time) error {
+	config, err := j.store.GetReviseDeadlineConfig()
+	if err != nil {
+		return err
+	}
+
+	days := reminderDays(config.ReminderDays)
+	if len(days) > 0 {
+		candidates, err := j.store.GetReviseReminderCandidates(now, days[len(days)-1])
+		if err != nil {
+			return err
+		}
+		for _, c := range candidates {
+			err := j.remind(c, days, now)
+			if err != nil {
+				slog.Error("failed to send revise reminder", "projectCode", c.ProjectCode, "error", err.Error())
+			}
+		}
+	}
+
+	overdueStatus := config.OverdueStatus
+	if overdueStatus != nil && (PROJECT_STATUS[*overdueStatus] == 0 || *overdueStatus == "Revise") {
+		slog.Warn("revise overdue status is invalid, overdue projects are only flagged", "status", *overdueStatus)
+		overdueStatus = nil
+	}
+	codes, err := j.store.MarkOverdueRevisions(now, overdueStatus)
+	if err != nil {
+		return err
+	}
+	if len(codes) > 0 {
+		slog.Info("revise deadline passed", "projectCodes", codes)
+	}
+	return nil
+}
+
+// remind sends one email for the closest reminder that is due, the reminders it skipped are claimed with it
+// so a deadline set only a day ahead does not trigger the week-before reminder as well.
+// The claims are released again when the email can not be sent, so the next run retries it
+func (j *ReviseDeadlineJob) remind(c ReviseReminderCandidate, days []int, now time.Time) error {
+	remaining := c.DueAt.Sub(now)
+	var due []int
+	for _, d := range days {
+		if remaining <= time.Duration(d)*24*time.Hour {
+			due = append(due, d)
+		}
+	}
+	if len(due) == 0 {
+		return nil
+	}
+	claimed, err := j.store.ClaimReviseReminder(c.ProjectHistoryId, c.DueAt, due[0], now)
+	if err != nil || !claimed {
+		return err
+	}
+	claims := []int{due[0]}
+	for _, d := range due[1:] {
+		claimed, err = j.store.ClaimReviseReminder(c.ProjectHistoryId, c.DueAt, d, now)
+		if err != nil {
+			return j.release(c, claims, err)
+		}
+		if claimed {
+			claims = append(claims, d)
+		}
+	}
+	err = j.store.SendReviseReminderEmail(c)
+	if err != nil {
+		return j.release(c, claims, err)
+	}
+	return nil
+}
+
+func (j *ReviseDeadlineJob) release(c ReviseReminderCandidate, claims []int, cause error) error {
+	err := j.store.ReleaseReviseReminders(c.ProjectHistoryId, c.DueAt, claims)
+	if err != nil {
+		slog.Error("failed to release revise reminder", "projectCode", c.ProjectCode, "error", err.Error())
+	}
+	return cause
+}
+
+func reminderDays(days []int) []int {
+	positive := []int{}
+	for _, d := range days {
+		if d > 0 {
+			positive = append(positive, d)
+		}
+	}
+	sort.Ints(positive)
+	return positive
+}
@@ -0,0 +1,480 @@
+package projects
+
+import (
+	"encoding/xml"
+	"io"
+	"math"
+	"mime/multipart"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const earthRadiusMeters = 6371008.8
+
+// ROUTE_DISTANCE_TOLERANCE is how far a track may be from a declared distance, GPS tracks are rarely exact
+const ROUTE_DISTANCE_TOLERANCE = 0.05
+
+// ROUTE_PREVIEW_MAX_POINTS caps the points kept for drawing a track in the pdf
+const ROUTE_PREVIEW_MAX_POINTS = 500
+
+// ROUTE_TRACK_NAME_MAX_LENGTH is the size of the file_name and track_name columns
+const ROUTE_TRACK_NAME_MAX_LENGTH = 255
+
+const (
+	DISTANCE_CHECK_MATCHED    = "matched"
+	DISTANCE_CHECK_MISMATCH   = "mismatch"
+	DISTANCE_CHECK_UNVERIFIED = "unverified"
+)
+
+type RoutePoint struct {
+	Lat float64
+	Lon float64
+	Ele *float64
+}
+
+type RouteBoundingBox struct {
+	MinLat float64 `json:"minLat"`
+	MinLon float64 `json:"minLon"`
+	MaxLat float64 `json:"maxLat"`
+	MaxLon float64 `json:"maxLon"`
+}
+
+type RouteTrack struct {
+	FileName        string           `json:"fileName"`
+	Name            string           `json:"name"`
+	LengthKm        float64          `json:"lengthKm"`
+	ElevationGainM  *float64         `json:"elevationGainM"`
+	BoundingBox     RouteBoundingBox `json:"boundingBox"`
+	MatchedDistance *string          `json:"matchedDistance"`
+	Mismatch        bool             `json:"mismatch"`
+	// Preview is a thinned copy of the track for the pdf, it is not stored
+	Preview [][]RoutePoint `json:"-"`
+}
+
+type DistanceCheck struct {
+	Type          string   `json:"type"`
+	DeclaredKm    *float64 `json:"declaredKm"`
+	Status        string   `json:"status"`
+	TrackLengthKm *float64 `json:"trackLengthKm"`
+}
+
+// RouteCheck compares uploaded GPX/KML tracks with the declared distances for reviewers
+type RouteCheck struct {
+	Tracks      []RouteTrack    `json:"tracks"`
+	Distances   []DistanceCheck `json:"distances"`
+	HasMismatch bool            `json:"hasMismatch"`
+}
+
+func IsRouteTrackFile(fileName string) bool {
+	ext := strings.ToLower(filepath.Ext(fileName))
+	return ext == ".gpx" || ext == ".kml"
+}
+
+// parseRouteFiles reads every GPX/KML file in routeFiles, images and pdfs are skipped
+func parseRouteFiles(routeFiles []*multipart.FileHeader) ([]RouteTrack, error) {
+	tracks := []RouteTrack{}
+	for _, fileHeader := range routeFiles {
+		if !IsRouteTrackFile(fileHeader.Filename) {
+			continue
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			return nil, err
+		}
+		fileTracks, err := ParseRouteFile(fileHeader.Filename, file)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+		tracks = append(tracks, fileTracks...)
+	}
+	return tracks, nil
+}
+
+// ParseRouteFile returns one RouteTrack per GPX trk/rte or KML Placemark with a line
+func ParseRouteFile(fileName string, r io.Reader) ([]RouteTrack, error) {
+	var segmentsByTrack [][][]RoutePoint
+	var names []string
+	var err error
+	if strings.ToLower(filepath.Ext(fileName)) == ".kml" {
+		names, segmentsByTrack, err = parseKML(r)
+	} else {
+		names, segmentsByTrack, err = parseGPX(r)
+	}
+	if err != nil || !validRoutePoints(segmentsByTrack) {
+		return nil, &RouteFileInvalidError{FileName: fileName}
+	}
+
+	tracks := []RouteTrack{}
+	for i, segments := range segmentsByTrack {
+		track, ok := measureRouteTrack(segments)
+		if !ok {
+			continue
+		}
+		track.FileName = truncateRunes(fileName, ROUTE_TRACK_NAME_MAX_LENGTH)
+		track.Name = truncateRunes(names[i], ROUTE_TRACK_NAME_MAX_LENGTH)
+		tracks = append(tracks, track)
+	}
+	if len(tracks) == 0 {
+		return nil, &RouteFileNoTrackError{FileName: fileName}
+	}
+	return tracks, nil
+}
+
+// validRoutePoints rejects coordinates off the globe and NaN or infinite values, they can not be measured or sent as JSON
+func validRoutePoints(segmentsByTrack [][][]RoutePoint) bool {
+	for _, segments := range segmentsByTrack {
+		for _, segment := range segments {
+			for _, p := range segment {
+				if !isFinite(p.Lat) || !isFinite(p.Lon) || math.Abs(p.Lat) > 90 || math.Abs(p.Lon) > 180 {
+					return false
+				}
+				if p.Ele != nil && !isFinite(*p.Ele) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// truncateRunes cuts s to at most max characters without splitting a character
+func truncateRunes(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max])
+}
+
+type gpxFile struct {
+	Tracks []gpxTrack `xml:"trk"`
+	Routes []gpxRoute `xml:"rte"`
+}
+
+type gpxTrack struct {
+	Name     string       `xml:"name"`
+	Segments []gpxSegment `xml:"trkseg"`
+}
+
+type gpxSegment struct {
+	Points []gpxPoint `xml:"trkpt"`
+}
+
+type gpxRoute struct {
+	Name   string     `xml:"name"`
+	Points []gpxPoint `xml:"rtept"`
+}
+
+type gpxPoint struct {
+	Lat float64  `xml:"lat,attr"`
+	Lon float64  `xml:"lon,attr"`
+	Ele *float64 `xml:"ele"`
+}
+
+func parseGPX(r io.Reader) ([]string, [][][]RoutePoint, error) {
+	var doc gpxFile
+	err := xml.NewDecoder(r).Decode(&doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	toPoints := func(in []gpxPoint) []RoutePoint {
+		out := make([]RoutePoint, 0, len(in))
+		for _, p := range in {
+			out = append(out, RoutePoint{Lat: p.Lat, Lon: p.Lon, Ele: p.Ele})
+		}
+		return out
+	}
+
+	names := []string{}
+	tracks := [][][]RoutePoint{}
+	for _, trk := range doc.Tracks {
+		segments := [][]RoutePoint{}
+		for _, seg := range trk.Segments {
+			segments = append(segments, toPoints(seg.Points))
+		}
+		names = append(names, strings.TrimSpace(trk.Name))
+		tracks = append(tracks, segments)
+	}
+	for _, rte := range doc.Routes {
+		names = append(names, strings.TrimSpace(rte.Name))
+		tracks = append(tracks, [][]RoutePoint{toPoints(rte.Points)})
+	}
+	return names, tracks, nil
+}
+
+// parseKML walks the document so Placemarks nested in any Folder are found,
+// lines come from LineString coordinates ("lon,lat[,alt]") and gx:Track coords ("lon lat [alt]")
+func parseKML(r io.Reader) ([]string, [][][]RoutePoint, error) {
+	decoder := xml.NewDecoder(r)
+	names := []string{}
+	tracks := [][][]RoutePoint{}
+
+	inPlacemark := false
+	var name string
+	var segments [][]RoutePoint
+	var text strings.Builder
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			text.Reset()
+			switch t.Name.Local {
+			case "Placemark":
+				inPlacemark = true
+				name = ""
+				segments = [][]RoutePoint{}
+			case "LineString", "Track":
+				if inPlacemark {
+					segments = append(segments, []RoutePoint{})
+				}
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			if !inPlacemark {
+				continue
+			}
+			switch t.Name.Local {
+			case "name":
+				if name == "" {
+					name = strings.TrimSpace(text.String())
+				}
+			case "coordinates":
+				if len(segments) == 0 {
+					continue
+				}
+				points, err := parseKMLCoordinates(text.String())
+				if err != nil {
+					return nil, nil, err
+				}
+				segments[len(segments)-1] = append(segments[len(segments)-1], points...)
+			case "coord":
+				if len(segments) == 0 {
+					continue
+				}
+				point, err := parseKMLPoint(strings.Fields(text.String()))
+				if err != nil {
+					return nil, nil, err
+				}
+				segments[len(segments)-1] = append(segments[len(segments)-1], point)
+			case "Placemark":
+				inPlacemark = false
+				if len(segments) > 0 {
+					names = append(names, name)
+					tracks = append(tracks, segments)
+				}
+			}
+			text.Reset()
+		}
+	}
+	return names, tracks, nil
+}
+
+func parseKMLCoordinates(raw string) ([]RoutePoint, error) {
+	points := []RoutePoint{}
+	for _, tuple := range strings.Fields(raw) {
+		point, err := parseKMLPoint(strings.Split(tuple, ","))
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, point)
+	}
+	return points, nil
+}
+
+func parseKMLPoint(values []string) (RoutePoint, error) {
+	if len(values) < 2 {
+		return RoutePoint{}, &RouteFileInvalidError{}
+	}
+	lon, err := strconv.ParseFloat(values[0], 64)
+	if err != nil {
+		return RoutePoint{}, err
+	}
+	lat, err := strconv.ParseFloat(values[1], 64)
+	if err != nil {
+		return RoutePoint{}, err
+	}
+	point := RoutePoint{Lat: lat, Lon: lon}
+	if len(values) > 2 {
+		ele, err := strconv.ParseFloat(values[2], 64)
+		if err != nil {
+			return RoutePoint{}, err
+		}
+		point.Ele = &ele
+	}
+	return point, nil
+}
+
+// measureRouteTrack sums each segment on its own so a gap between segments is not counted
+func measureRouteTrack(segments [][]RoutePoint) (RouteTrack, bool) {
+	track := RouteTrack{
+		BoundingBox: RouteBoundingBox{MinLat: 90, MinLon: 180, MaxLat: -90, MaxLon: -180},
+		Preview:     [][]RoutePoint{},
+	}
+	totalPoints := 0
+	for _, segment := range segments {
+		totalPoints += len(segment)
+	}
+	if totalPoints < 2 {
+		return RouteTrack{}, false
+	}
+
+	var meters, gain float64
+	hasElevation := false
+	for _, segment := range segments {
+		for i, p := range segment {
+			track.BoundingBox.MinLat = math.Min(track.BoundingBox.MinLat, p.Lat)
+			track.BoundingBox.MinLon = math.Min(track.BoundingBox.MinLon, p.Lon)
+			track.BoundingBox.MaxLat = math.Max(track.BoundingBox.MaxLat, p.Lat)
+			track.BoundingBox.MaxLon = math.Max(track.BoundingBox.MaxLon, p.Lon)
+			if i == 0 {
+				continue
+			}
+			prev := segment[i-1]
+			meters += haversineMeters(prev, p)
+			if prev.Ele != nil && p.Ele != nil {
+				hasElevation = true
+				if *p.Ele > *prev.Ele {
+					gain += *p.Ele - *prev.Ele
+				}
+			}
+		}
+		track.Preview = append(track.Preview, thinRoutePoints(segment, ROUTE_PREVIEW_MAX_POINTS*len(segment)/totalPoints+2))
+	}
+	track.LengthKm = roundTo(meters/1000, 3)
+	if hasElevation {
+		gain = roundTo(gain, 1)
+		track.ElevationGainM = &gain
+	}
+	return track, true
+}
+
+func haversineMeters(a, b RoutePoint) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (b.Lon - a.Lon) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// thinRoutePoints keeps every nth point plus the last one so the finish stays in place
+func thinRoutePoints(points []RoutePoint, max int) []RoutePoint {
+	if len(points) <= max {
+		return points
+	}
+	step := int(math.Ceil(float64(len(points)) / float64(max)))
+	out := []RoutePoint{}
+	for i := 0; i < len(points); i += step {
+		out = append(out, points[i])
+	}
+	if last := points[len(points)-1]; out[len(out)-1] != last {
+		out = append(out, last)
+	}
+	return out
+}
+
+func roundTo(v float64, places int) float64 {
+	p := math.Pow(10, float64(places))
+	return math.Round(v*p) / p
+}
+
+// distanceKm returns the length a declared distance stands for, ok is false for free text like "iron man"
+func distanceKm(d DistanceAndFee) (km float64, upTo bool, ok bool) {
+	code, length := resolveDistance(d)
+	if code != nil && *code == "fun" && length == nil {
+		return FUN_RUN_MAX_KM, true, true
+	}
+	if length == nil {
+		return 0, false, false
+	}
+	return *length, false, true
+}
+
+func distanceMatches(lengthKm, km float64, upTo bool) bool {
+	if upTo {
+		return lengthKm <= km*(1+ROUTE_DISTANCE_TOLERANCE)
+	}
+	return math.Abs(lengthKm-km) <= km*ROUTE_DISTANCE_TOLERANCE
+}
+
+// CheckRouteDistances matches every track to the closest declared distance within the tolerance,
+// a track matching nothing or a declared distance no track matches is flagged for reviewers
+func CheckRouteDistances(distances []DistanceAndFee, tracks []RouteTrack) RouteCheck {
+	check := RouteCheck{Tracks: []RouteTrack{}, Distances: []DistanceCheck{}}
+	for _, d := range distances {
+		if !d.Checked {
+			continue
+		}
+		dc := DistanceCheck{Type: d.Type, Status: DISTANCE_CHECK_UNVERIFIED}
+		if km, _, ok := distanceKm(d); ok {
+			dc.DeclaredKm = &km
+		}
+		check.Distances = append(check.Distances, dc)
+	}
+
+	for _, track := range tracks {
+		track.MatchedDistance = nil
+		track.Mismatch = false
+		best := -1
+		bestDiff := math.MaxFloat64
+		for i, d := range distances {
+			if !d.Checked {
+				continue
+			}
+			km, upTo, ok := distanceKm(d)
+			if !ok || !distanceMatches(track.LengthKm, km, upTo) {
+				continue
+			}
+			diff := math.Abs(track.LengthKm - km)
+			// an exact distance is a better match than the open ended fun run
+			if upTo {
+				diff = math.MaxFloat64 / 2
+			}
+			if diff < bestDiff {
+				best = i
+				bestDiff = diff
+			}
+		}
+		if best == -1 {
+			track.Mismatch = true
+			check.HasMismatch = true
+		} else {
+			matched := distances[best].Type
+			track.MatchedDistance = &matched
+			for i := range check.Distances {
+				if check.Distances[i].Type == matched && check.Distances[i].Status != DISTANCE_CHECK_MATCHED {
+					length := track.LengthKm
+					check.Distances[i].Status = DISTANCE_CHECK_MATCHED
+					check.Distances[i].TrackLengthKm = &length
+					break
+				}
+			}
+		}
+		check.Tracks = append(check.Tracks, track)
+	}
+
+	// without any track there is nothing to compare, the distances stay unverified
+	if len(tracks) == 0 {
+		return check
+	}
+	for i := range check.Distances {
+		if check.Distances[i].Status != DISTANCE_CHECK_MATCHED && check.Distances[i].DeclaredKm != nil {
+			check.Distances[i].Status = DISTANCE_CHECK_MISMATCH
+			check.HasMismatch = true
+		}
+	}
+	return check
+}
@@ -9,11 +9,14 @@ review.id as review_id, review.created_at as reviewed_at
 FROM project
 INNER JOIN project_history
 ON project.project_history_id = project_history.id
+INNER JOIN funding_round
+ON project.funding_round_id = funding_round.id
 LEFT JOIN review
 ON project.project_history_id = review.project_history_id AND review.user_id = $1
-WHERE project.created_at >= $2
-AND project.created_at < $3
+WHERE funding_round.review_from_date <= $2
+AND funding_round.review_to_date > $2
 AND project_history.status = 'Reviewing'
+AND ($3::int IS NULL OR project.funding_round_id = $3)
 ORDER BY project_name;
 `
 const getReviewerProjectDetailsSQL = `
@@ -78,21 +81,32 @@ WHERE review_details.review_id = $1
 ORDER BY criteria_order_number ASC;
 `
 
-const countProjectCreatedToday = `
-SELECT count(*) FROM project 
-WHERE created_at >= date_trunc('day', now()) + interval '- 7 hour' 
-AND created_at < date_trunc('day', now()) + interval '1 day - 7 hour - 1 microsecond';
+// The first allocation of a day is seeded from the projects already created that day,
+// later ones bump the counter. The counter row stays locked until the transaction ends.
+// $2 and $3 are the start of the local day and of the next one.
+const nextProjectCodeSequenceSQL = `
+INSERT INTO project_code_counter (code_date, last_value)
+SELECT $1::date, count(*) + 1 FROM project
+WHERE created_at >= $2 AND created_at < $3
+ON CONFLICT (code_date) DO UPDATE SET last_value = project_code_counter.last_value + 1
+RETURNING last_value;
 `
 
 const getApplicantCriteriaSQL = `
-SELECT id, criteria_version, order_number, display
-FROM applicant_criteria WHERE criteria_version = $1 AND code = 'project_self_score' 
-ORDER BY order_number ASC;
+SELECT applicant_criteria.id, applicant_criteria.criteria_version, applicant_criteria.order_number, applicant_criteria.display
+FROM applicant_criteria
+INNER JOIN applicant_criteria_version ON applicant_criteria.criteria_version = applicant_criteria_version.criteria_version
+WHERE applicant_criteria.criteria_version = $1 AND applicant_criteria.code = 'project_self_score'
+AND applicant_criteria_version.status = 'published'
+ORDER BY applicant_criteria.order_number ASC;
 `
 const getApplicantCriteriaPdfSQL = `
-SELECT id, criteria_version, order_number, pdf_display
-FROM applicant_criteria WHERE criteria_version = $1 AND code = 'project_self_score' 
-ORDER BY order_number ASC;
+SELECT applicant_criteria.id, applicant_criteria.criteria_version, applicant_criteria.order_number, applicant_criteria.pdf_display
+FROM applicant_criteria
+INNER JOIN applicant_criteria_version ON applicant_criteria.criteria_version = applicant_criteria_version.criteria_version
+WHERE applicant_criteria.criteria_version = $1 AND applicant_criteria.code = 'project_self_score'
+AND applicant_criteria_version.status = 'published'
+ORDER BY applicant_criteria.order_number ASC;
 `
 
 const addAddressSQL = `
@@ -113,8 +127,8 @@ VALUES ($1, $2, $3) RETURNING id;
 
 const addProjectSQL = `
 INSERT INTO project
-(project_code, created_at, project_history_id, user_id)
-VALUES ($1, $2, $3, $4) RETURNING id;
+(project_code, created_at, project_history_id, user_id, funding_round_id)
+VALUES ($1, $2, $3, $4, $5) RETURNING id;
 `
 
 const addProjectHistorySQL = `
@@ -224,6 +238,7 @@ INSERT INTO project_history
 	fund_req_other,
 	fund_req_other_type,
 	no_alcohol_sponsor,
+	organizer_public_consent,
 	files_prefix
 ) VALUES (
 	$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, 
@@ -231,12 +246,16 @@ INSERT INTO project_history
 	$39, $40, $41, $42, $43, $44, $45, $46, $47, $48, $49, $50, $51, $52, $53, $54, $55, $56, 
 	$57, $58, $59, $60, $61, $62, $63, $64, $65, $66, $67, $68, $69, $70, $71, $72, $73, $74, 
 	$75, $76, $77, $78, $79, $80, $81, $82, $83, $84, $85, $86, $87, $88, $89, $90, $91, $92, 
-	$93, $94, $95, $96, $97, $98, $99, $100, $101, $102, $103, $104, $105
+	$93, $94, $95, $96, $97, $98, $99, $100, $101, $102, $103, $104, $105, $106
 ) RETURNING id;
 `
 
 const addManyDistanceSQL = `
-INSERT INTO distance (type, fee, is_dynamic, project_history_id) VALUES 
+INSERT INTO distance (type, fee, is_dynamic, project_history_id, catalogue_code, km) VALUES 
+`
+
+const addManyBudgetItemSQL = `
+INSERT INTO budget_item (project_history_id, order_number, category, description, quantity, unit_cost, funding_source) VALUES 
 `
 
 const addManyApplicantScoreSQL = `
@@ -328,7 +347,8 @@ project_history.admin_score as admin_score,
 project_history.fund_approved_amount as fund_approved_amount,
 project_history.admin_comment as admin_comment,
 project_history.admin_approved_at as admin_approved_at,
-project_history.updated_at as updated_at
+project_history.updated_at as updated_at,
+project_history.revise_due_at as revise_due_at
 FROM project
 INNER JOIN project_history ON project.project_history_id = project_history.id
 WHERE project.project_code = $1;
@@ -342,17 +362,50 @@ admin_score = $3,
 fund_approved_amount = $4,
 admin_comment = $5,
 admin_approved_at = $6,
-updated_at = $7
+updated_at = $7,
+revise_due_at = $8,
+revise_overdue_at = CASE WHEN revise_due_at IS DISTINCT FROM $8 THEN NULL ELSE revise_overdue_at END
 WHERE project_history.id = $1 RETURNING id;
 `
 
+const withdrawProjectSQL = `
+UPDATE project_history
+SET
+status = 'Withdrawn',
+withdraw_reason = $2,
+withdrawn_at = $3,
+updated_at = $3
+WHERE project_history.id = $1 AND project_history.status IN ('Reviewing', 'Reviewed', 'Revise')
+RETURNING id;
+`
+
+const cancelProjectSQL = `
+UPDATE project_history
+SET
+status = 'Cancelled',
+cancel_reason = $2,
+cancelled_at = $3,
+updated_at = $3
+WHERE project_history.id = $1 AND project_history.status IN ('Approved', 'Start')
+RETURNING id;
+`
+
 const getAdminSummarySQL = `
 SELECT 
 project_history.status,
 COUNT(*) as count,
-SUM(project_history.fund_approved_amount)
+SUM(project_history.fund_approved_amount),
+SUM(paid.net_paid_amount)
 FROM project INNER JOIN project_history ON project.project_history_id = project_history.id
+LEFT JOIN (
+	SELECT project_code,
+	SUM(CASE WHEN entry_type = 'Refund' THEN -amount ELSE amount END) as net_paid_amount
+	FROM disbursement
+	WHERE status = 'Paid'
+	GROUP BY project_code
+) paid ON paid.project_code = project.project_code
 WHERE project.created_at >= $1 AND project.created_at < $2
+AND ($3::int IS NULL OR project.funding_round_id = $3)
 GROUP BY project_history.status;
 `
 
@@ -362,9 +415,629 @@ project_history.project_code as project_code,
 project_history.project_name as project_name,
 project.created_at as created_at,
 project_history.from_date as from_date,
-project_history.fund_approved_amount as fund_approved_amount
+project_history.fund_approved_amount as fund_approved_amount,
+COALESCE((
+	SELECT string_agg(project_tag.tag, ', ' ORDER BY project_tag.tag) FROM project_tag
+	WHERE project_tag.project_code = project.project_code
+), '') as tags
 FROM project
 INNER JOIN project_history ON project.project_history_id = project_history.id
 WHERE project.created_at >= $1 AND project.created_at < $2
+AND ($3::int IS NULL OR project.funding_round_id = $3)
 ;
 `
+
+const getLatestProjectHistoryIdSQL = `
+SELECT project.project_history_id, project.user_id
+FROM project
+WHERE project.project_code = $1;
+`
+
+const getProjectHistoryIdByVersionSQL = `
+SELECT project_history.id, project.user_id
+FROM project_history
+INNER JOIN project ON project.project_code = project_history.project_code
+WHERE project_history.project_code = $1 AND project_history.project_version = $2;
+`
+
+const getProjectVersionsSQL = `
+SELECT project_version FROM project_history
+WHERE project_code = $1
+ORDER BY project_version ASC;
+`
+
+const getProjectHistoryFullDetailsSQL = `
+SELECT
+	project_code,
+	project_version,
+	created_at,
+	updated_at,
+	status,
+	collaborated,
+	project_name,
+	from_date,
+	to_date,
+	address_id,
+	start_point,
+	finish_point,
+	cat_road_race,
+	cat_trail_running,
+	cat_has_other,
+	cat_other_type,
+	vip,
+	vip_fee,
+	expected_participants,
+	has_organizer,
+	organizer_name,
+	organizer_public_consent,
+	project_head_contact_id,
+	project_manager_contact_id,
+	project_coordinator_contact_id,
+	project_race_director_contact_id,
+	organization_type,
+	organization_name,
+	background,
+	objective,
+	mkt_has_facebook,
+	mkt_facebook,
+	mkt_has_website,
+	mkt_website,
+	mkt_use_online_page,
+	mkt_online_page,
+	mkt_use_other_online_marketing,
+	mkt_other_online_marketing,
+	mkt_pr,
+	mkt_local_official,
+	mkt_booth,
+	mkt_billboard,
+	mkt_tv,
+	mkt_use_other_offline_marketing,
+	mkt_other_offline_marketing,
+	st_runner_info,
+	st_health_decider,
+	st_ambulance,
+	st_first_aid,
+	st_aed,
+	st_aed_count,
+	st_volunteer_doctor,
+	st_insurance,
+	st_other,
+	st_addition,
+	measure_athletics_association,
+	measure_calibrated_bicycle,
+	measure_self_measurement,
+	measure_self_tool,
+	traffic_ask_permission,
+	traffic_has_supporter,
+	traffic_road_closure,
+	traffic_signs,
+	traffic_lighting,
+	judge_type,
+	judge_other_type,
+	support_provincial_admin,
+	support_safety,
+	support_health,
+	support_volunteer,
+	support_community,
+	support_other,
+	support_addition,
+	feedback,
+	exp_this_first_time,
+	exp_this_ordinal_number,
+	exp_this_latest_date,
+	exp_this_completed1_year,
+	exp_this_completed1_participant,
+	exp_this_completed2_year,
+	exp_this_completed2_participant,
+	exp_this_completed3_year,
+	exp_this_completed3_participant,
+	exp_other_done_before,
+	exp_other_completed1_year,
+	exp_other_completed1_name,
+	exp_other_completed1_participant,
+	exp_other_completed2_year,
+	exp_other_completed2_name,
+	exp_other_completed2_participant,
+	exp_other_completed3_year,
+	exp_other_completed3_name,
+	exp_other_completed3_participant,
+	fund_total,
+	fund_support_organization,
+	fund_req_fund,
+	fund_req_fund_amount,
+	fund_req_bib,
+	fund_req_bib_amount,
+	fund_req_pr,
+	fund_req_seminar,
+	fund_req_seminar_topic,
+	fund_req_other,
+	fund_req_other_type,
+	no_alcohol_sponsor
+FROM project_history
+WHERE project_history.id = $1;
+`
+
+const getAddressWithAreaIdsSQL = `
+SELECT address.address, address.postcode_id, subdistrict.id, district.id, province.id
+FROM address
+INNER JOIN postcode ON address.postcode_id = postcode.id
+INNER JOIN subdistrict ON postcode.subdistrict_id = subdistrict.id
+INNER JOIN district ON subdistrict.district_id = district.id
+INNER JOIN province ON district.province_id = province.id
+WHERE address.id = $1;
+`
+
+const getContactByIdSQL = `
+SELECT prefix, first_name, last_name, organization_position, event_position, address_id, email, line_id, phone_number
+FROM contact
+WHERE contact.id = $1;
+`
+
+const getDistancesByProjectHistoryIdSQL = `
+SELECT type, fee, is_dynamic, catalogue_code, km FROM distance
+WHERE project_history_id = $1
+ORDER BY id ASC;
+`
+
+const addRouteTrackSQL = `
+INSERT INTO project_route_track (project_history_id, file_name, track_name, length_km, elevation_gain_m, min_lat, min_lon, max_lat, max_lon)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+`
+
+const getRouteTracksByProjectHistoryIdSQL = `
+SELECT file_name, track_name, length_km, elevation_gain_m, min_lat, min_lon, max_lat, max_lon FROM project_route_track
+WHERE project_history_id = $1
+ORDER BY id ASC;
+`
+
+const getBudgetItemsByProjectHistoryIdSQL = `
+SELECT category, description, quantity, unit_cost, funding_source FROM budget_item
+WHERE project_history_id = $1
+ORDER BY order_number ASC;
+`
+
+const getApplicantScoresByProjectHistoryIdSQL = `
+SELECT applicant_criteria.criteria_version, applicant_criteria.order_number, applicant_score.score
+FROM applicant_score
+INNER JOIN applicant_criteria ON applicant_score.applicant_criteria_id = applicant_criteria.id
+WHERE applicant_score.project_history_id = $1
+ORDER BY applicant_criteria.order_number ASC;
+`
+
+const eligibilityRuleColumns = `id, rule_type, params, message, funding_round_id, enabled, created_at, updated_at`
+
+const getEnabledEligibilityRulesSQL = `SELECT ` + eligibilityRuleColumns + ` FROM eligibility_rule
+WHERE enabled = TRUE AND (funding_round_id IS NULL OR funding_round_id = $1)
+ORDER BY id ASC;
+`
+
+const getAllEligibilityRulesSQL = `SELECT ` + eligibilityRuleColumns + ` FROM eligibility_rule ORDER BY id ASC;`
+
+const addEligibilityRuleSQL = `
+INSERT INTO eligibility_rule (rule_type, params, message, funding_round_id, enabled, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $6) RETURNING id;
+`
+
+const updateEligibilityRuleSQL = `
+UPDATE eligibility_rule
+SET rule_type = $2, params = $3, message = $4, funding_round_id = $5, enabled = $6, updated_at = $7
+WHERE id = $1 RETURNING id;
+`
+
+const countActiveOrganizationProposalsSQL = `
+SELECT COUNT(*) FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+WHERE project.funding_round_id = $1
+AND LOWER(TRIM(project_history.organization_name)) = LOWER(TRIM($2))
+AND project_history.status NOT IN ('NotApproved', 'Withdrawn', 'Cancelled', 'ClosedAsDuplicate');
+`
+
+const getDuplicateCandidatesSQL = `
+SELECT
+project.project_code,
+project_history.project_name,
+project_history.from_date,
+province.id,
+district.id,
+COALESCE(project_history.organizer_name, ''),
+COALESCE(project_history.organization_name, '')
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+LEFT JOIN address ON project_history.address_id = address.id
+LEFT JOIN postcode ON address.postcode_id = postcode.id
+LEFT JOIN subdistrict ON postcode.subdistrict_id = subdistrict.id
+LEFT JOIN district ON subdistrict.district_id = district.id
+LEFT JOIN province ON district.province_id = province.id
+WHERE project.project_code <> $1
+AND project_history.from_date >= $2 AND project_history.from_date < $3
+AND project_history.status NOT IN ('Withdrawn', 'Cancelled', 'ClosedAsDuplicate');
+`
+
+const addProjectDuplicateSQL = `
+INSERT INTO project_duplicate (project_code, duplicate_of_project_code, score, reasons, status, created_at)
+VALUES ($1, $2, $3, $4, 'Suspected', $5)
+ON CONFLICT (project_code, duplicate_of_project_code) DO NOTHING;
+`
+
+const getProjectDuplicatesSQL = `
+SELECT
+project_duplicate.id,
+project_duplicate.project_code,
+project_duplicate.duplicate_of_project_code,
+project_history.project_name,
+project_history.status,
+project_duplicate.score,
+project_duplicate.reasons,
+project_duplicate.status,
+project_duplicate.resolved_by,
+project_duplicate.resolved_at,
+project_duplicate.created_at
+FROM project_duplicate
+INNER JOIN project ON project.project_code = (
+	CASE WHEN project_duplicate.project_code = $1
+	THEN project_duplicate.duplicate_of_project_code
+	ELSE project_duplicate.project_code END
+)
+INNER JOIN project_history ON project.project_history_id = project_history.id
+WHERE project_duplicate.project_code = $1 OR project_duplicate.duplicate_of_project_code = $1
+ORDER BY project_duplicate.created_at DESC;
+`
+
+const getProjectDuplicateForUpdateSQL = `
+SELECT
+project_duplicate.project_code,
+project_duplicate.duplicate_of_project_code,
+project_duplicate.status,
+project.project_history_id,
+project.user_id
+FROM project_duplicate
+INNER JOIN project ON project.project_code = project_duplicate.project_code
+WHERE project_duplicate.id = $1 FOR UPDATE OF project_duplicate;
+`
+
+const resolveProjectDuplicateSQL = `
+UPDATE project_duplicate
+SET status = $2, resolved_by = $3, resolved_at = $4
+WHERE id = $1 AND status = 'Suspected' RETURNING id;
+`
+
+const closeProjectAsDuplicateSQL = `
+UPDATE project_history
+SET
+status = 'ClosedAsDuplicate',
+updated_at = $2
+WHERE project_history.id = $1 AND project_history.status IN ('Reviewing', 'Reviewed', 'Revise')
+RETURNING id;
+`
+
+const getDuplicateMergeTargetForUpdateSQL = `
+SELECT project.project_history_id, project.user_id, project_history.status
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+WHERE project.project_code = $1 FOR UPDATE OF project_history;
+`
+
+// a reviewer who already reviewed the surviving project keeps the review on the merged one
+const moveDuplicateReviewsSQL = `
+UPDATE review SET project_history_id = $2
+WHERE review.project_history_id = $1
+AND review.user_id NOT IN (SELECT survivor.user_id FROM review survivor WHERE survivor.project_history_id = $2);
+`
+
+const refreshProjectReviewScoreSQL = `
+UPDATE project_history
+SET
+avg_review_score = (
+	SELECT ROUND(AVG(sum_score), 2) FROM (
+		SELECT SUM(review_details.score) as sum_score
+		FROM review
+		INNER JOIN review_details ON review.id = review_details.review_id
+		WHERE review.project_history_id = $1
+		GROUP BY review.id
+	) per_review
+),
+review_count = (SELECT COUNT(*) FROM review WHERE review.project_history_id = $1)
+WHERE project_history.id = $1;
+`
+
+const moveDuplicateNotesSQL = `UPDATE project_note SET project_code = $2 WHERE project_code = $1;`
+
+const moveDuplicateTagsSQL = `
+WITH moved AS (
+	DELETE FROM project_tag WHERE project_code = $1 RETURNING tag, created_by, created_at
+)
+INSERT INTO project_tag (project_code, tag, created_by, created_at)
+SELECT $2, tag, created_by, created_at FROM moved
+ON CONFLICT (project_code, tag) DO NOTHING;
+`
+
+// attachment paths are relative to the project owner's folder, $2 is where the merged files are copied to
+const moveDuplicateMessageAttachmentsSQL = `
+UPDATE project_message_attachment SET path = $2 || project_message_attachment.path
+FROM project_message
+WHERE project_message_attachment.message_id = project_message.id AND project_message.project_code = $1;
+`
+
+const moveDuplicateMessagesSQL = `UPDATE project_message SET project_code = $2 WHERE project_code = $1;`
+
+const refreshProjectSearchSQL = `SELECT refresh_project_search($1);`
+
+const getProjectsForAdminUpdateByProjectCodesSQL = `
+SELECT
+project.project_code,
+project_history.id as project_history_id,
+project_history.status as project_status,
+project_history.fund_approved_amount as fund_approved_amount,
+project_history.admin_comment as admin_comment,
+project_history.admin_approved_at as admin_approved_at,
+project_history.updated_at as updated_at
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+WHERE project.project_code = ANY($1);
+`
+
+const bulkUpdateProjectByAdminSQL = `
+UPDATE project_history
+SET
+status = $3,
+fund_approved_amount = $4,
+admin_comment = $5,
+admin_approved_at = $6,
+updated_at = $7,
+revise_due_at = CASE WHEN $3 = 'Revise' THEN revise_due_at ELSE NULL END
+WHERE project_history.id = $1 AND project_history.updated_at = $2 RETURNING id;
+`
+
+const getProjectNotesSQL = `
+SELECT
+project_note.id,
+project_note.project_code,
+project_note.parent_id,
+project_note.body,
+project_note.created_by,
+users.first_name || ' ' || users.last_name as created_by_name,
+project_note.created_at
+FROM project_note
+INNER JOIN users ON project_note.created_by = users.id
+WHERE project_note.project_code = $1
+ORDER BY project_note.created_at ASC, project_note.id ASC;
+`
+
+const getProjectNoteThreadIdSQL = `
+SELECT COALESCE(parent_id, id) FROM project_note WHERE id = $1 AND project_code = $2;
+`
+
+const addProjectNoteSQL = `
+INSERT INTO project_note (project_code, parent_id, body, created_by, created_at)
+SELECT project.project_code, $2, $3, $4, $5 FROM project WHERE project.project_code = $1
+RETURNING id;
+`
+
+const getProjectTagsSQL = `
+SELECT tag FROM project_tag WHERE project_code = $1 ORDER BY tag;
+`
+
+const lockProjectByCodeSQL = `
+SELECT id FROM project WHERE project_code = $1 FOR UPDATE;
+`
+
+const removeProjectTagsSQL = `
+DELETE FROM project_tag WHERE project_code = $1 AND tag <> ALL($2);
+`
+
+const addProjectTagSQL = `
+INSERT INTO project_tag (project_code, tag, created_by, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (project_code, tag) DO NOTHING;
+`
+
+const getAllProjectTagsSQL = `
+SELECT tag, COUNT(*) FROM project_tag GROUP BY tag ORDER BY tag;
+`
+
+const getProjectOwnerSQL = `
+SELECT project.user_id, project_history.project_name
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+WHERE project.project_code = $1;
+`
+
+const getProjectMessagesSQL = `
+SELECT
+project_message.id,
+project_message.project_code,
+project_message.sender_id,
+project_message.sender_role,
+users.first_name || ' ' || users.last_name as sender_name,
+project_message.body,
+project_message.created_at,
+project_message.read_by,
+project_message.read_at
+FROM project_message
+INNER JOIN users ON project_message.sender_id = users.id
+WHERE project_message.project_code = $1
+ORDER BY project_message.created_at ASC, project_message.id ASC;
+`
+
+const getProjectMessageAttachmentsSQL = `
+SELECT
+project_message_attachment.message_id,
+project_message_attachment.id,
+project_message_attachment.file_name,
+project_message_attachment.path
+FROM project_message_attachment
+INNER JOIN project_message ON project_message_attachment.message_id = project_message.id
+WHERE project_message.project_code = $1
+ORDER BY project_message_attachment.id ASC;
+`
+
+const addProjectMessageSQL = `
+INSERT INTO project_message (project_code, sender_id, sender_role, body, created_at)
+VALUES ($1, $2, $3, $4, $5) RETURNING id;
+`
+
+const addProjectMessageAttachmentSQL = `
+INSERT INTO project_message_attachment (message_id, file_name, path)
+VALUES ($1, $2, $3);
+`
+
+const markProjectMessagesReadSQL = `
+UPDATE project_message
+SET read_by = $3, read_at = $4
+WHERE project_code = $1 AND sender_role <> $2 AND read_at IS NULL;
+`
+
+const getActiveAdminEmailsSQL = `
+SELECT email FROM users WHERE user_role = 'admin' AND activated = true;
+`
+
+const getUserEmailByIdSQL = `
+SELECT email FROM users WHERE id = $1;
+`
+
+const getReviseDeadlineConfigSQL = `
+SELECT revise_reminder_days, revise_overdue_status FROM operation_config ORDER BY id DESC LIMIT 1;
+`
+
+const getReviseReminderCandidatesSQL = `
+SELECT
+project_history.id,
+project.project_code,
+project_history.project_name,
+project_history.revise_due_at,
+users.email
+FROM project
+INNER JOIN project_history ON project.project_history_id = project_history.id
+INNER JOIN users ON project.user_id = users.id
+WHERE project_history.status = 'Revise'
+AND project_history.revise_due_at > $1
+AND project_history.revise_due_at <= $1 + make_interval(days => $2);
+`
+
+const claimReviseReminderSQL = `
+INSERT INTO project_revise_reminder (project_history_id, due_at, days_before, sent_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (project_history_id, due_at, days_before) DO NOTHING
+RETURNING project_history_id;
+`
+
+const releaseReviseRemindersSQL = `
+DELETE FROM project_revise_reminder
+WHERE project_history_id = $1 AND due_at = $2 AND days_before = ANY($3);
+`
+
+const markReviseOverdueSQL = `
+UPDATE project_history
+SET
+revise_overdue_at = $1,
+status = COALESCE($2::varchar, status),
+updated_at = CASE WHEN $2::varchar IS NULL THEN updated_at ELSE $1 END
+WHERE project_history.status = 'Revise'
+AND project_history.revise_due_at <= $1
+AND project_history.revise_overdue_at IS NULL
+AND project_history.id IN (SELECT project.project_history_id FROM project)
+RETURNING project_history.project_code;
+`
+
+const getDistanceCatalogueSQL = `
+SELECT code, name, km, km_required, order_number FROM distance_catalogue
+ORDER BY order_number ASC;
+`
+
+const getUnmappedDistanceTypesSQL = `
+SELECT type, COUNT(*) FROM distance
+WHERE catalogue_code IS NULL
+GROUP BY type
+ORDER BY COUNT(*) DESC, type ASC;
+`
+
+const mapDistanceTypeSQL = `
+UPDATE distance SET catalogue_code = $1, km = $2
+WHERE catalogue_code IS NULL AND type = $3;
+`
+
+const distanceFeeAnalyticsWhereSQL = `
+WHERE ($1::int IS NULL OR EXTRACT(YEAR FROM project_history.from_date AT TIME ZONE $3) = $1)
+AND (cardinality($2::text[]) = 0 OR project_history.status = ANY($2))
+`
+
+const getDistanceFeeAnalyticsSQL = `
+SELECT
+distance_catalogue.code,
+distance_catalogue.name,
+COUNT(*),
+MIN(distance.fee),
+MAX(distance.fee),
+AVG(distance.fee),
+percentile_cont(0.5) WITHIN GROUP (ORDER BY distance.fee)
+FROM distance_catalogue
+INNER JOIN distance ON distance.catalogue_code = distance_catalogue.code
+INNER JOIN project ON distance.project_history_id = project.project_history_id
+INNER JOIN project_history ON project.project_history_id = project_history.id` +
+	distanceFeeAnalyticsWhereSQL + `
+GROUP BY distance_catalogue.code
+ORDER BY distance_catalogue.order_number ASC;
+`
+
+const countUnmappedDistanceFeesSQL = `
+SELECT COUNT(*)
+FROM distance
+INNER JOIN project ON distance.project_history_id = project.project_history_id
+INNER JOIN project_history ON project.project_history_id = project_history.id` +
+	distanceFeeAnalyticsWhereSQL + `AND distance.catalogue_code IS NULL;
+`
+
+const getApplicantCriteriaVersionsSQL = `
+SELECT
+applicant_criteria_version.criteria_version,
+applicant_criteria_version.status,
+(SELECT COUNT(*) FROM applicant_criteria WHERE applicant_criteria.criteria_version = applicant_criteria_version.criteria_version),
+(SELECT COUNT(*) FROM funding_round WHERE funding_round.applicant_criteria_version = applicant_criteria_version.criteria_version),
+applicant_criteria_version.created_at,
+applicant_criteria_version.updated_at,
+applicant_criteria_version.published_at
+FROM applicant_criteria_version
+ORDER BY applicant_criteria_version.criteria_version DESC;
+`
+
+const getApplicantCriteriaVersionSQL = `
+SELECT criteria_version, status, created_at, updated_at, published_at
+FROM applicant_criteria_version WHERE criteria_version = $1;
+`
+
+const getApplicantCriteriaVersionForUpdateSQL = `
+SELECT status FROM applicant_criteria_version WHERE criteria_version = $1 FOR UPDATE;
+`
+
+const getApplicantCriteriaPreviewSQL = `
+SELECT id, criteria_version, order_number, display, pdf_display
+FROM applicant_criteria WHERE criteria_version = $1 AND code = 'project_self_score'
+ORDER BY order_number ASC;
+`
+
+const addApplicantCriteriaVersionSQL = `
+INSERT INTO applicant_criteria_version (criteria_version, status, created_at, updated_at)
+SELECT COALESCE(MAX(criteria_version), 0) + 1, 'draft', $1, $1 FROM applicant_criteria_version
+RETURNING criteria_version;
+`
+
+const addManyApplicantCriteriaSQL = `
+INSERT INTO applicant_criteria (code, criteria_version, order_number, display, pdf_display) VALUES
+`
+
+const deleteApplicantCriteriaItemsSQL = `
+DELETE FROM applicant_criteria WHERE criteria_version = $1;
+`
+
+const touchApplicantCriteriaVersionSQL = `
+UPDATE applicant_criteria_version SET updated_at = $2 WHERE criteria_version = $1;
+`
+
+const deleteApplicantCriteriaVersionSQL = `
+DELETE FROM applicant_criteria_version WHERE criteria_version = $1;
+`
+
+const publishApplicantCriteriaVersionSQL = `
+UPDATE applicant_criteria_version SET status = 'published', updated_at = $2, published_at = $2
+WHERE criteria_version = $1;
+`
@@ -2,11 +2,33 @@ package projects
 
 import (
 	"mime/multipart"
+	"strings"
 	"time"
 	"unicode/utf8"
+
+	"github.com/poomipat-k/running-fund/pkg/utils"
 )
 
 const ADMIN_COMMENT_MAX_LENGTH = 512
+const BULK_UPDATE_MAX_PROJECTS = 200
+const STATUS_REASON_MAX_LENGTH = 512
+
+const (
+	PROJECT_NOTE_MAX_LENGTH = 4000
+	PROJECT_TAG_MAX_LENGTH  = 64
+	PROJECT_TAGS_MAX        = 20
+)
+
+// expectedParticipantsOptions are the buckets of general.expectedParticipants in the form schema
+var expectedParticipantsOptions = map[string]bool{
+	"<=500":     true,
+	"501-1500":  true,
+	"1501-2500": true,
+	"2501-3500": true,
+	"3501-4500": true,
+	"4501-5500": true,
+	">=5501":    true,
+}
 
 var thirtyDaysMonth = map[int]int{
 	4:  30,
@@ -25,17 +47,51 @@ var PROJECT_STATUS = map[string]int{
 	"Completed":   7,
 }
 
-var sortByWhiteList = map[string]bool{
-	"project_history.project_name": true,
-	"project_history.created_at":   true,
-	"project_history.updated_at":   true,
-	"project_history.status":       true,
-	// "POSITION(project_history.status::text IN 'Reviewing,Reviewed,Revise,NotApproved,Approved')": true, // move NotApproved to started table (bottom table)
-	"POSITION(project_history.status::text IN 'Reviewing,Reviewed,Revise,Approved')": true,
-	// "POSITION(project_history.status::text IN 'Start,Completed')":        true,
-	"POSITION(project_history.status::text IN 'Start,Completed,NotApproved')": true,
+// STATUS_TRANSITION lists the statuses a project can move to from its current one.
+// Admin updates, single or bulk, move between the PROJECT_STATUS statuses, a started project can not
+// go back before approval. Applicant can withdraw a project only before the admin has made a decision
+// and admin can cancel it only after approval. Closed statuses have no way out.
+var STATUS_TRANSITION = map[string]map[string]bool{
+	"Reviewing":         statusSet("Reviewed", "Revise", "NotApproved", "Approved", "Start", "Completed", "Withdrawn"),
+	"Reviewed":          statusSet("Reviewing", "Revise", "NotApproved", "Approved", "Start", "Completed", "Withdrawn"),
+	"Revise":            statusSet("Reviewing", "Reviewed", "NotApproved", "Approved", "Start", "Completed", "Withdrawn"),
+	"NotApproved":       statusSet("Reviewing", "Reviewed", "Revise", "Approved", "Start", "Completed"),
+	"Approved":          statusSet("Reviewing", "Reviewed", "Revise", "NotApproved", "Start", "Completed", "Cancelled"),
+	"Start":             statusSet("Approved", "Completed", "Cancelled"),
+	"Completed":         statusSet("Start"),
+	"Withdrawn":         statusSet(),
+	"Cancelled":         statusSet(),
+	"ClosedAsDuplicate": statusSet(),
+}
+
+func statusSet(statuses ...string) map[string]bool {
+	set := map[string]bool{}
+	for _, status := range statuses {
+		set[status] = true
+	}
+	return set
+}
+
+func canChangeStatus(from, to string) bool {
+	return STATUS_TRANSITION[from][to]
 }
 
+// Projects in these statuses can no longer be updated by admin
+var CLOSED_STATUS = map[string]bool{
+	"Withdrawn":         true,
+	"Cancelled":         true,
+	"ClosedAsDuplicate": true,
+}
+
+const (
+	SEARCH_QUERY_MIN_LENGTH     = 2
+	SEARCH_QUERY_MAX_LENGTH     = 255
+	SEARCH_SIMILARITY_THRESHOLD = 0.4
+	DASHBOARD_FILTER_MAX_IDS    = 100
+	DASHBOARD_SORT_MAX_KEYS     = 4
+	DASHBOARD_PAGE_SIZE_MAX     = 200
+)
+
 var PRIMARY_STATUS = map[string]bool{
 	"CurrentBeforeApprove": true,
 	"Approved":             true,
@@ -46,29 +102,15 @@ func validateAddProjectPayload(
 	payload AddProjectRequest,
 	criteria []ApplicantSelfScoreCriteria,
 	marketingFiles, routeFiles, eventMapFiles, eventDetailsFiles []*multipart.FileHeader) error {
-	if payload.Collaborated == nil {
-		return &CollaboratedRequiredError{}
-	}
-
-	if err := validateGeneral(payload); err != nil {
-		return err
-	}
-	if err := validateContact(payload); err != nil {
+	errs := &utils.ValidationError{}
+	schema, _ := GetFormSchema(FORM_SCHEMA_VERSION)
+	if err := schema.validate(payload, criteria, errs); err != nil {
 		return err
 	}
-	if err := validateDetails(payload, criteria); err != nil {
-		return err
+	validateAttachment(marketingFiles, routeFiles, eventMapFiles, eventDetailsFiles, errs)
+	if len(errs.Errors) > 0 {
+		return errs
 	}
-	if err := validateExperience(payload); err != nil {
-		return err
-	}
-	if err := validateFund(payload); err != nil {
-		return err
-	}
-	if err := validateAttachment(marketingFiles, routeFiles, eventMapFiles, eventDetailsFiles); err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -96,6 +138,113 @@ func validateAdminUpdateProjectPayload(payload AdminUpdateProjectRequest) (strin
 	if payload.AdminComment != nil && utf8.RuneCountInString(*payload.AdminComment) > ADMIN_COMMENT_MAX_LENGTH {
 		return "adminComment", &AdminCommentTooLongError{utf8.RuneCountInString(*payload.AdminComment)}
 	}
+	if payload.ReviseDueAt != nil {
+		if payload.ProjectStatusSecondary != "Revise" {
+			return "reviseDueAt", &ReviseDueAtNotAllowedError{}
+		}
+		if !payload.ReviseDueAt.After(time.Now()) {
+			return "reviseDueAt", &ReviseDueAtInPastError{}
+		}
+	}
+	return "", nil
+}
+
+func validateBulkAdminUpdatePayload(payload BulkAdminUpdateRequest) (string, error) {
+	if len(payload.ProjectCodes) == 0 {
+		return "projectCodes", &BulkProjectCodesRequiredError{}
+	}
+	if len(payload.ProjectCodes) > BULK_UPDATE_MAX_PROJECTS {
+		return "projectCodes", &BulkTooManyProjectsError{}
+	}
+	seen := map[string]bool{}
+	for _, code := range payload.ProjectCodes {
+		if code == "" {
+			return "projectCodes", &ProjectCodeRequiredError{}
+		}
+		if seen[code] {
+			return "projectCodes", &BulkProjectCodeDuplicatedError{ProjectCode: code}
+		}
+		seen[code] = true
+	}
+	if payload.ProjectStatus == nil && payload.AdminComment == nil && payload.FundApprovedAmount == nil {
+		return "payload", &BulkNothingToUpdateError{}
+	}
+	if payload.ProjectStatus != nil && PROJECT_STATUS[*payload.ProjectStatus] == 0 {
+		return "projectStatus", &ProjectStatusInvalidError{}
+	}
+	if payload.FundApprovedAmount != nil && *payload.FundApprovedAmount < 0 {
+		return "fundApprovedAmount", &FundApprovedAmountNegativeError{}
+	}
+	if payload.AdminComment != nil && utf8.RuneCountInString(*payload.AdminComment) > ADMIN_COMMENT_MAX_LENGTH {
+		return "adminComment", &AdminCommentTooLongError{utf8.RuneCountInString(*payload.AdminComment)}
+	}
+	return "", nil
+}
+
+func validateAddProjectNotePayload(payload AddProjectNoteRequest) (string, error) {
+	if strings.TrimSpace(payload.Body) == "" {
+		return "body", &ProjectNoteBodyRequiredError{}
+	}
+	if utf8.RuneCountInString(payload.Body) > PROJECT_NOTE_MAX_LENGTH {
+		return "body", &ProjectNoteTooLongError{utf8.RuneCountInString(payload.Body)}
+	}
+	if payload.ParentId != nil && *payload.ParentId <= 0 {
+		return "parentId", &ProjectNoteParentNotFoundError{}
+	}
+	return "", nil
+}
+
+func validateAddProjectMessagePayload(payload AddProjectMessageRequest, attachments []*multipart.FileHeader) (string, error) {
+	if strings.TrimSpace(payload.Body) == "" && len(attachments) == 0 {
+		return "body", &ProjectMessageRequiredError{}
+	}
+	if utf8.RuneCountInString(payload.Body) > PROJECT_MESSAGE_MAX_LENGTH {
+		return "body", &ProjectMessageTooLongError{utf8.RuneCountInString(payload.Body)}
+	}
+	if len(attachments) > PROJECT_MESSAGE_MAX_ATTACHMENTS {
+		return "attachments", &ProjectMessageTooManyAttachmentsError{}
+	}
+	return "", nil
+}
+
+// normaliseProjectTags validates the tags and returns them normalised without duplicates
+func normaliseProjectTags(tags []string) ([]string, string, error) {
+	seen := map[string]bool{}
+	normalised := []string{}
+	for _, tag := range tags {
+		t := normaliseTag(tag)
+		if t == "" || utf8.RuneCountInString(t) > PROJECT_TAG_MAX_LENGTH {
+			return nil, "tags", &ProjectTagInvalidError{Tag: tag}
+		}
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		normalised = append(normalised, t)
+	}
+	if len(normalised) > PROJECT_TAGS_MAX {
+		return nil, "tags", &ProjectTooManyTagsError{}
+	}
+	return normalised, "", nil
+}
+
+func validateWithdrawProjectPayload(payload WithdrawProjectRequest) (string, error) {
+	if strings.TrimSpace(payload.Reason) == "" {
+		return "reason", &WithdrawReasonRequiredError{}
+	}
+	if utf8.RuneCountInString(payload.Reason) > STATUS_REASON_MAX_LENGTH {
+		return "reason", &StatusReasonTooLongError{utf8.RuneCountInString(payload.Reason)}
+	}
+	return "", nil
+}
+
+func validateCancelProjectPayload(payload CancelProjectRequest) (string, error) {
+	if strings.TrimSpace(payload.Reason) == "" {
+		return "reason", &CancelReasonRequiredError{}
+	}
+	if utf8.RuneCountInString(payload.Reason) > STATUS_REASON_MAX_LENGTH {
+		return "reason", &StatusReasonTooLongError{utf8.RuneCountInString(payload.Reason)}
+	}
 	return "", nil
 }
 
@@ -104,24 +253,145 @@ func validateGetAdminDashboardPayload(payload GetAdminDashboardRequest) (string,
 	if err != nil {
 		return fn, err
 	}
-	if payload.PageNo <= 0 {
-		return "pageNo", &PageNoInvalidError{}
-	}
-	if payload.PageSize < 1 {
+	if payload.PageSize < 1 || payload.PageSize > DASHBOARD_PAGE_SIZE_MAX {
 		return "pageSize", &PageSizeInvalidError{}
 	}
-	if len(payload.SortBy) == 0 {
-		return "sortBy", &SortByRequiredError{}
+	fn, err = validateDashboardSort(payload.Sort)
+	if err != nil {
+		return fn, err
 	}
+	return validateAdminDashboardFilter(payload.AdminDashboardFilter)
+}
 
-	for i := 0; i < len(payload.SortBy); i++ {
-		if !sortByWhiteList[payload.SortBy[i]] {
-			return "sortBy", &SortByInvalidError{}
+func validateGetAdminDashboardCountPayload(payload GetAdminDashboardCountRequest) (string, error) {
+	if payload.Dashboard != "request" && payload.Dashboard != "started" {
+		return "dashboard", &DashboardTypeInvalidError{}
+	}
+	fn, err := validateFormDateToDate(payload.FromYear, payload.FromMonth, payload.FromDay, payload.ToYear, payload.ToMonth, payload.ToDay)
+	if err != nil {
+		return fn, err
+	}
+	return validateAdminDashboardFilter(payload.AdminDashboardFilter)
+}
+
+func validateDashboardSort(sort []DashboardSort) (string, error) {
+	if len(sort) == 0 {
+		return "sort", &SortRequiredError{}
+	}
+	if len(sort) > DASHBOARD_SORT_MAX_KEYS {
+		return "sort", &SortTooManyKeysError{}
+	}
+	seen := map[string]bool{}
+	for _, s := range sort {
+		if _, ok := adminDashboardSortColumn("request", s.Key); !ok {
+			return "sort", &SortKeyInvalidError{Key: s.Key}
 		}
+		if seen[s.Key] {
+			return "sort", &SortKeyDuplicatedError{Key: s.Key}
+		}
+		seen[s.Key] = true
+		if s.Direction != "asc" && s.Direction != "desc" {
+			return "sort", &SortDirectionInvalidError{Key: s.Key}
+		}
+	}
+	return "", nil
+}
+
+func validateAdminDashboardFilter(filter AdminDashboardFilter) (string, error) {
+	if len(filter.ProvinceIds) > DASHBOARD_FILTER_MAX_IDS {
+		return "provinceIds", &FilterTooManyValuesError{Name: "provinceIds"}
+	}
+	for _, id := range filter.ProvinceIds {
+		if id <= 0 {
+			return "provinceIds", &FilterIdInvalidError{Name: "provinceIds"}
+		}
+	}
+	if len(filter.DistrictIds) > DASHBOARD_FILTER_MAX_IDS {
+		return "districtIds", &FilterTooManyValuesError{Name: "districtIds"}
+	}
+	for _, id := range filter.DistrictIds {
+		if id <= 0 {
+			return "districtIds", &FilterIdInvalidError{Name: "districtIds"}
+		}
+	}
+	if filter.EventFromDate != nil && !isValidDashboardDate(*filter.EventFromDate) {
+		return "eventFromDate", &FilterDateInvalidError{Name: "eventFromDate"}
+	}
+	if filter.EventToDate != nil && !isValidDashboardDate(*filter.EventToDate) {
+		return "eventToDate", &FilterDateInvalidError{Name: "eventToDate"}
+	}
+	if filter.EventFromDate != nil && filter.EventToDate != nil {
+		from := *filter.EventFromDate
+		to := *filter.EventToDate
+		if time.Date(from.Year, time.Month(from.Month), from.Day, 0, 0, 0, 0, time.UTC).After(time.Date(to.Year, time.Month(to.Month), to.Day, 0, 0, 0, 0, time.UTC)) {
+			return "eventToDate", &FilterRangeInvalidError{Name: "eventDate"}
+		}
+	}
+	for _, bucket := range filter.ExpectedParticipants {
+		if !expectedParticipantsOptions[bucket] {
+			return "expectedParticipants", &ExpectedParticipantsInvalidError{}
+		}
+	}
+	if fn, err := validateAmountRange("requestedAmount", filter.RequestedAmountMin, filter.RequestedAmountMax); err != nil {
+		return fn, err
+	}
+	if fn, err := validateAmountRange("approvedAmount", filter.ApprovedAmountMin, filter.ApprovedAmountMax); err != nil {
+		return fn, err
+	}
+	if len(filter.Tags) > DASHBOARD_FILTER_MAX_IDS {
+		return "tags", &FilterTooManyValuesError{Name: "tags"}
+	}
+	if filter.AvgScoreMin != nil && *filter.AvgScoreMin < 0 {
+		return "avgScoreMin", &FilterRangeInvalidError{Name: "avgScore"}
+	}
+	if filter.AvgScoreMin != nil && filter.AvgScoreMax != nil && *filter.AvgScoreMin > *filter.AvgScoreMax {
+		return "avgScoreMax", &FilterRangeInvalidError{Name: "avgScore"}
+	}
+	return "", nil
+}
+
+func validateAmountRange(name string, min, max *int64) (string, error) {
+	if min != nil && *min < 0 {
+		return name + "Min", &FilterRangeInvalidError{Name: name}
+	}
+	if max != nil && *max < 0 {
+		return name + "Max", &FilterRangeInvalidError{Name: name}
+	}
+	if min != nil && max != nil && *min > *max {
+		return name + "Max", &FilterRangeInvalidError{Name: name}
 	}
 	return "", nil
 }
 
+func isValidDashboardDate(d DashboardDate) bool {
+	if d.Year < minDashboardYear || d.Month < 1 || d.Month > 12 || d.Day < 1 {
+		return false
+	}
+	t := time.Date(d.Year, time.Month(d.Month), d.Day, 0, 0, 0, 0, time.UTC)
+	return t.Day() == d.Day
+}
+
+func validateSearchProjectsPayload(payload SearchProjectsRequest) (string, error) {
+	queryLength := utf8.RuneCountInString(strings.TrimSpace(payload.Query))
+	if queryLength < SEARCH_QUERY_MIN_LENGTH || queryLength > SEARCH_QUERY_MAX_LENGTH {
+		return "query", &SearchQueryLengthError{}
+	}
+	if payload.Dashboard != "request" && payload.Dashboard != "started" {
+		return "dashboard", &DashboardTypeInvalidError{}
+	}
+	fn, err := validateFormDateToDate(payload.FromYear, payload.FromMonth, payload.FromDay, payload.ToYear, payload.ToMonth, payload.ToDay)
+	if err != nil {
+		return fn, err
+	}
+	if payload.PageNo <= 0 {
+		return "pageNo", &PageNoInvalidError{}
+	}
+	if payload.PageSize < 1 {
+		return "pageSize", &PageSizeInvalidError{}
+	}
+	return validateAdminDashboardFilter(payload.AdminDashboardFilter)
+}
+
 func validateGetAdminSummaryRequestPayload(payload GetAdminSummaryRequest) (string, error) {
 	fn, err := validateFormDateToDate(payload.FromYear, payload.FromMonth, payload.FromDay, payload.ToYear, payload.ToMonth, payload.ToDay)
 	if err != nil {
@@ -170,3 +440,27 @@ func validateFormDateToDate(fromYear, fromMonth, fromDay, toYear, toMonth, toDay
 	}
 	return "", nil
 }
+
+func validateMapDistancesPayload(payload MapDistancesRequest) (string, error) {
+	for distanceType, mapping := range payload.Overrides {
+		if err := validateDistanceChoice(mapping.Code, mapping.Km); err != nil {
+			return "overrides", &DistanceOverrideInvalidError{Type: distanceType, Err: err}
+		}
+	}
+	return "", nil
+}
+
+func validateDistanceFeeAnalyticsPayload(payload DistanceFeeAnalyticsRequest) (string, error) {
+	if payload.Year != nil && *payload.Year < 1971 {
+		return "year", &YearInvalidError{}
+	}
+	if len(payload.Statuses) > DASHBOARD_FILTER_MAX_IDS {
+		return "statuses", &FilterTooManyValuesError{Name: "statuses"}
+	}
+	for _, status := range payload.Statuses {
+		if PROJECT_STATUS[status] == 0 {
+			return "statuses", &ProjectStatusInvalidError{}
+		}
+	}
+	return "", nil
+}
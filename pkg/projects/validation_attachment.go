@@ -1,19 +1,22 @@
 package projects
 
-import "mime/multipart"
+import (
+	"mime/multipart"
 
-func validateAttachment(marketingFiles, routeFiles, eventMapFiles, eventDetailsFiles []*multipart.FileHeader) error {
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+func validateAttachment(marketingFiles, routeFiles, eventMapFiles, eventDetailsFiles []*multipart.FileHeader, errs *utils.ValidationError) {
 	if len(marketingFiles) == 0 {
-		return &MarketingFilesRequiredError{}
+		errs.Add("/marketingFiles", "required", (&MarketingFilesRequiredError{}).Error(), "กรุณาแนบไฟล์ป้ายประชาสัมพันธ์กิจกรรม")
 	}
 	if len(routeFiles) == 0 {
-		return &RouteFilesRequiredError{}
+		errs.Add("/routeFiles", "required", (&RouteFilesRequiredError{}).Error(), "กรุณาแนบไฟล์เส้นทางการวิ่ง")
 	}
 	if len(eventMapFiles) == 0 {
-		return &EventMapFilesRequiredError{}
+		errs.Add("/eventMapFiles", "required", (&EventMapFilesRequiredError{}).Error(), "กรุณาแนบไฟล์แผนผังบริเวณการจัดงาน")
 	}
 	if len(eventDetailsFiles) == 0 {
-		return &EventDetailsFilesRequiredError{}
+		errs.Add("/eventDetailsFiles", "required", (&EventDetailsFilesRequiredError{}).Error(), "กรุณาแนบไฟล์กำหนดการการจัดกิจกรรม")
 	}
-	return nil
 }
@@ -1,53 +1,26 @@
 package projects
 
-var requestFundAmountOptions = map[int]bool{
-	20000:  true,
-	30000:  true,
-	50000:  true,
-	100000: true,
-}
+const BUDGET_ITEM_MAX_COUNT = 100
 
-func validateFund(payload AddProjectRequest) error {
-	// budget
-	fund := payload.Fund
-	if fund.Budget.Total <= 0 {
-		return &TotalBudgetRequiredError{}
-	}
-	if fund.Budget.SupportOrganization == "" {
-		return &BudgetSupportOrganizationRequiredError{}
-	}
-	if !fund.Budget.NoAlcoholSponsor {
-		return &NoAlcoholSponsorError{}
-	}
-	// request
-	if !fund.Request.Type.Fund &&
-		!fund.Request.Type.BIB &&
-		!fund.Request.Type.Pr &&
-		!fund.Request.Type.Other {
-		return &FundRequestTypeRequiredOneError{}
-	}
-	if fund.Request.Type.Fund {
-		if fund.Request.Details.FundAmount == 0 {
-			return &FundRequestAmountRequiredError{}
-		}
-		_, found := requestFundAmountOptions[fund.Request.Details.FundAmount]
-		if !found {
-			return &FundRequestAmountInvalidError{}
+// validateBudgetItemsTotal runs after the schema has checked every item
+func validateBudgetItemsTotal(fund Fund) error {
+	total := 0
+	requestedTotal := 0
+	for _, item := range fund.Budget.Items {
+		total += item.Quantity * item.UnitCost
+		if item.FundingSource == "runningFund" {
+			requestedTotal += item.Quantity * item.UnitCost
 		}
 	}
-	if fund.Request.Type.BIB {
-		if fund.Request.Details.BibAmount == 0 {
-			return &BibRequestAmountRequiredError{}
-		}
-		if fund.Request.Details.BibAmount < 0 {
-			return &BibRequestAmountInvalidError{}
-		}
+	if total != fund.Budget.Total {
+		return &BudgetItemsTotalMismatchError{ItemsTotal: total, BudgetTotal: fund.Budget.Total}
 	}
-	if fund.Request.Type.Seminar && fund.Request.Details.Seminar == "" {
-		return &SeminarRequiredError{}
+	requestedFund := 0
+	if fund.Request.Type.Fund {
+		requestedFund = fund.Request.Details.FundAmount
 	}
-	if fund.Request.Type.Other && fund.Request.Details.Other == "" {
-		return &OtherRequestRequiredError{}
+	if requestedTotal != requestedFund {
+		return &BudgetItemsFundRequestMismatchError{ItemsTotal: requestedTotal, FundAmount: requestedFund}
 	}
 	return nil
 }
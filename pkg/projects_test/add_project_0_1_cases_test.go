@@ -2,6 +2,7 @@ package projects_test
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/poomipat-k/running-fund/pkg/mock"
 	"github.com/poomipat-k/running-fund/pkg/projects"
@@ -59,7 +60,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CollaboratedRequiredError{},
+		expectedName:   "/collaborated",
+		expectedCode:   "required",
 	},
 	{
 		name: "should not error when collaborated and collaborateFiles is empty",
@@ -70,7 +72,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectNameRequiredError{},
+		expectedName:   "/general/projectName",
+		expectedCode:   "required",
 	},
 	// STEP 0 END
 	// 1 START - general
@@ -83,7 +86,38 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectNameRequiredError{},
+		expectedName:   "/general/projectName",
+		expectedCode:   "required",
+	},
+	{
+		name: "should error when general.projectName is longer than 512 characters",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General: projects.AddProjectGeneralDetails{
+				ProjectName: strings.Repeat("ก", 513),
+			}},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedName:   "/general/projectName",
+		expectedCode:   "maxLength",
+	},
+	{
+		name: "should count general.projectName length in characters not bytes",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General: projects.AddProjectGeneralDetails{
+				ProjectName: strings.Repeat("ก", 512),
+			}},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedName:   "/general/eventDate/year",
+		expectedCode:   "required",
 	},
 	// general.eventDate
 	{
@@ -98,7 +132,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.YearRequiredError{},
+		expectedName:   "/general/eventDate/year",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.eventDate.year is less than 1971",
@@ -168,7 +203,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.DayRequiredError{},
+		expectedName:   "/general/eventDate/day",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.eventDate.day is 32",
@@ -187,7 +223,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.DayOutOfBoundError{},
+		expectedName:   "/general/eventDate/day",
+		expectedCode:   "dayOfMonth",
 	},
 	{
 		name: "should error when general.eventDate.day is 29 Feb on non-leap-year",
@@ -206,7 +243,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.DayOutOfBoundError{},
+		expectedName:   "/general/eventDate/day",
+		expectedCode:   "dayOfMonth",
 	},
 	{
 		name: "should error when general.eventDate.day is 31 November",
@@ -225,7 +263,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.DayOutOfBoundError{},
+		expectedName:   "/general/eventDate/day",
+		expectedCode:   "dayOfMonth",
 	},
 	{
 		name: "should error when general.eventDate.day is 30 Feb",
@@ -244,7 +283,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.DayOutOfBoundError{},
+		expectedName:   "/general/eventDate/day",
+		expectedCode:   "dayOfMonth",
 	},
 	{
 		name: "should error when general.eventDate.fromHour is empty",
@@ -263,7 +303,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.FromHourRequiredError{},
+		expectedName:   "/general/eventDate/fromHour",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.eventDate.fromHour is < 0 or > 23",
@@ -283,7 +324,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.InvalidError{Name: "fromHour"},
+		expectedName:   "/general/eventDate/fromHour",
+		expectedCode:   "max",
 	},
 	{
 		name: "should error when general.eventDate.fromMinute is empty",
@@ -303,7 +345,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.FromMinuteRequiredError{},
+		expectedName:   "/general/eventDate/fromMinute",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.eventDate.fromMinute < 0 or > 59",
@@ -324,7 +367,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.InvalidError{Name: "fromMinute"},
+		expectedName:   "/general/eventDate/fromMinute",
+		expectedCode:   "max",
 	},
 	{
 		name: "should error when general.eventDate.toHour is empty",
@@ -345,7 +389,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ToHourRequiredError{},
+		expectedName:   "/general/eventDate/toHour",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.eventDate.ToHour < 0 or > 23",
@@ -367,7 +412,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.InvalidError{Name: "toHour"},
+		expectedName:   "/general/eventDate/toHour",
+		expectedCode:   "max",
 	},
 	{
 		name: "should error when general.eventDate.toMinute is empty",
@@ -389,7 +435,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ToMinuteRequiredError{},
+		expectedName:   "/general/eventDate/toMinute",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.eventDate.toMinute < 0 or > 59",
@@ -412,7 +459,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.InvalidError{Name: "toMinute"},
+		expectedName:   "/general/eventDate/toMinute",
+		expectedCode:   "max",
 	},
 
 	// general.address
@@ -437,7 +485,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.GeneralAddressRequiredError{},
+		expectedName:   "/general/address/address",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.address.provinceId is empty",
@@ -463,7 +512,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.GeneralProvinceRequiredError{},
+		expectedName:   "/general/address/provinceId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.address.districtId is empty",
@@ -490,7 +540,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.GeneralDistrictIdRequiredError{},
+		expectedName:   "/general/address/districtId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.address.subdistrictId is empty",
@@ -518,7 +569,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.GeneralSubdistrictIdRequiredError{},
+		expectedName:   "/general/address/subdistrictId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.address.postcodeId is empty",
@@ -547,7 +599,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.GeneralPostcodeIdRequiredError{},
+		expectedName:   "/general/address/postcodeId",
+		expectedCode:   "required",
 	},
 	// general.startPoint and general.finishPoint
 	{
@@ -578,7 +631,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.StartPointRequiredError{},
+		expectedName:   "/general/startPoint",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.finishPoint is empty",
@@ -609,7 +663,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.FinishPointRequiredError{},
+		expectedName:   "/general/finishPoint",
+		expectedCode:   "required",
 	},
 	// general.eventDetails.category
 	{
@@ -651,7 +706,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CategoryAvailableRequiredOneError{},
+		expectedName:   "/general/eventDetails/category/available",
+		expectedCode:   "anyTrue",
 	},
 	{
 		name: "should error when general.eventDetails.category.available.other is checked but general.eventDetails.category.otherType is empty",
@@ -692,7 +748,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.OtherEventTypeRequiredError{},
+		expectedName:   "/general/eventDetails/category/otherType",
+		expectedCode:   "required",
 	},
 	// general.eventDetails.distanceAndFee
 	{
@@ -734,7 +791,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.DistanceRequiredOneError{},
+		expectedName:   "/general/eventDetails/distanceAndFee",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.eventDetails.distanceAndFee has 0 checked",
@@ -778,7 +836,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.DistanceRequiredOneError{},
+		expectedName:   "/general/eventDetails/distanceAndFee",
+		expectedCode:   "someItems",
 	},
 	{
 		name: "should error when general.eventDetails.distanceAndFee.type is empty",
@@ -822,7 +881,140 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.DistanceTypeRequiredError{},
+		expectedName:   "/general/eventDetails/distanceAndFee/0/type",
+		expectedCode:   "required",
+	},
+	{
+		name: "should error when general.eventDetails.distanceAndFee.code is not in the catalogue",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General: projects.AddProjectGeneralDetails{
+				ProjectName: "A",
+				EventDate: projects.EventDate{
+					Year:       2024,
+					Month:      2,
+					Day:        20,
+					FromHour:   newInt(0),
+					FromMinute: newInt(25),
+					ToHour:     newInt(10),
+					ToMinute:   newInt(20),
+				},
+				Address: projects.Address{
+					Address:       "A",
+					ProvinceId:    1,
+					DistrictId:    2,
+					SubdistrictId: 3,
+					PostcodeId:    4,
+				},
+				StartPoint:  "X",
+				FinishPoint: "Y",
+				EventDetails: projects.EventDetails{
+					Category: projects.Category{
+						Available: projects.Available{
+							Other:        false,
+							RoadRace:     false,
+							TrailRunning: true,
+						},
+					},
+					DistanceAndFee: []projects.DistanceAndFee{
+						{Checked: true, Type: "10K", Code: "10k", Fee: newFloat64(222.50), Dynamic: newFalse()},
+					},
+				},
+			}},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedError:  &projects.DistanceCodeInvalidError{Code: "10k"},
+	},
+	{
+		name: "should error when general.eventDetails.distanceAndFee.km is missing for an ultra",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General: projects.AddProjectGeneralDetails{
+				ProjectName: "A",
+				EventDate: projects.EventDate{
+					Year:       2024,
+					Month:      2,
+					Day:        20,
+					FromHour:   newInt(0),
+					FromMinute: newInt(25),
+					ToHour:     newInt(10),
+					ToMinute:   newInt(20),
+				},
+				Address: projects.Address{
+					Address:       "A",
+					ProvinceId:    1,
+					DistrictId:    2,
+					SubdistrictId: 3,
+					PostcodeId:    4,
+				},
+				StartPoint:  "X",
+				FinishPoint: "Y",
+				EventDetails: projects.EventDetails{
+					Category: projects.Category{
+						Available: projects.Available{
+							Other:        false,
+							RoadRace:     false,
+							TrailRunning: true,
+						},
+					},
+					DistanceAndFee: []projects.DistanceAndFee{
+						{Checked: true, Type: "Ultra", Code: "ultra", Fee: newFloat64(222.50), Dynamic: newTrue()},
+					},
+				},
+			}},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedError:  &projects.DistanceKmRequiredError{Code: "ultra"},
+	},
+	{
+		name: "should error when general.eventDetails.distanceAndFee.km of an ultra is not over a marathon",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General: projects.AddProjectGeneralDetails{
+				ProjectName: "A",
+				EventDate: projects.EventDate{
+					Year:       2024,
+					Month:      2,
+					Day:        20,
+					FromHour:   newInt(0),
+					FromMinute: newInt(25),
+					ToHour:     newInt(10),
+					ToMinute:   newInt(20),
+				},
+				Address: projects.Address{
+					Address:       "A",
+					ProvinceId:    1,
+					DistrictId:    2,
+					SubdistrictId: 3,
+					PostcodeId:    4,
+				},
+				StartPoint:  "X",
+				FinishPoint: "Y",
+				EventDetails: projects.EventDetails{
+					Category: projects.Category{
+						Available: projects.Available{
+							Other:        false,
+							RoadRace:     false,
+							TrailRunning: true,
+						},
+					},
+					DistanceAndFee: []projects.DistanceAndFee{
+						{Checked: true, Type: "Ultra 40", Code: "ultra", Km: newFloat64(40), Fee: newFloat64(222.50), Dynamic: newTrue()},
+					},
+				},
+			}},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedError:  &projects.DistanceKmInvalidError{Code: "ultra"},
 	},
 	{
 		name: "should error when general.eventDetails.distanceAndFee.fee is empty",
@@ -866,7 +1058,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.DistanceFeeRequiredError{},
+		expectedName:   "/general/eventDetails/distanceAndFee/0/fee",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.eventDetails.distanceAndFee.fee is negative",
@@ -910,7 +1103,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ValueNegativeError{},
+		expectedName:   "/general/eventDetails/distanceAndFee/0/fee",
+		expectedCode:   "min",
 	},
 	{
 		name: "should error when general.eventDetails.distanceAndFee.dynamic is empty",
@@ -954,7 +1148,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.DistanceAndFeeDynamicRequiredError{},
+		expectedName:   "/general/eventDetails/distanceAndFee/0/dynamic",
+		expectedCode:   "required",
 	},
 	// general.eventDetails.vip
 	{
@@ -999,7 +1194,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.VIPRequiredError{},
+		expectedName:   "/general/eventDetails/vip",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.eventDetails.vip is true and general.eventDetails.vipFee is empty",
@@ -1044,7 +1240,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.VIPFeeRequiredError{},
+		expectedName:   "/general/eventDetails/vipFee",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.eventDetails.vip is true and general.eventDetails.vipFee is negative",
@@ -1090,7 +1287,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.VIPFeeNegativeError{},
+		expectedName:   "/general/eventDetails/vipFee",
+		expectedCode:   "min",
 	},
 	// general.expectedParticipants
 	{
@@ -1136,7 +1334,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ExpectedParticipantsRequiredError{},
+		expectedName:   "/general/expectedParticipants",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.expectedParticipants is not a valid value",
@@ -1229,7 +1428,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.HasOrganizerRequiredError{},
+		expectedName:   "/general/hasOrganizer",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when general.hasOrganizer is true and organizerName is empty",
@@ -1276,7 +1476,8 @@ var GeneralAndCollaboratedTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.OrganizerNameRequiredError{},
+		expectedName:   "/general/organizerName",
+		expectedCode:   "required",
 	},
 
 	// 1 END - general
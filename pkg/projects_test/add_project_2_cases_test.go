@@ -2,6 +2,7 @@ package projects_test
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/poomipat-k/running-fund/pkg/mock"
 	"github.com/poomipat-k/running-fund/pkg/projects"
@@ -86,7 +87,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadPrefixRequiredError{},
+		expectedName:   "/contact/projectHead/prefix",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectHead.firstName is empty",
@@ -103,7 +105,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadFirstNameRequiredError{},
+		expectedName:   "/contact/projectHead/firstName",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectHead.lastName is empty",
@@ -121,7 +124,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadLastNameRequiredError{},
+		expectedName:   "/contact/projectHead/lastName",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectHead.organizationPosition is empty",
@@ -140,7 +144,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadOrganizationPositionRequiredError{},
+		expectedName:   "/contact/projectHead/organizationPosition",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectHead.eventPosition is empty",
@@ -160,7 +165,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadEventPositionRequiredError{},
+		expectedName:   "/contact/projectHead/eventPosition",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectHead.address.address is empty",
@@ -181,7 +187,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadAddressRequiredError{},
+		expectedName:   "/contact/projectHead/address/address",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectHead.address.provinceId is empty",
@@ -205,7 +212,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadProvinceIdRequiredError{},
+		expectedName:   "/contact/projectHead/address/provinceId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectHead.address.districtId is empty",
@@ -231,7 +239,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadDistrictIdRequiredError{},
+		expectedName:   "/contact/projectHead/address/districtId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectHead.address.subdistrictId is empty",
@@ -258,7 +267,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadSubdistrictIdRequiredError{},
+		expectedName:   "/contact/projectHead/address/subdistrictId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectHead.address.postcodeId is empty",
@@ -286,7 +296,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadPostcodeIdRequiredError{},
+		expectedName:   "/contact/projectHead/address/postcodeId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectHead.email is empty",
@@ -315,7 +326,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadEmailRequiredError{},
+		expectedName:   "/contact/projectHead/email",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectHead.lineId is empty",
@@ -345,7 +357,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadLineIdRequiredError{},
+		expectedName:   "/contact/projectHead/lineId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectHead.phoneNumber is empty",
@@ -376,7 +389,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadPhoneNumberRequiredError{},
+		expectedName:   "/contact/projectHead/phoneNumber",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectHead.phoneNumber is shorter than 9 numbers",
@@ -408,7 +422,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadPhoneNumberLengthError{},
+		expectedName:   "/contact/projectHead/phoneNumber",
+		expectedCode:   "minLength",
 	},
 	{
 		name: "should error when contact.ProjectHead.phoneNumber is invalid",
@@ -440,7 +455,41 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectHeadPhoneNumberInvalidError{},
+		expectedName:   "/contact/projectHead/phoneNumber",
+		expectedCode:   "pattern",
+	},
+	{
+		name: "should error when contact.ProjectHead.phoneNumber is longer than 64 numbers",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General:      GeneralDetailsOkPayload,
+			Contact: projects.Contact{
+				ProjectHead: projects.ContactPerson{
+					Prefix:               "Mr",
+					FirstName:            "Poomipat",
+					LastName:             "Khamai",
+					OrganizationPosition: "Software Engineer",
+					EventPosition:        "Head",
+					Address: projects.Address{
+						Address:       "Address 1",
+						ProvinceId:    1,
+						DistrictId:    1,
+						SubdistrictId: 1,
+						PostcodeId:    1,
+					},
+					Email:       "a@test.com",
+					LineId:      "12345678",
+					PhoneNumber: strings.Repeat("1", 65),
+				},
+			},
+		},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedName:   "/contact/projectHead/phoneNumber",
+		expectedCode:   "maxLength",
 	},
 	// contact.projectManager
 	{
@@ -457,7 +506,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerPrefixRequiredError{},
+		expectedName:   "/contact/projectManager/prefix",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectManager.firstName is empty",
@@ -476,7 +526,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerFirstNameRequiredError{},
+		expectedName:   "/contact/projectManager/firstName",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectManager.lastName is empty",
@@ -496,7 +547,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerLastNameRequiredError{},
+		expectedName:   "/contact/projectManager/lastName",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectManager.organizationPosition is empty",
@@ -517,7 +569,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerOrganizationPositionRequiredError{},
+		expectedName:   "/contact/projectManager/organizationPosition",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectManager.eventPosition is empty",
@@ -539,7 +592,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerEventPositionRequiredError{},
+		expectedName:   "/contact/projectManager/eventPosition",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectManager.address.address is empty",
@@ -562,7 +616,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerAddressRequiredError{},
+		expectedName:   "/contact/projectManager/address/address",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectManager.address.provinceId is empty",
@@ -588,7 +643,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerProvinceIdRequiredError{},
+		expectedName:   "/contact/projectManager/address/provinceId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectManager.address.districtId is empty",
@@ -615,7 +671,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerDistrictIdRequiredError{},
+		expectedName:   "/contact/projectManager/address/districtId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectManager.address.subdistrictId is empty",
@@ -643,7 +700,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerSubdistrictIdRequiredError{},
+		expectedName:   "/contact/projectManager/address/subdistrictId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectManager.address.postcodeId is empty",
@@ -672,7 +730,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerPostcodeIdRequiredError{},
+		expectedName:   "/contact/projectManager/address/postcodeId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectManager.email is empty",
@@ -702,7 +761,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerEmailRequiredError{},
+		expectedName:   "/contact/projectManager/email",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectManager.lineId is empty",
@@ -733,7 +793,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerLineIdRequiredError{},
+		expectedName:   "/contact/projectManager/lineId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectManager.phoneNumber is empty",
@@ -765,7 +826,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerPhoneNumberRequiredError{},
+		expectedName:   "/contact/projectManager/phoneNumber",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.ProjectManager.phoneNumber is shorter than 9 numbers",
@@ -798,7 +860,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerPhoneNumberLengthError{},
+		expectedName:   "/contact/projectManager/phoneNumber",
+		expectedCode:   "minLength",
 	},
 	{
 		name: "should error when contact.ProjectManager.phoneNumber is invalid",
@@ -831,7 +894,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectManagerPhoneNumberInvalidError{},
+		expectedName:   "/contact/projectManager/phoneNumber",
+		expectedCode:   "pattern",
 	},
 	// contact.projectCoordinator
 	{
@@ -849,7 +913,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorPrefixRequiredError{},
+		expectedName:   "/contact/projectCoordinator/prefix",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectCoordinator.firstName is empty",
@@ -869,7 +934,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorFirstNameRequiredError{},
+		expectedName:   "/contact/projectCoordinator/firstName",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectCoordinator.lastName is empty",
@@ -890,7 +956,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorLastNameRequiredError{},
+		expectedName:   "/contact/projectCoordinator/lastName",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectCoordinator.organizationPosition is empty",
@@ -912,7 +979,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorOrganizationPositionRequiredError{},
+		expectedName:   "/contact/projectCoordinator/organizationPosition",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectCoordinator.eventPosition is empty",
@@ -935,7 +1003,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorEventPositionRequiredError{},
+		expectedName:   "/contact/projectCoordinator/eventPosition",
+		expectedCode:   "required",
 	},
 	// contact.projectCoordinator.address
 	{
@@ -960,7 +1029,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorAddressRequiredError{},
+		expectedName:   "/contact/projectCoordinator/address/address",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectCoordinator.address.provinceId is empty",
@@ -987,7 +1057,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorProvinceIdRequiredError{},
+		expectedName:   "/contact/projectCoordinator/address/provinceId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectCoordinator.address.districtId is empty",
@@ -1015,7 +1086,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorDistrictIdRequiredError{},
+		expectedName:   "/contact/projectCoordinator/address/districtId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectCoordinator.address.subdistrictId is empty",
@@ -1044,7 +1116,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorSubdistrictIdRequiredError{},
+		expectedName:   "/contact/projectCoordinator/address/subdistrictId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectCoordinator.address.postcodeId is empty",
@@ -1074,7 +1147,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorPostcodeIdRequiredError{},
+		expectedName:   "/contact/projectCoordinator/address/postcodeId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectCoordinator.email is empty",
@@ -1105,7 +1179,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorEmailRequiredError{},
+		expectedName:   "/contact/projectCoordinator/email",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectCoordinator.lineId is empty",
@@ -1137,7 +1212,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorLineIdRequiredError{},
+		expectedName:   "/contact/projectCoordinator/lineId",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectCoordinator.phoneNumber is empty",
@@ -1170,7 +1246,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorPhoneNumberRequiredError{},
+		expectedName:   "/contact/projectCoordinator/phoneNumber",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.projectCoordinator.phoneNumber is shorter than 9 numbers",
@@ -1204,7 +1281,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorPhoneNumberLengthError{},
+		expectedName:   "/contact/projectCoordinator/phoneNumber",
+		expectedCode:   "minLength",
 	},
 	{
 		name: "should error when contact.projectCoordinator.phoneNumber is invalid",
@@ -1238,7 +1316,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ProjectCoordinatorPhoneNumberInvalidError{},
+		expectedName:   "/contact/projectCoordinator/phoneNumber",
+		expectedCode:   "pattern",
 	},
 	// project.raceDirector
 	{
@@ -1273,7 +1352,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.RaceDirectorWhoRequiredError{},
+		expectedName:   "/contact/raceDirector/who",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.raceDirector.who is other and raceDirector.alternative.prefix is empty",
@@ -1310,7 +1390,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.RaceDirectorAlternativePrefixRequiredError{},
+		expectedName:   "/contact/raceDirector/alternative/prefix",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.raceDirector.who is other and raceDirector.alternative.firstName is empty",
@@ -1350,7 +1431,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.RaceDirectorAlternativeFirstNameRequiredError{},
+		expectedName:   "/contact/raceDirector/alternative/firstName",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.raceDirector.who is other and raceDirector.alternative.lastName is empty",
@@ -1391,7 +1473,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.RaceDirectorAlternativeLastNameRequiredError{},
+		expectedName:   "/contact/raceDirector/alternative/lastName",
+		expectedCode:   "required",
 	},
 	// organization
 	{
@@ -1434,7 +1517,8 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ContactOrganizationNameRequiredError{},
+		expectedName:   "/contact/organization/name",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when contact.organization.type is empty",
@@ -1479,6 +1563,7 @@ var ContactTestCases = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ContactOrganizationTypeRequiredError{},
+		expectedName:   "/contact/organization/type",
+		expectedCode:   "required",
 	},
 }
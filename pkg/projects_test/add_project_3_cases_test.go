@@ -80,7 +80,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.BackgroundRequiredError{},
+		expectedName:   "/details/background",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when details.objective is empty",
@@ -97,7 +98,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ObjectiveRequiredError{},
+		expectedName:   "/details/objective",
+		expectedCode:   "required",
 	},
 	// marketing
 	// marketing.online
@@ -117,7 +119,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.OnlineAvailableRequiredOne{},
+		expectedName:   "/details/marketing/online/available",
+		expectedCode:   "anyTrue",
 	},
 	{
 		name: "should error when  details.marketing.online.available.facebook is checked and details.marketing.online.howTo.facebook is empty",
@@ -142,7 +145,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.FacebookHowToIsRequired{},
+		expectedName:   "/details/marketing/online/howTo/facebook",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when  details.marketing.online.available.website is checked and details.marketing.online.howTo.website is empty",
@@ -171,7 +175,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.WebsiteHowToIsRequired{},
+		expectedName:   "/details/marketing/online/howTo/website",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when  details.marketing.online.available.onlinePage is checked and details.marketing.online.howTo.onlinePage is empty",
@@ -202,7 +207,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.OnlinePageHowToIsRequired{},
+		expectedName:   "/details/marketing/online/howTo/onlinePage",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when  details.marketing.online.available.other is checked and details.marketing.online.howTo.other is empty",
@@ -235,7 +241,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.OtherHowToIsRequired{},
+		expectedName:   "/details/marketing/online/howTo/other",
+		expectedCode:   "required",
 	},
 	// marketing offline
 	{
@@ -269,7 +276,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.OfflineAvailableRequiredOne{},
+		expectedName:   "/details/marketing/offline/available",
+		expectedCode:   "anyTrue",
 	},
 	{
 		name: "should error when  details.marketing.offline.available is 'other' and details.marketing.offline.addition is empty",
@@ -307,7 +315,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.OfflineAdditionRequiredError{},
+		expectedName:   "/details/marketing/offline/addition",
+		expectedCode:   "required",
 	},
 	// details.score
 	{
@@ -518,7 +527,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.SafetyReadyRequiredOneError{},
+		expectedName:   "/details/Safety/ready",
+		expectedCode:   "anyTrue",
 	},
 	{
 		name: "should error when details.safety.ready.aed is checked and details.safety.aedCount < 1",
@@ -568,7 +578,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.AEDCountInvalidError{},
+		expectedName:   "/details/Safety/aedCount",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when details.safety.ready.other is checked and details.safety.addition is empty",
@@ -619,7 +630,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.SafetyAdditionRequiredError{},
+		expectedName:   "/details/Safety/addition",
+		expectedCode:   "required",
 	},
 	// details.route
 	{
@@ -672,7 +684,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.RouteMeasurementRequiredOneError{},
+		expectedName:   "/details/route/measurement",
+		expectedCode:   "anyTrue",
 	},
 	{
 		name: "should error when details.route.measurement.selfMeasurement is checked and details.route.tool is empty",
@@ -729,7 +742,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.RouteToolRequiredError{},
+		expectedName:   "/details/route/tool",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when none of details.route.trafficManagement is checked",
@@ -787,7 +801,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.RouteTrafficManagementRequiredOneError{},
+		expectedName:   "/details/route/trafficManagement",
+		expectedCode:   "anyTrue",
 	},
 	// details.judge
 	{
@@ -849,7 +864,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.JudgeTypeRequiredError{},
+		expectedName:   "/details/judge/type",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when details.judge.type value is invalid",
@@ -913,7 +929,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.JudgeTypeInvalidError{},
+		expectedName:   "/details/judge/type",
+		expectedCode:   "enum",
 	},
 	{
 		name: "should error when details.judge.type is other and details.judge.otherType is empty",
@@ -977,7 +994,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.JudgeOtherTypeRequiredError{},
+		expectedName:   "/details/judge/otherType",
+		expectedCode:   "required",
 	},
 	// details.support
 	{
@@ -1043,7 +1061,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.SupportOrganizationRequiredOneError{},
+		expectedName:   "/details/support/organization",
+		expectedCode:   "anyTrue",
 	},
 	{
 		name: "should error when details.support.organization.other is checked and details.support.addition is empty",
@@ -1114,7 +1133,8 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.SupportAdditionRequiredError{},
+		expectedName:   "/details/support/addition",
+		expectedCode:   "required",
 	},
 	// details.feedback
 	{
@@ -1187,6 +1207,7 @@ var Details = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.FeedbackRequiredError{},
+		expectedName:   "/details/feedback",
+		expectedCode:   "required",
 	},
 }
@@ -59,7 +59,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.ThisSeriesFirstTimeRequiredError{},
+		expectedName:   "/experience/thisSeries/firstTime",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime false and ordinalNumber is empty",
@@ -79,7 +80,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.HistoryOrdinalNumberInvalidError{},
+		expectedName:   "/experience/thisSeries/history/ordinalNumber",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and experience.thisSeries.history.ordinalNumber < 2",
@@ -102,7 +104,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.HistoryOrdinalNumberInvalidError{},
+		expectedName:   "/experience/thisSeries/history/ordinalNumber",
+		expectedCode:   "min",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and experience.thisSeries.history.year is empty",
@@ -125,7 +128,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.HistoryYearRequiredError{},
+		expectedName:   "/experience/thisSeries/history/year",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and (experience.thisSeries.history.year < 1957 or experience.thisSeries.history.year > currentYear)",
@@ -149,7 +153,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.HistoryYearOutOfBoundError{},
+		expectedName:   "/experience/thisSeries/history/year",
+		expectedCode:   "min",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and experience.thisSeries.history.month is empty",
@@ -173,7 +178,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.HistoryMonthRequiredError{},
+		expectedName:   "/experience/thisSeries/history/month",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and experience.thisSeries.history.month is invalid",
@@ -198,7 +204,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.HistoryMonthOutOfBoundError{},
+		expectedName:   "/experience/thisSeries/history/month",
+		expectedCode:   "max",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and experience.thisSeries.history.day is empty",
@@ -223,7 +230,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.HistoryDayRequiredError{},
+		expectedName:   "/experience/thisSeries/history/day",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and experience.thisSeries.history.day is 32 Jan",
@@ -249,7 +257,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.HistoryDayOutOfBoundError{},
+		expectedName:   "/experience/thisSeries/history/day",
+		expectedCode:   "dayOfMonth",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and experience.thisSeries.history.day is 31 November",
@@ -275,7 +284,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.HistoryDayOutOfBoundError{},
+		expectedName:   "/experience/thisSeries/history/day",
+		expectedCode:   "dayOfMonth",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and experience.thisSeries.history.day is 30 Feb",
@@ -301,7 +311,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.HistoryDayOutOfBoundError{},
+		expectedName:   "/experience/thisSeries/history/day",
+		expectedCode:   "dayOfMonth",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and experience.thisSeries.history.day is 29 Feb on none-leap-year",
@@ -327,7 +338,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.HistoryDayOutOfBoundError{},
+		expectedName:   "/experience/thisSeries/history/day",
+		expectedCode:   "dayOfMonth",
 	},
 	// Complete1
 	{
@@ -354,7 +366,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedYearRequiredError{},
+		expectedName:   "/experience/thisSeries/history/completed1/year",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and experience.thisSeries.history.completed1.year is invalid",
@@ -383,7 +396,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedYearOutOfBoundError{},
+		expectedName:   "/experience/thisSeries/history/completed1/year",
+		expectedCode:   "min",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and experience.thisSeries.history.completed1.participant is empty",
@@ -413,7 +427,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedParticipantRequiredError{},
+		expectedName:   "/experience/thisSeries/history/completed1/participant",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and experience.thisSeries.history.completed1.participant < 0",
@@ -444,7 +459,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedParticipantInvalidError{},
+		expectedName:   "/experience/thisSeries/history/completed1/participant",
+		expectedCode:   "min",
 	},
 	// Completed2
 	{
@@ -479,7 +495,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedYearRequiredError{},
+		expectedName:   "/experience/thisSeries/history/completed2/year",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and only experience.thisSeries.history.completed2.year is invalid",
@@ -515,7 +532,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedYearOutOfBoundError{},
+		expectedName:   "/experience/thisSeries/history/completed2/year",
+		expectedCode:   "min",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and only experience.thisSeries.history.completed2.participant is empty",
@@ -550,7 +568,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedParticipantRequiredError{},
+		expectedName:   "/experience/thisSeries/history/completed2/participant",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and only experience.thisSeries.history.completed2.participant is invalid",
@@ -586,7 +605,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedParticipantInvalidError{},
+		expectedName:   "/experience/thisSeries/history/completed2/participant",
+		expectedCode:   "min",
 	},
 	// Completed3
 	{
@@ -621,7 +641,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedYearRequiredError{},
+		expectedName:   "/experience/thisSeries/history/completed3/year",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and only experience.thisSeries.history.completed3.year is invalid",
@@ -657,7 +678,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedYearOutOfBoundError{},
+		expectedName:   "/experience/thisSeries/history/completed3/year",
+		expectedCode:   "min",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and only experience.thisSeries.history.completed3.participant is empty",
@@ -692,7 +714,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedParticipantRequiredError{},
+		expectedName:   "/experience/thisSeries/history/completed3/participant",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.thisSeries.firstTime is false and only experience.thisSeries.history.completed3.participant is invalid",
@@ -728,7 +751,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedParticipantInvalidError{},
+		expectedName:   "/experience/thisSeries/history/completed3/participant",
+		expectedCode:   "min",
 	},
 	// otherSeries
 	{
@@ -765,7 +789,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.DoneBeforeRequiredError{},
+		expectedName:   "/experience/otherSeries/doneBefore",
+		expectedCode:   "required",
 	},
 	// otherSeries.history.completed1
 	{
@@ -810,7 +835,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedYearRequiredError{},
+		expectedName:   "/experience/otherSeries/history/completed1/year",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.otherSeries.doneBefore is true and experience.otherSeries.history.completed1.year is invalid",
@@ -854,7 +880,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedYearOutOfBoundError{},
+		expectedName:   "/experience/otherSeries/history/completed1/year",
+		expectedCode:   "min",
 	},
 	{
 		name: "should error when experience.otherSeries.doneBefore is true and experience.otherSeries.history.completed1.name is empty",
@@ -898,7 +925,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedNameRequiredError{},
+		expectedName:   "/experience/otherSeries/history/completed1/name",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.otherSeries.doneBefore is true and experience.otherSeries.history.completed1.participant is empty",
@@ -943,7 +971,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedParticipantRequiredError{},
+		expectedName:   "/experience/otherSeries/history/completed1/participant",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.otherSeries.doneBefore is true and experience.otherSeries.history.completed1.participant < 0",
@@ -989,7 +1018,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedParticipantInvalidError{},
+		expectedName:   "/experience/otherSeries/history/completed1/participant",
+		expectedCode:   "min",
 	},
 	// otherSeries.history.completed2
 	{
@@ -1040,7 +1070,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedYearRequiredError{},
+		expectedName:   "/experience/otherSeries/history/completed2/year",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.otherSeries.doneBefore is true and only experience.otherSeries.history.completed2.year is invalid",
@@ -1091,7 +1122,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedYearOutOfBoundError{},
+		expectedName:   "/experience/otherSeries/history/completed2/year",
+		expectedCode:   "min",
 	},
 	{
 		name: "should error when experience.otherSeries.doneBefore is true and experience.otherSeries.history.completed2.name is empty",
@@ -1142,7 +1174,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedNameRequiredError{},
+		expectedName:   "/experience/otherSeries/history/completed2/name",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.otherSeries.doneBefore is true and experience.otherSeries.history.completed2.participant is empty",
@@ -1193,7 +1226,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedParticipantRequiredError{},
+		expectedName:   "/experience/otherSeries/history/completed2/participant",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.otherSeries.doneBefore is true and experience.otherSeries.history.completed2.participant is invalid",
@@ -1244,7 +1278,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedParticipantInvalidError{},
+		expectedName:   "/experience/otherSeries/history/completed2/participant",
+		expectedCode:   "min",
 	},
 	// otherSeries.history.completed3
 	{
@@ -1295,7 +1330,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedYearRequiredError{},
+		expectedName:   "/experience/otherSeries/history/completed3/year",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.otherSeries.doneBefore is true and only experience.otherSeries.history.completed3.year is invalid",
@@ -1346,7 +1382,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedYearOutOfBoundError{},
+		expectedName:   "/experience/otherSeries/history/completed3/year",
+		expectedCode:   "min",
 	},
 	{
 		name: "should error when experience.otherSeries.doneBefore is true and experience.otherSeries.history.completed3.name is empty",
@@ -1397,7 +1434,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedNameRequiredError{},
+		expectedName:   "/experience/otherSeries/history/completed3/name",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.otherSeries.doneBefore is true and experience.otherSeries.history.completed3.participant is empty",
@@ -1448,7 +1486,8 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedParticipantRequiredError{},
+		expectedName:   "/experience/otherSeries/history/completed3/participant",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when experience.otherSeries.doneBefore is true and experience.otherSeries.history.completed3.participant is invalid",
@@ -1499,6 +1538,7 @@ var Experience = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.CompletedParticipantInvalidError{},
+		expectedName:   "/experience/otherSeries/history/completed3/participant",
+		expectedCode:   "min",
 	},
 }
@@ -2,6 +2,7 @@ package projects_test
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/poomipat-k/running-fund/pkg/mock"
 	"github.com/poomipat-k/running-fund/pkg/projects"
@@ -12,6 +13,7 @@ var FundOkPayload = projects.Fund{
 		Total:               50000,
 		SupportOrganization: "ABC",
 		NoAlcoholSponsor:    true,
+		Items:               budgetItemsOk,
 	},
 	Request: projects.FundRequest{
 		Type: projects.FundRequestType{
@@ -29,6 +31,17 @@ var FundOkPayload = projects.Fund{
 	},
 }
 
+var budgetItemsOk = []projects.BudgetItem{
+	{Category: "bib", Description: "Bib", Quantity: 500, UnitCost: 40, FundingSource: "runningFund"},
+	{Category: "medal", Description: "Medal", Quantity: 500, UnitCost: 60, FundingSource: "runningFund"},
+}
+
+func fundWithItems(items []projects.BudgetItem) projects.Fund {
+	fund := FundOkPayload
+	fund.Budget.Items = items
+	return fund
+}
+
 var Fund = []TestCase{
 	// budget
 	{
@@ -45,7 +58,8 @@ var Fund = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.TotalBudgetRequiredError{},
+		expectedName:   "/fund/budget/total",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when fund.budget.supportOrganization is empty",
@@ -66,7 +80,8 @@ var Fund = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.BudgetSupportOrganizationRequiredError{},
+		expectedName:   "/fund/budget/supportOrganization",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when fund.budget.noAlcoholSponsor is empty",
@@ -88,7 +103,8 @@ var Fund = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.NoAlcoholSponsorError{},
+		expectedName:   "/fund/budget/noAlcoholSponsor",
+		expectedCode:   "const",
 	},
 	// fund.request
 	{
@@ -112,7 +128,8 @@ var Fund = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.FundRequestTypeRequiredOneError{},
+		expectedName:   "/fund/request/type",
+		expectedCode:   "anyTrue",
 	},
 	{
 		name: "should error when fund.request.type.fund is checked and fund.request.details.fundAmount is empty",
@@ -140,7 +157,8 @@ var Fund = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.FundRequestAmountRequiredError{},
+		expectedName:   "/fund/request/details/fundAmount",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when fund.request.type.fund is checked and fund.request.details.fundAmount is invalid",
@@ -171,7 +189,8 @@ var Fund = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.FundRequestAmountInvalidError{},
+		expectedName:   "/fund/request/details/fundAmount",
+		expectedCode:   "enum",
 	},
 	{
 		name: "should error when fund.request.type.bib is checked and fund.request.details.bibAmount is empty",
@@ -203,7 +222,8 @@ var Fund = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.BibRequestAmountRequiredError{},
+		expectedName:   "/fund/request/details/bibAmount",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when fund.request.type.bib is checked and fund.request.details.bibAmount is invalid",
@@ -236,7 +256,8 @@ var Fund = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.BibRequestAmountInvalidError{},
+		expectedName:   "/fund/request/details/bibAmount",
+		expectedCode:   "min",
 	},
 	{
 		name: "should error when fund.request.type.seminar is checked and fund.request.details.seminar is empty",
@@ -270,7 +291,8 @@ var Fund = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.SeminarRequiredError{},
+		expectedName:   "/fund/request/details/seminar",
+		expectedCode:   "required",
 	},
 	{
 		name: "should error when fund.request.type.other is checked and fund.request.details.other is empty",
@@ -306,6 +328,167 @@ var Fund = []TestCase{
 			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
 		},
 		expectedStatus: http.StatusBadRequest,
-		expectedError:  &projects.OtherRequestRequiredError{},
+		expectedName:   "/fund/request/details/other",
+		expectedCode:   "required",
+	},
+	// fund.budget.items
+	{
+		name: "should error when fund.budget.items is empty",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General:      GeneralDetailsOkPayload,
+			Contact:      ContactOkPayload,
+			Details:      DetailsOkPayload,
+			Experience:   ExperienceOkPayload,
+			Fund:         fundWithItems(nil),
+		},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedName:   "/fund/budget/items",
+		expectedCode:   "required",
+	},
+	{
+		name: "should error when fund.budget.items category is invalid",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General:      GeneralDetailsOkPayload,
+			Contact:      ContactOkPayload,
+			Details:      DetailsOkPayload,
+			Experience:   ExperienceOkPayload,
+			Fund: fundWithItems([]projects.BudgetItem{
+				{Category: "food", Description: "Lunch", Quantity: 1, UnitCost: 50000, FundingSource: "runningFund"},
+			}),
+		},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedName:   "/fund/budget/items/0/category",
+		expectedCode:   "enum",
+	},
+	{
+		name: "should error when fund.budget.items quantity is invalid",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General:      GeneralDetailsOkPayload,
+			Contact:      ContactOkPayload,
+			Details:      DetailsOkPayload,
+			Experience:   ExperienceOkPayload,
+			Fund: fundWithItems([]projects.BudgetItem{
+				{Category: "bib", Description: "Bib", Quantity: 500, UnitCost: 40, FundingSource: "runningFund"},
+				{Category: "medal", Description: "Medal", Quantity: 0, UnitCost: 60, FundingSource: "runningFund"},
+			}),
+		},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedName:   "/fund/budget/items/1/quantity",
+		expectedCode:   "required",
+	},
+	{
+		name: "should error when fund.budget.items fundingSource is invalid",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General:      GeneralDetailsOkPayload,
+			Contact:      ContactOkPayload,
+			Details:      DetailsOkPayload,
+			Experience:   ExperienceOkPayload,
+			Fund: fundWithItems([]projects.BudgetItem{
+				{Category: "bib", Description: "Bib", Quantity: 500, UnitCost: 100, FundingSource: "bank"},
+			}),
+		},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedName:   "/fund/budget/items/0/fundingSource",
+		expectedCode:   "enum",
+	},
+	{
+		name: "should error when fund.budget.items description is blank",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General:      GeneralDetailsOkPayload,
+			Contact:      ContactOkPayload,
+			Details:      DetailsOkPayload,
+			Experience:   ExperienceOkPayload,
+			Fund: fundWithItems([]projects.BudgetItem{
+				{Category: "bib", Description: "  ", Quantity: 500, UnitCost: 100, FundingSource: "runningFund"},
+			}),
+		},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedName:   "/fund/budget/items/0/description",
+		expectedCode:   "notBlank",
+	},
+	{
+		name: "should error when fund.budget.items description is longer than 512 characters",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General:      GeneralDetailsOkPayload,
+			Contact:      ContactOkPayload,
+			Details:      DetailsOkPayload,
+			Experience:   ExperienceOkPayload,
+			Fund: fundWithItems([]projects.BudgetItem{
+				{Category: "bib", Description: "Bib", Quantity: 500, UnitCost: 40, FundingSource: "runningFund"},
+				{Category: "medal", Description: strings.Repeat("ก", 513), Quantity: 500, UnitCost: 60, FundingSource: "runningFund"},
+			}),
+		},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedName:   "/fund/budget/items/1/description",
+		expectedCode:   "maxLength",
+	},
+	{
+		name: "should error when fund.budget.items do not sum to fund.budget.total",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General:      GeneralDetailsOkPayload,
+			Contact:      ContactOkPayload,
+			Details:      DetailsOkPayload,
+			Experience:   ExperienceOkPayload,
+			Fund: fundWithItems([]projects.BudgetItem{
+				{Category: "bib", Description: "Bib", Quantity: 500, UnitCost: 40, FundingSource: "runningFund"},
+			}),
+		},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedError:  &projects.BudgetItemsTotalMismatchError{ItemsTotal: 20000, BudgetTotal: 50000},
+	},
+	{
+		name: "should error when runningFund items do not match fund.request.details.fundAmount",
+		payload: projects.AddProjectRequest{
+			Collaborated: newFalse(),
+			General:      GeneralDetailsOkPayload,
+			Contact:      ContactOkPayload,
+			Details:      DetailsOkPayload,
+			Experience:   ExperienceOkPayload,
+			Fund: fundWithItems([]projects.BudgetItem{
+				{Category: "bib", Description: "Bib", Quantity: 500, UnitCost: 40, FundingSource: "runningFund"},
+				{Category: "medal", Description: "Medal", Quantity: 500, UnitCost: 60, FundingSource: "sponsor"},
+			}),
+		},
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedError:  &projects.BudgetItemsFundRequestMismatchError{ItemsTotal: 20000, FundAmount: 50000},
 	},
 }
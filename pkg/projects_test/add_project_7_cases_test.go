@@ -0,0 +1,74 @@
+package projects_test
+
+import (
+	"net/http"
+
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+func contactWithProjectHeadPhone(phoneNumber string) projects.Contact {
+	contact := ContactOkPayload
+	contact.ProjectHead.PhoneNumber = phoneNumber
+	return contact
+}
+
+var AllErrors = []TestCase{
+	{
+		name: "should return every invalid field with its path",
+		payload: projects.AddProjectRequest{
+			Collaborated: newTrue(),
+			General:      GeneralDetailsOkPayload,
+			Contact:      contactWithProjectHeadPhone("0812"),
+			Details:      DetailsOkPayload,
+			Experience:   ExperienceOkPayload,
+			Fund: fundWithItems([]projects.BudgetItem{
+				{Category: "bib", Description: "Bib", Quantity: 500, UnitCost: 40, FundingSource: "runningFund"},
+				{Category: "food", Description: "Food", Quantity: 500, UnitCost: 60, FundingSource: "runningFund"},
+			}),
+		},
+		marketingFilesPath: "test.png",
+		routeFilesPath:     "test.png",
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedCode:   "minLength",
+		expectedName:   "/contact/projectHead/phoneNumber",
+		expectedFieldErrors: []utils.FieldError{
+			{Path: "/contact/projectHead/phoneNumber", Code: "minLength", Message: "เบอร์โทรศัพท์หัวหน้าโครงการ ต้องมีอย่างน้อย 9 ตัวอักษร"},
+			{Path: "/fund/budget/items/1/category", Code: "enum", Message: "กรุณาเลือกหมวดหมู่ของรายการงบประมาณที่ 2 จากตัวเลือกที่กำหนด"},
+			{Path: "/eventMapFiles", Code: "required", Message: "กรุณาแนบไฟล์แผนผังบริเวณการจัดงาน"},
+			{Path: "/eventDetailsFiles", Code: "required", Message: "กรุณาแนบไฟล์กำหนดการการจัดกิจกรรม"},
+		},
+	},
+	{
+		name: "should report custom rules by name",
+		payload: projects.AddProjectRequest{
+			Collaborated: newTrue(),
+			General:      GeneralDetailsOkPayload,
+			Contact:      ContactOkPayload,
+			Details:      DetailsOkPayload,
+			Experience:   ExperienceOkPayload,
+			Fund: fundWithItems([]projects.BudgetItem{
+				{Category: "bib", Description: "Bib", Quantity: 500, UnitCost: 40, FundingSource: "runningFund"},
+			}),
+		},
+		marketingFilesPath:    "test.png",
+		routeFilesPath:        "test.png",
+		eventMapFilesPath:     "test.png",
+		eventDetailsFilesPath: "test.png",
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedError:  &projects.BudgetItemsTotalMismatchError{ItemsTotal: 20000, BudgetTotal: 50000},
+		expectedName:   "/fund/budget/items",
+		expectedFieldErrors: []utils.FieldError{
+			{Path: "/fund/budget/items", Code: "budgetItemsTotal", Message: "ยอดรวมของรายการงบประมาณไม่ตรงกับงบประมาณรวมหรือจำนวนเงินที่ขอรับการสนับสนุน"},
+		},
+	},
+}
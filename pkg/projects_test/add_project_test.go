@@ -7,17 +7,21 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/poomipat-k/running-fund/pkg/mock"
 	"github.com/poomipat-k/running-fund/pkg/projects"
 	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+	"github.com/poomipat-k/running-fund/pkg/utils"
 )
 
 type ErrorBody struct {
 	Error   bool
 	Message string
+	Name    string
+	Errors  []utils.FieldError
 }
 
 type TestCase struct {
@@ -31,6 +35,9 @@ type TestCase struct {
 	routeFilesPath         string
 	eventMapFilesPath      string
 	eventDetailsFilesPath  string
+	expectedName           string
+	expectedCode           string
+	expectedFieldErrors    []utils.FieldError
 }
 
 func TestAddProject(t *testing.T) {
@@ -42,8 +49,9 @@ func TestAddProject(t *testing.T) {
 		Experience,
 		Fund,
 		Attachment,
+		Eligibility,
+		AllErrors,
 	}
-	t.Setenv("APPLICANT_CRITERIA_VERSION", "1")
 	for _, cases := range pagesCases {
 		for _, tt := range cases {
 			t.Run(tt.name, func(t *testing.T) {
@@ -142,15 +150,27 @@ func TestAddProject(t *testing.T) {
 				req := httptest.NewRequest(http.MethodPost, "/project", pipeReader)
 
 				req.Header.Set("userId", "1")
+				req.Header.Set("fundingRoundId", "1")
+				req.Header.Set("applicantCriteriaVersion", "1")
 				// Set content-type to multipart
 				req.Header.Add("content-type", multipartWriter.FormDataContentType())
 
 				handler.AddProject(res, req)
 
 				assertStatus(t, res.Code, tt.expectedStatus)
-				if tt.expectedError != nil {
+				if tt.expectedError != nil || tt.expectedCode != "" {
 					errBody := getErrorResponse(t, res)
-					assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+					if tt.expectedCode != "" {
+						assertFormSchemaError(t, errBody, tt.expectedName, tt.expectedCode)
+					} else {
+						assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+					}
+					if tt.expectedFieldErrors != nil {
+						assertErrorMessage(t, errBody.Name, tt.expectedName)
+						if !reflect.DeepEqual(errBody.Errors, tt.expectedFieldErrors) {
+							t.Errorf("got errors %+v, want %+v", errBody.Errors, tt.expectedFieldErrors)
+						}
+					}
 				}
 			})
 		}
@@ -229,6 +249,16 @@ func assertUpdatedData(t testing.TB, got, want projects.AdminUpdateParam) {
 	if got.AdminApprovedAt != nil && want.AdminApprovedAt != nil && *got.AdminApprovedAt != *want.AdminApprovedAt {
 		t.Errorf("AdminApprovedAt: got %v, want %v", *got.AdminApprovedAt, *want.AdminApprovedAt)
 	}
+	// ReviseDueAt
+	if got.ReviseDueAt == nil && want.ReviseDueAt != nil {
+		t.Error("ReviseDueAt should not be nil")
+	}
+	if got.ReviseDueAt != nil && want.ReviseDueAt == nil {
+		t.Error("ReviseDueAt should be nil")
+	}
+	if got.ReviseDueAt != nil && want.ReviseDueAt != nil && !got.ReviseDueAt.Equal(*want.ReviseDueAt) {
+		t.Errorf("ReviseDueAt: got %v, want %v", *got.ReviseDueAt, *want.ReviseDueAt)
+	}
 }
 
 func newInt(val int) *int {
@@ -266,7 +296,7 @@ func newTrue() *bool {
 	return &b
 }
 
-func addProjectSuccess(addProject projects.AddProjectRequest, userId int, criteria []projects.ApplicantSelfScoreCriteria, attachments []projects.Attachments) (int, error) {
+func addProjectSuccess(addProject projects.AddProjectRequest, userId int, fundingRoundId int, criteria []projects.ApplicantSelfScoreCriteria, attachments []projects.Attachments) (int, error) {
 	return 1, nil
 }
 
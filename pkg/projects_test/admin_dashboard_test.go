@@ -0,0 +1,337 @@
+package projects_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+type AdminDashboardFilterTestCase struct {
+	name           string
+	filter         projects.AdminDashboardFilter
+	expectedStatus int
+	expectedError  error
+}
+
+func newInt64Ptr(v int64) *int64 {
+	return &v
+}
+
+func newFloat64Ptr(v float64) *float64 {
+	return &v
+}
+
+func newBoolPtr(v bool) *bool {
+	return &v
+}
+
+func dashboardPayload(filter projects.AdminDashboardFilter) projects.GetAdminDashboardRequest {
+	return projects.GetAdminDashboardRequest{
+		FromYear:             2024,
+		FromMonth:            1,
+		FromDay:              1,
+		ToYear:               2024,
+		ToMonth:              12,
+		ToDay:                31,
+		PageSize:             10,
+		Sort:                 []projects.DashboardSort{{Key: "createdAt", Direction: "desc"}},
+		AdminDashboardFilter: filter,
+	}
+}
+
+func TestAdminRequestDashboardFilter(t *testing.T) {
+	tests := []AdminDashboardFilterTestCase{
+		{
+			name:           "should error when province id is invalid",
+			filter:         projects.AdminDashboardFilter{ProvinceIds: []int{1, 0}},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.FilterIdInvalidError{Name: "provinceIds"},
+		},
+		{
+			name:           "should error when event date is invalid",
+			filter:         projects.AdminDashboardFilter{EventFromDate: &projects.DashboardDate{Year: 2024, Month: 2, Day: 30}},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.FilterDateInvalidError{Name: "eventFromDate"},
+		},
+		{
+			name: "should error when event date range is reversed",
+			filter: projects.AdminDashboardFilter{
+				EventFromDate: &projects.DashboardDate{Year: 2024, Month: 3, Day: 2},
+				EventToDate:   &projects.DashboardDate{Year: 2024, Month: 3, Day: 1},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.FilterRangeInvalidError{Name: "eventDate"},
+		},
+		{
+			name:           "should error when expected participants bucket is unknown",
+			filter:         projects.AdminDashboardFilter{ExpectedParticipants: []string{"1501-2500", "9999"}},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ExpectedParticipantsInvalidError{},
+		},
+		{
+			name:           "should error when requested amount range is reversed",
+			filter:         projects.AdminDashboardFilter{RequestedAmountMin: newInt64Ptr(50000), RequestedAmountMax: newInt64Ptr(10000)},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.FilterRangeInvalidError{Name: "requestedAmount"},
+		},
+		{
+			name:           "should error when approved amount is negative",
+			filter:         projects.AdminDashboardFilter{ApprovedAmountMin: newInt64Ptr(-1)},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.FilterRangeInvalidError{Name: "approvedAmount"},
+		},
+		{
+			name:           "should error when average score range is reversed",
+			filter:         projects.AdminDashboardFilter{AvgScoreMin: newFloat64Ptr(80), AvgScoreMax: newFloat64Ptr(60)},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.FilterRangeInvalidError{Name: "avgScore"},
+		},
+		{
+			name: "should pass every filter to the store",
+			filter: projects.AdminDashboardFilter{
+				ProvinceIds:          []int{38, 39},
+				CatTrailRunning:      newBoolPtr(true),
+				EventFromDate:        &projects.DashboardDate{Year: 2024, Month: 11, Day: 1},
+				EventToDate:          &projects.DashboardDate{Year: 2025, Month: 2, Day: 28},
+				ExpectedParticipants: []string{"1501-2500", "2501-3500"},
+				RequestedAmountMin:   newInt64Ptr(50001),
+				Collaborated:         newBoolPtr(false),
+				AvgScoreMin:          newFloat64Ptr(60),
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mock.MockProjectStore{
+				GetAdminRequestDashboardFunc: func(fromDate, toDate time.Time, sort []projects.DashboardSort, limit int, cursor *string, filter projects.AdminDashboardFilter) (projects.AdminDashboardPage, error) {
+					got, _ := json.Marshal(filter)
+					want, _ := json.Marshal(tt.filter)
+					if !bytes.Equal(got, want) {
+						t.Errorf("got filter %s, want %s", got, want)
+					}
+					return projects.AdminDashboardPage{Items: []projects.AdminRequestDashboardRow{}}, nil
+				},
+			}
+			handler := projects.NewProjectHandler(store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			body, err := json.Marshal(dashboardPayload(tt.filter))
+			if err != nil {
+				t.Error("error marshal payload err:", err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/dashboard/request", bytes.NewReader(body))
+
+			handler.GetAdminRequestDashboard(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
+
+type AdminDashboardSortTestCase struct {
+	name           string
+	sort           []projects.DashboardSort
+	expectedStatus int
+	expectedError  error
+}
+
+func TestAdminStartedDashboardSort(t *testing.T) {
+	tests := []AdminDashboardSortTestCase{
+		{
+			name:           "should error when sort is missing",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.SortRequiredError{},
+		},
+		{
+			name:           "should error when sort key is raw SQL",
+			sort:           []projects.DashboardSort{{Key: "project_history.project_name", Direction: "asc"}},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.SortKeyInvalidError{Key: "project_history.project_name"},
+		},
+		{
+			name:           "should error when direction is invalid",
+			sort:           []projects.DashboardSort{{Key: "name", Direction: "ASC; DROP TABLE project"}},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.SortDirectionInvalidError{Key: "name"},
+		},
+		{
+			name:           "should error when sort key is duplicated",
+			sort:           []projects.DashboardSort{{Key: "name", Direction: "asc"}, {Key: "name", Direction: "desc"}},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.SortKeyDuplicatedError{Key: "name"},
+		},
+		{
+			name: "should error when there are too many sort keys",
+			sort: []projects.DashboardSort{
+				{Key: "status", Direction: "asc"},
+				{Key: "name", Direction: "asc"},
+				{Key: "avgScore", Direction: "desc"},
+				{Key: "updatedAt", Direction: "desc"},
+				{Key: "code", Direction: "asc"},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.SortTooManyKeysError{},
+		},
+		{
+			name:           "should accept multiple sort keys",
+			sort:           []projects.DashboardSort{{Key: "status", Direction: "asc"}, {Key: "avgScore", Direction: "desc"}},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mock.MockProjectStore{
+				GetAdminStartedDashboardFunc: func(fromDate, toDate time.Time, sort []projects.DashboardSort, limit int, cursor *string, filter projects.AdminDashboardFilter) (projects.AdminDashboardPage, error) {
+					if len(sort) != len(tt.sort) {
+						t.Errorf("got %d sort keys, want %d", len(sort), len(tt.sort))
+					}
+					return projects.AdminDashboardPage{Items: []projects.AdminRequestDashboardRow{}}, nil
+				},
+			}
+			handler := projects.NewProjectHandler(store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			payload := dashboardPayload(projects.AdminDashboardFilter{})
+			payload.Sort = tt.sort
+			body, err := json.Marshal(payload)
+			if err != nil {
+				t.Error("error marshal payload err:", err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/dashboard/started", bytes.NewReader(body))
+
+			handler.GetAdminStartedDashboard(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
+
+func TestAdminDashboardCursor(t *testing.T) {
+	t.Run("should error when page size is over the maximum", func(t *testing.T) {
+		handler := projects.NewProjectHandler(&mock.MockProjectStore{}, &mock.MockUserStore{}, s3Service.S3Service{})
+		payload := dashboardPayload(projects.AdminDashboardFilter{})
+		payload.PageSize = projects.DASHBOARD_PAGE_SIZE_MAX + 1
+		body, _ := json.Marshal(payload)
+		res := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/admin/dashboard/request", bytes.NewReader(body))
+
+		handler.GetAdminRequestDashboard(res, req)
+		assertStatus(t, res.Code, http.StatusBadRequest)
+		assertErrorMessage(t, getErrorResponse(t, res).Message, (&projects.PageSizeInvalidError{}).Error())
+	})
+
+	t.Run("should error when cursor is rejected by the store", func(t *testing.T) {
+		store := &mock.MockProjectStore{
+			GetAdminRequestDashboardFunc: func(fromDate, toDate time.Time, sort []projects.DashboardSort, limit int, cursor *string, filter projects.AdminDashboardFilter) (projects.AdminDashboardPage, error) {
+				return projects.AdminDashboardPage{}, &projects.DashboardCursorInvalidError{}
+			},
+		}
+		handler := projects.NewProjectHandler(store, &mock.MockUserStore{}, s3Service.S3Service{})
+		payload := dashboardPayload(projects.AdminDashboardFilter{})
+		cursor := "not-a-cursor"
+		payload.Cursor = &cursor
+		body, _ := json.Marshal(payload)
+		res := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/admin/dashboard/request", bytes.NewReader(body))
+
+		handler.GetAdminRequestDashboard(res, req)
+		assertStatus(t, res.Code, http.StatusBadRequest)
+		assertErrorMessage(t, getErrorResponse(t, res).Message, (&projects.DashboardCursorInvalidError{}).Error())
+	})
+
+	t.Run("should pass cursor and page size to the store", func(t *testing.T) {
+		next := "next"
+		store := &mock.MockProjectStore{
+			GetAdminRequestDashboardFunc: func(fromDate, toDate time.Time, sort []projects.DashboardSort, limit int, cursor *string, filter projects.AdminDashboardFilter) (projects.AdminDashboardPage, error) {
+				if cursor == nil || *cursor != "abc" {
+					t.Errorf("got cursor %v, want abc", cursor)
+				}
+				if limit != 10 {
+					t.Errorf("got limit %d, want 10", limit)
+				}
+				return projects.AdminDashboardPage{Items: []projects.AdminRequestDashboardRow{{ProjectCode: "APR67_0501"}}, NextCursor: &next}, nil
+			},
+		}
+		handler := projects.NewProjectHandler(store, &mock.MockUserStore{}, s3Service.S3Service{})
+		payload := dashboardPayload(projects.AdminDashboardFilter{})
+		cursor := "abc"
+		payload.Cursor = &cursor
+		body, _ := json.Marshal(payload)
+		res := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/admin/dashboard/request", bytes.NewReader(body))
+
+		handler.GetAdminRequestDashboard(res, req)
+		assertStatus(t, res.Code, http.StatusOK)
+		var page projects.AdminDashboardPage
+		err := json.Unmarshal(res.Body.Bytes(), &page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page.NextCursor == nil || *page.NextCursor != next || len(page.Items) != 1 {
+			t.Errorf("unexpected page %+v", page)
+		}
+	})
+}
+
+func TestAdminDashboardCount(t *testing.T) {
+	t.Run("should error when dashboard is invalid", func(t *testing.T) {
+		handler := projects.NewProjectHandler(&mock.MockProjectStore{}, &mock.MockUserStore{}, s3Service.S3Service{})
+		body, _ := json.Marshal(projects.GetAdminDashboardCountRequest{Dashboard: "all"})
+		res := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/admin/dashboard/count", bytes.NewReader(body))
+
+		handler.GetAdminDashboardCount(res, req)
+		assertStatus(t, res.Code, http.StatusBadRequest)
+		assertErrorMessage(t, getErrorResponse(t, res).Message, (&projects.DashboardTypeInvalidError{}).Error())
+	})
+
+	t.Run("should return counts from the store", func(t *testing.T) {
+		store := &mock.MockProjectStore{
+			GetAdminDashboardCountFunc: func(dashboardType string, fromDate, toDate time.Time, filter projects.AdminDashboardFilter) (projects.AdminDashboardCount, error) {
+				if dashboardType != "started" {
+					t.Errorf("got dashboard %s, want started", dashboardType)
+				}
+				return projects.AdminDashboardCount{Total: 3, ByStatus: map[string]int{"Start": 2, "Completed": 1}}, nil
+			},
+		}
+		handler := projects.NewProjectHandler(store, &mock.MockUserStore{}, s3Service.S3Service{})
+		body, _ := json.Marshal(projects.GetAdminDashboardCountRequest{
+			Dashboard: "started",
+			FromYear:  2024,
+			FromMonth: 1,
+			FromDay:   1,
+			ToYear:    2024,
+			ToMonth:   12,
+			ToDay:     31,
+		})
+		res := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/admin/dashboard/count", bytes.NewReader(body))
+
+		handler.GetAdminDashboardCount(res, req)
+		assertStatus(t, res.Code, http.StatusOK)
+		var count projects.AdminDashboardCount
+		err := json.Unmarshal(res.Body.Bytes(), &count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count.Total != 3 || count.ByStatus["Start"] != 2 {
+			t.Errorf("unexpected count %+v", count)
+		}
+	})
+}
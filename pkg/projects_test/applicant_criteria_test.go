@@ -0,0 +1,162 @@
+package projects_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+func criteriaItem(orderNumber int) projects.ApplicantCriteriaItem {
+	return projects.ApplicantCriteriaItem{OrderNumber: orderNumber, Display: "display", PdfDisplay: "pdf display"}
+}
+
+func TestAddApplicantCriteriaDraft(t *testing.T) {
+	tests := []struct {
+		name           string
+		payload        projects.ApplicantCriteriaRequest
+		expectedStatus int
+		expectedError  error
+		expectedAdded  bool
+	}{
+		{
+			name:           "should error when items are empty",
+			payload:        projects.ApplicantCriteriaRequest{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ApplicantCriteriaItemsRequiredError{},
+		},
+		{
+			name:           "should error when orderNumber is duplicated",
+			payload:        projects.ApplicantCriteriaRequest{Items: []projects.ApplicantCriteriaItem{criteriaItem(1), criteriaItem(1)}},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ApplicantCriteriaOrderNumberInvalidError{Count: 2},
+		},
+		{
+			name:           "should error when orderNumber skips a number",
+			payload:        projects.ApplicantCriteriaRequest{Items: []projects.ApplicantCriteriaItem{criteriaItem(1), criteriaItem(3)}},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ApplicantCriteriaOrderNumberInvalidError{Count: 2},
+		},
+		{
+			name: "should error when pdfDisplay is blank",
+			payload: projects.ApplicantCriteriaRequest{Items: []projects.ApplicantCriteriaItem{
+				criteriaItem(1),
+				{OrderNumber: 2, Display: "display", PdfDisplay: "  "},
+			}},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ApplicantCriteriaTextRequiredError{Name: "items[1].pdfDisplay"},
+		},
+		{
+			name: "should error when display is too long",
+			payload: projects.ApplicantCriteriaRequest{Items: []projects.ApplicantCriteriaItem{
+				{OrderNumber: 1, Display: strings.Repeat("ก", projects.APPLICANT_CRITERIA_TEXT_MAX_LENGTH+1), PdfDisplay: "pdf display"},
+			}},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ApplicantCriteriaTextTooLongError{Name: "items[0].display"},
+		},
+		{
+			name:           "should add a draft in any item order",
+			payload:        projects.ApplicantCriteriaRequest{Items: []projects.ApplicantCriteriaItem{criteriaItem(2), criteriaItem(1)}},
+			expectedStatus: http.StatusCreated,
+			expectedAdded:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added := false
+			store := &mock.MockProjectStore{
+				AddApplicantCriteriaDraftFunc: func(items []projects.ApplicantCriteriaItem) (int, error) {
+					added = true
+					return 2, nil
+				},
+			}
+			handler := projects.NewProjectHandler(store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			body, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Error("error marshal payload err:", err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/applicant-criteria", bytes.NewReader(body))
+
+			handler.AddApplicantCriteriaDraft(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if added != tt.expectedAdded {
+				t.Errorf("got added %v, want %v", added, tt.expectedAdded)
+			}
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
+
+func TestPublishApplicantCriteria(t *testing.T) {
+	tests := []struct {
+		name            string
+		criteriaVersion string
+		publishErr      error
+		expectedStatus  int
+		expectedError   error
+	}{
+		{
+			name:            "should error when version is not a number",
+			criteriaVersion: "latest",
+			expectedStatus:  http.StatusNotFound,
+			expectedError:   &projects.ApplicantCriteriaVersionNotFoundError{},
+		},
+		{
+			name:            "should error when version does not exist",
+			criteriaVersion: "9",
+			publishErr:      &projects.ApplicantCriteriaVersionNotFoundError{},
+			expectedStatus:  http.StatusNotFound,
+			expectedError:   &projects.ApplicantCriteriaVersionNotFoundError{},
+		},
+		{
+			name:            "should error when version is already published",
+			criteriaVersion: "1",
+			publishErr:      &projects.ApplicantCriteriaVersionPublishedError{Version: 1},
+			expectedStatus:  http.StatusConflict,
+			expectedError:   &projects.ApplicantCriteriaVersionPublishedError{Version: 1},
+		},
+		{
+			name:            "should publish a draft",
+			criteriaVersion: "2",
+			expectedStatus:  http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mock.MockProjectStore{
+				PublishApplicantCriteriaFunc: func(criteriaVersion int) error {
+					return tt.publishErr
+				},
+			}
+			handler := projects.NewProjectHandler(store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/applicant-criteria/"+tt.criteriaVersion+"/publish", nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("criteriaVersion", tt.criteriaVersion)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			handler.PublishApplicantCriteria(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package projects_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+func TestGetApplicantProjectDetails(t *testing.T) {
+	tests := []struct {
+		name            string
+		userRole        string
+		query           string
+		detailsErr      error
+		expectedStatus  int
+		expectedError   error
+		expectedIsAdmin bool
+	}{
+		{
+			name:           "should deny reviewers",
+			userRole:       "reviewer",
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errors.New("access denied. No permission"),
+		},
+		{
+			name:           "should error when project is not found",
+			userRole:       "applicant",
+			detailsErr:     errors.New("sql: no rows in result set"),
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errors.New("sql: no rows in result set"),
+		},
+		{
+			name:           "should serve the reviews as a bare array to applicants",
+			userRole:       "applicant",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:            "should serve the reviews as a bare array to admins",
+			userRole:        "admin",
+			expectedStatus:  http.StatusOK,
+			expectedIsAdmin: true,
+		},
+		{
+			name:           "should include messages when asked to",
+			userRole:       "applicant",
+			query:          "?include=messages",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// GetProjectMessagesFunc is only set when messages are asked for, the default path must not call it
+			store := &mock.MockProjectStore{
+				GetApplicantProjectDetailsFunc: func(isAdmin bool, projectCode string, userId int) ([]projects.ApplicantDetailsData, error) {
+					if isAdmin != tt.expectedIsAdmin || projectCode != "APR67_0501" || userId != 2 {
+						t.Errorf("unexpected store args %v %s %d", isAdmin, projectCode, userId)
+					}
+					if tt.detailsErr != nil {
+						return nil, tt.detailsErr
+					}
+					return []projects.ApplicantDetailsData{
+						{ProjectCode: projectCode, UserId: 2, ProjectName: "วิ่งเพื่อสุขภาพ", ProjectStatus: "Reviewed", ReviewId: newInt(1)},
+						{ProjectCode: projectCode, UserId: 2, ProjectName: "วิ่งเพื่อสุขภาพ", ProjectStatus: "Reviewed", ReviewId: newInt(2)},
+					}, nil
+				},
+			}
+			if tt.query != "" {
+				store.GetProjectMessagesFunc = func(projectCode string) ([]projects.ProjectMessage, error) {
+					return []projects.ProjectMessage{{Id: 1, ProjectCode: projectCode, SenderId: 2, SenderRole: "applicant", Body: "สวัสดี"}}, nil
+				}
+			}
+			handler := projects.NewProjectHandler(store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/applicant/project/details/APR67_0501"+tt.query, nil)
+			req.Header.Set("userId", "2")
+			req.Header.Set("userRole", tt.userRole)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("projectCode", "APR67_0501")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			handler.GetApplicantProjectDetails(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				assertErrorMessage(t, getErrorResponse(t, res).Message, tt.expectedError.Error())
+				return
+			}
+			if tt.query != "" {
+				var got projects.ApplicantProjectDetailsResponse
+				err := json.Unmarshal(res.Body.Bytes(), &got)
+				if err != nil {
+					t.Fatalf("response is not an object: %v", err)
+				}
+				if len(got.ProjectDetails) != 2 || len(got.Messages) != 1 {
+					t.Errorf("got %d rows and %d messages, want 2 and 1", len(got.ProjectDetails), len(got.Messages))
+				}
+				return
+			}
+			var got []projects.ApplicantDetailsData
+			err := json.Unmarshal(res.Body.Bytes(), &got)
+			if err != nil {
+				t.Fatalf("response is not a bare array: %v", err)
+			}
+			if len(got) != 2 {
+				t.Errorf("got %d rows, want 2", len(got))
+			}
+		})
+	}
+}
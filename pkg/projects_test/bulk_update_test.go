@@ -0,0 +1,200 @@
+package projects_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+type BulkUpdateTestCase struct {
+	name             string
+	payload          projects.BulkAdminUpdateRequest
+	applyErr         error
+	expectedStatus   int
+	expectedError    error
+	expectedApplied  bool
+	expectedRejected int
+	expectedItems    []projects.BulkAdminUpdateItem
+	expectedParams   int
+}
+
+func newStringPtr(v string) *string {
+	return &v
+}
+
+var bulkCurrentProjects = []projects.AdminBulkUpdateParam{
+	{ProjectCode: "APR67_0501", ProjectHistoryId: 1, ProjectStatus: "Reviewed", UpdatedAt: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+	{ProjectCode: "APR67_0502", ProjectHistoryId: 2, ProjectStatus: "Approved", FundApprovedAmount: newInt64Ptr(50000), UpdatedAt: time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)},
+	{ProjectCode: "APR67_0503", ProjectHistoryId: 3, ProjectStatus: "Withdrawn", UpdatedAt: time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC)},
+	{ProjectCode: "APR67_0504", ProjectHistoryId: 4, ProjectStatus: "Completed", UpdatedAt: time.Date(2024, 4, 4, 0, 0, 0, 0, time.UTC)},
+}
+
+func TestBulkUpdateProjects(t *testing.T) {
+	tests := []BulkUpdateTestCase{
+		{
+			name:           "should error when projectCodes is empty",
+			payload:        projects.BulkAdminUpdateRequest{ProjectStatus: newStringPtr("Approved")},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.BulkProjectCodesRequiredError{},
+		},
+		{
+			name:           "should error when projectCode is duplicated",
+			payload:        projects.BulkAdminUpdateRequest{ProjectCodes: []string{"APR67_0501", "APR67_0501"}, ProjectStatus: newStringPtr("Approved")},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.BulkProjectCodeDuplicatedError{ProjectCode: "APR67_0501"},
+		},
+		{
+			name:           "should error when there is nothing to update",
+			payload:        projects.BulkAdminUpdateRequest{ProjectCodes: []string{"APR67_0501"}},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.BulkNothingToUpdateError{},
+		},
+		{
+			name:           "should error when status is invalid",
+			payload:        projects.BulkAdminUpdateRequest{ProjectCodes: []string{"APR67_0501"}, ProjectStatus: newStringPtr("Done")},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectStatusInvalidError{},
+		},
+		{
+			name: "should report every invalid project and apply nothing",
+			payload: projects.BulkAdminUpdateRequest{
+				ProjectCodes:  []string{"APR67_0501", "APR67_0503", "APR67_9999"},
+				ProjectStatus: newStringPtr("NotApproved"),
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedRejected: 2,
+			expectedItems: []projects.BulkAdminUpdateItem{
+				{ProjectCode: "APR67_0501", Ok: true, Changes: []projects.BulkFieldChange{{Field: "projectStatus"}}},
+				{ProjectCode: "APR67_0503", Error: (&projects.ProjectClosedError{Status: "Withdrawn"}).Error()},
+				{ProjectCode: "APR67_9999", Error: (&projects.ProjectNotFoundError{}).Error()},
+			},
+		},
+		{
+			name: "should show changes without applying in dry run",
+			payload: projects.BulkAdminUpdateRequest{
+				ProjectCodes:       []string{"APR67_0501", "APR67_0502"},
+				ProjectStatus:      newStringPtr("Approved"),
+				FundApprovedAmount: newInt64Ptr(50000),
+				DryRun:             true,
+			},
+			expectedStatus: http.StatusOK,
+			expectedItems: []projects.BulkAdminUpdateItem{
+				{ProjectCode: "APR67_0501", Ok: true, Changes: []projects.BulkFieldChange{{Field: "projectStatus"}, {Field: "adminApprovedAt"}, {Field: "fundApprovedAmount"}}},
+				{ProjectCode: "APR67_0502", Ok: true},
+			},
+		},
+		{
+			name: "should report projects that can not move to the status in dry run",
+			payload: projects.BulkAdminUpdateRequest{
+				ProjectCodes:  []string{"APR67_0501", "APR67_0504"},
+				ProjectStatus: newStringPtr("Reviewing"),
+				DryRun:        true,
+			},
+			expectedStatus:   http.StatusOK,
+			expectedRejected: 1,
+			expectedItems: []projects.BulkAdminUpdateItem{
+				{ProjectCode: "APR67_0501", Ok: true, Changes: []projects.BulkFieldChange{{Field: "projectStatus"}}},
+				{ProjectCode: "APR67_0504", Error: (&projects.ProjectStatusTransitionInvalidError{From: "Completed", To: "Reviewing"}).Error()},
+			},
+		},
+		{
+			name: "should apply only projects that change",
+			payload: projects.BulkAdminUpdateRequest{
+				ProjectCodes:       []string{"APR67_0501", "APR67_0502"},
+				FundApprovedAmount: newInt64Ptr(50000),
+			},
+			expectedStatus:  http.StatusOK,
+			expectedApplied: true,
+			expectedParams:  1,
+		},
+		{
+			name: "should error when a project changed since it was read",
+			payload: projects.BulkAdminUpdateRequest{
+				ProjectCodes: []string{"APR67_0501"},
+				AdminComment: newStringPtr("ok"),
+			},
+			applyErr:       &projects.BulkUpdateConflictError{ProjectCode: "APR67_0501"},
+			expectedStatus: http.StatusConflict,
+			expectedError:  &projects.BulkUpdateConflictError{ProjectCode: "APR67_0501"},
+			expectedParams: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appliedParams := 0
+			store := &mock.MockProjectStore{
+				GetProjectsForAdminUpdateFunc: func(projectCodes []string) ([]projects.AdminBulkUpdateParam, error) {
+					return bulkCurrentProjects, nil
+				},
+				BulkUpdateProjectsByAdminFunc: func(params []projects.AdminBulkUpdateParam) error {
+					appliedParams = len(params)
+					for _, p := range params {
+						if p.ExpectedUpdatedAt.IsZero() {
+							t.Errorf("expected updated_at is not set for %s", p.ProjectCode)
+						}
+					}
+					return tt.applyErr
+				},
+			}
+			handler := projects.NewProjectHandler(store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			body, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Error("error marshal payload err:", err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/project/bulk", bytes.NewReader(body))
+
+			handler.BulkUpdateProjects(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if appliedParams != tt.expectedParams {
+				t.Errorf("got %d applied projects, want %d", appliedParams, tt.expectedParams)
+			}
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+				return
+			}
+			var got projects.BulkAdminUpdateResponse
+			err = json.Unmarshal(res.Body.Bytes(), &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Applied != tt.expectedApplied {
+				t.Errorf("got applied %v, want %v", got.Applied, tt.expectedApplied)
+			}
+			if got.Rejected != tt.expectedRejected {
+				t.Errorf("got %d rejected projects, want %d", got.Rejected, tt.expectedRejected)
+			}
+			if tt.expectedItems == nil {
+				return
+			}
+			if len(got.Items) != len(tt.expectedItems) {
+				t.Fatalf("got %d items, want %d", len(got.Items), len(tt.expectedItems))
+			}
+			for i, want := range tt.expectedItems {
+				item := got.Items[i]
+				if item.ProjectCode != want.ProjectCode || item.Ok != want.Ok || item.Error != want.Error {
+					t.Errorf("got item %+v, want %+v", item, want)
+				}
+				if len(item.Changes) != len(want.Changes) {
+					t.Errorf("got %d changes for %s, want %d", len(item.Changes), item.ProjectCode, len(want.Changes))
+					continue
+				}
+				for j := range want.Changes {
+					if item.Changes[j].Field != want.Changes[j].Field {
+						t.Errorf("got change %s, want %s", item.Changes[j].Field, want.Changes[j].Field)
+					}
+				}
+			}
+		})
+	}
+}
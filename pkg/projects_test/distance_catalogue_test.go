@@ -0,0 +1,150 @@
+package projects_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+func TestMapDistanceType(t *testing.T) {
+	tests := []struct {
+		raw        string
+		expectedOk bool
+		code       string
+		km         *float64
+	}{
+		{raw: "10K", expectedOk: true, code: "mini", km: newFloat64(10)},
+		{raw: "10 km", expectedOk: true, code: "mini", km: newFloat64(10)},
+		{raw: "มินิมาราธอน", expectedOk: true, code: "mini", km: newFloat64(10)},
+		{raw: "Half Marathon", expectedOk: true, code: "half", km: newFloat64(21.0975)},
+		{raw: "42k", expectedOk: true, code: "full", km: newFloat64(42.195)},
+		{raw: "Fun Run 5 km", expectedOk: true, code: "fun", km: newFloat64(5)},
+		{raw: "5 km", expectedOk: true, code: "fun", km: newFloat64(5)},
+		{raw: "15 กม.", expectedOk: true, code: "custom", km: newFloat64(15)},
+		{raw: "Ultra 50K", expectedOk: true, code: "ultra", km: newFloat64(50)},
+		{raw: "ultra", expectedOk: false},
+		{raw: "iron man", expectedOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			code, km, ok := projects.MapDistanceType(tt.raw)
+			if ok != tt.expectedOk {
+				t.Fatalf("got ok %v, want %v", ok, tt.expectedOk)
+			}
+			if code != tt.code {
+				t.Errorf("got code %q, want %q", code, tt.code)
+			}
+			if (km == nil) != (tt.km == nil) || (km != nil && *km != *tt.km) {
+				t.Errorf("got km %v, want %v", km, tt.km)
+			}
+		})
+	}
+}
+
+var unmappedDistanceTypes = []projects.DistanceTypeCount{
+	{Type: "10K", Rows: 4},
+	{Type: "Half Marathon", Rows: 2},
+	{Type: "iron man", Rows: 1},
+}
+
+func TestMapDistances(t *testing.T) {
+	tests := []struct {
+		name             string
+		payload          projects.MapDistancesRequest
+		expectedStatus   int
+		expectedError    error
+		expectedApplied  []string
+		expectedUnmapped int
+	}{
+		{
+			name:             "should not update anything in dry run",
+			payload:          projects.MapDistancesRequest{DryRun: true},
+			expectedStatus:   http.StatusOK,
+			expectedUnmapped: 1,
+		},
+		{
+			name:             "should update only the types it could map",
+			payload:          projects.MapDistancesRequest{},
+			expectedStatus:   http.StatusOK,
+			expectedApplied:  []string{"10K", "Half Marathon"},
+			expectedUnmapped: 1,
+		},
+		{
+			name: "should map the rest with overrides",
+			payload: projects.MapDistancesRequest{
+				Overrides: map[string]projects.DistanceMapping{"iron man": {Code: "custom", Km: newFloat64(3.8)}},
+			},
+			expectedStatus:  http.StatusOK,
+			expectedApplied: []string{"10K", "Half Marathon", "iron man"},
+		},
+		{
+			name: "should error when an override is not a valid catalogue choice",
+			payload: projects.MapDistancesRequest{
+				Overrides: map[string]projects.DistanceMapping{"iron man": {Code: "custom"}},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &projects.DistanceOverrideInvalidError{
+				Type: "iron man",
+				Err:  &projects.DistanceKmRequiredError{Code: "custom"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var applied []string
+			store := &mock.MockProjectStore{
+				GetUnmappedDistanceTypesFunc: func() ([]projects.DistanceTypeCount, error) {
+					return unmappedDistanceTypes, nil
+				},
+				MapDistanceTypesFunc: func(mappings []projects.MappedDistanceType) (int64, error) {
+					rows := 0
+					for _, m := range mappings {
+						applied = append(applied, m.Type)
+						rows += m.Rows
+					}
+					return int64(rows), nil
+				},
+			}
+			handler := projects.NewProjectHandler(store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			body, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Error("error marshal payload err:", err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/distance/map", bytes.NewReader(body))
+
+			handler.MapDistances(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+				return
+			}
+			if len(applied) != len(tt.expectedApplied) {
+				t.Fatalf("got applied %v, want %v", applied, tt.expectedApplied)
+			}
+			for i := range applied {
+				if applied[i] != tt.expectedApplied[i] {
+					t.Errorf("got applied %v, want %v", applied, tt.expectedApplied)
+				}
+			}
+			var got projects.MapDistancesResponse
+			err = json.Unmarshal(res.Body.Bytes(), &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got.Unmapped) != tt.expectedUnmapped {
+				t.Errorf("got %d unmapped, want %d", len(got.Unmapped), tt.expectedUnmapped)
+			}
+		})
+	}
+}
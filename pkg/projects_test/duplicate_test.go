@@ -0,0 +1,136 @@
+package projects_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+type ResolveDuplicateTestCase struct {
+	name           string
+	payload        projects.ResolveDuplicateRequest
+	store          *mock.MockProjectStore
+	expectedStatus int
+	expectedError  error
+}
+
+func TestResolveDuplicate(t *testing.T) {
+	tests := []ResolveDuplicateTestCase{
+		{
+			name:           "should error when action is missing",
+			payload:        projects.ResolveDuplicateRequest{},
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.DuplicateActionInvalidError{},
+		},
+		{
+			name:           "should error when action is invalid",
+			payload:        projects.ResolveDuplicateRequest{Action: "delete"},
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.DuplicateActionInvalidError{},
+		},
+		{
+			name:    "should error when duplicate is not found",
+			payload: projects.ResolveDuplicateRequest{Action: "link"},
+			store: &mock.MockProjectStore{
+				ResolveDuplicateFunc: func(duplicateId int, action string, adminId int) error {
+					return &projects.DuplicateNotFoundError{}
+				},
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  &projects.DuplicateNotFoundError{},
+		},
+		{
+			name:    "should error when duplicate is already resolved",
+			payload: projects.ResolveDuplicateRequest{Action: "dismiss"},
+			store: &mock.MockProjectStore{
+				ResolveDuplicateFunc: func(duplicateId int, action string, adminId int) error {
+					return &projects.DuplicateAlreadyResolvedError{Status: "Linked"}
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.DuplicateAlreadyResolvedError{Status: "Linked"},
+		},
+		{
+			name:    "should error when project can not be closed as duplicate",
+			payload: projects.ResolveDuplicateRequest{Action: "close-as-duplicate"},
+			store: &mock.MockProjectStore{
+				ResolveDuplicateFunc: func(duplicateId int, action string, adminId int) error {
+					return &projects.ProjectNotClosableAsDuplicateError{}
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectNotClosableAsDuplicateError{},
+		},
+		{
+			name:    "should close project as duplicate",
+			payload: projects.ResolveDuplicateRequest{Action: "close-as-duplicate"},
+			store: &mock.MockProjectStore{
+				ResolveDuplicateFunc: func(duplicateId int, action string, adminId int) error {
+					if duplicateId != 7 || action != "close-as-duplicate" || adminId != 1 {
+						t.Errorf("unexpected resolve args %d %s %d", duplicateId, action, adminId)
+					}
+					return nil
+				},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "should error when merge target is closed",
+			payload: projects.ResolveDuplicateRequest{Action: "merge"},
+			store: &mock.MockProjectStore{
+				ResolveDuplicateFunc: func(duplicateId int, action string, adminId int) error {
+					return &projects.DuplicateMergeTargetClosedError{ProjectCode: "RF2610190001", Status: "Withdrawn"}
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.DuplicateMergeTargetClosedError{ProjectCode: "RF2610190001", Status: "Withdrawn"},
+		},
+		{
+			name:    "should merge duplicate",
+			payload: projects.ResolveDuplicateRequest{Action: "merge"},
+			store: &mock.MockProjectStore{
+				ResolveDuplicateFunc: func(duplicateId int, action string, adminId int) error {
+					if duplicateId != 7 || action != "merge" || adminId != 1 {
+						t.Errorf("unexpected resolve args %d %s %d", duplicateId, action, adminId)
+					}
+					return nil
+				},
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := projects.NewProjectHandler(tt.store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			body, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Error("error marshal payload err:", err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/project/duplicate/7/resolve", bytes.NewReader(body))
+			req.Header.Set("userId", "1")
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("duplicateId", "7")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			handler.ResolveDuplicate(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
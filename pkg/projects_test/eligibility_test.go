@@ -0,0 +1,179 @@
+package projects_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+	"github.com/poomipat-k/running-fund/pkg/utils"
+)
+
+type EligibilityTestCase struct {
+	name              string
+	payload           projects.AddProjectRequest
+	rules             []projects.EligibilityRule
+	orgProposalCount  int
+	expectedRuleTypes []string
+}
+
+func newIntPtr(v int) *int {
+	return &v
+}
+
+func eligibilityPayload(daysFromNow int, noAlcoholSponsor bool, fundAmount int) projects.AddProjectRequest {
+	loc, _ := time.LoadLocation(projects.TIMEZONE)
+	eventDay := time.Now().In(loc).AddDate(0, 0, daysFromNow)
+	return projects.AddProjectRequest{
+		General: projects.AddProjectGeneralDetails{
+			EventDate: projects.EventDate{Year: eventDay.Year(), Month: int(eventDay.Month()), Day: eventDay.Day()},
+		},
+		Contact: projects.Contact{
+			Organization: projects.ContactOrganization{Name: "Running Club", Type: "private_sector"},
+		},
+		Fund: projects.Fund{
+			Budget: projects.Budget{NoAlcoholSponsor: noAlcoholSponsor},
+			Request: projects.FundRequest{
+				Type:    projects.FundRequestType{Fund: true},
+				Details: projects.FundRequestDetails{FundAmount: fundAmount},
+			},
+		},
+	}
+}
+
+var allEligibilityRules = []projects.EligibilityRule{
+	{Id: 1, RuleType: projects.RULE_NO_ALCOHOL_SPONSOR, Enabled: true},
+	{Id: 2, RuleType: projects.RULE_MIN_LEAD_TIME_DAYS, Params: projects.EligibilityRuleParams{Days: newIntPtr(30)}, Enabled: true},
+	{Id: 3, RuleType: projects.RULE_MAX_ORGANIZATION_PROPOSALS, Params: projects.EligibilityRuleParams{Max: newIntPtr(1)}, Enabled: true},
+	{Id: 4, RuleType: projects.RULE_MAX_REQUESTED_FUND_AMOUNT, Params: projects.EligibilityRuleParams{Amount: newIntPtr(50000)}, Enabled: true},
+}
+
+var Eligibility = []TestCase{
+	{
+		name: "should report every failing eligibility rule on submission",
+		payload: projects.AddProjectRequest{
+			Collaborated: newTrue(),
+			General:      GeneralDetailsOkPayload,
+			Contact:      ContactOkPayload,
+			Details:      DetailsOkPayload,
+			Experience:   ExperienceOkPayload,
+			Fund:         FundOkPayload,
+		},
+		collaborationFilesPath: "test.png",
+		marketingFilesPath:     "test.png",
+		routeFilesPath:         "test.png",
+		eventMapFilesPath:      "test.png",
+		eventDetailsFilesPath:  "test.png",
+		store: &mock.MockProjectStore{
+			AddProjectFunc:           addProjectSuccess,
+			GetApplicantCriteriaFunc: getApplicantCriteriaSuccess,
+			GetEnabledEligibilityRulesFunc: func(fundingRoundId int) ([]projects.EligibilityRule, error) {
+				return []projects.EligibilityRule{
+					{Id: 1, RuleType: projects.RULE_NO_ALCOHOL_SPONSOR, Enabled: true},
+					{Id: 3, RuleType: projects.RULE_MAX_ORGANIZATION_PROPOSALS, Params: projects.EligibilityRuleParams{Max: newIntPtr(1)}, Message: newString("ส่งได้รอบละ 1 โครงการ"), Enabled: true},
+					{Id: 4, RuleType: projects.RULE_MAX_REQUESTED_FUND_AMOUNT, Params: projects.EligibilityRuleParams{Amount: newIntPtr(10000)}, Enabled: true},
+				}, nil
+			},
+			CountActiveOrganizationProposalsFunc: func(fundingRoundId int, organizationName string) (int, error) {
+				return 1, nil
+			},
+		},
+		expectedStatus: http.StatusBadRequest,
+		expectedError:  errors.New("ข้อเสนอโครงการไม่ผ่านเกณฑ์คุณสมบัติ"),
+		expectedName:   "/eligibility/maxOrganizationProposals",
+		expectedFieldErrors: []utils.FieldError{
+			{Path: "/eligibility/maxOrganizationProposals", Code: "maxOrganizationProposals", Message: "ส่งได้รอบละ 1 โครงการ"},
+			{Path: "/eligibility/maxRequestedFundAmount", Code: "maxRequestedFundAmount", Message: "จำนวนเงินที่ขอรับการสนับสนุนต้องไม่เกิน 10000 บาท"},
+		},
+	},
+}
+
+func TestCheckEligibility(t *testing.T) {
+	tests := []EligibilityTestCase{
+		{
+			name:              "should be eligible when there is no rule",
+			payload:           eligibilityPayload(1, false, 100000),
+			expectedRuleTypes: []string{},
+		},
+		{
+			name:              "should be eligible when every rule passes",
+			payload:           eligibilityPayload(30, true, 50000),
+			rules:             allEligibilityRules,
+			expectedRuleTypes: []string{},
+		},
+		{
+			name:             "should return every failing rule",
+			payload:          eligibilityPayload(29, false, 100000),
+			rules:            allEligibilityRules,
+			orgProposalCount: 1,
+			expectedRuleTypes: []string{
+				projects.RULE_NO_ALCOHOL_SPONSOR,
+				projects.RULE_MIN_LEAD_TIME_DAYS,
+				projects.RULE_MAX_ORGANIZATION_PROPOSALS,
+				projects.RULE_MAX_REQUESTED_FUND_AMOUNT,
+			},
+		},
+		{
+			name:             "should fail only the organization rule when the organization already has a proposal",
+			payload:          eligibilityPayload(60, true, 30000),
+			rules:            allEligibilityRules,
+			orgProposalCount: 1,
+			expectedRuleTypes: []string{
+				projects.RULE_MAX_ORGANIZATION_PROPOSALS,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mock.MockProjectStore{
+				GetEnabledEligibilityRulesFunc: func(fundingRoundId int) ([]projects.EligibilityRule, error) {
+					return tt.rules, nil
+				},
+				CountActiveOrganizationProposalsFunc: func(fundingRoundId int, organizationName string) (int, error) {
+					return tt.orgProposalCount, nil
+				},
+			}
+			handler := projects.NewProjectHandler(store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			body, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Error("error marshal payload err:", err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/project/eligibility", bytes.NewReader(body))
+			req.Header.Set("userId", "1")
+			req.Header.Set("fundingRoundId", "1")
+			req.Header.Set("applicantCriteriaVersion", "1")
+
+			handler.CheckEligibility(res, req)
+			assertStatus(t, res.Code, http.StatusOK)
+
+			var got projects.EligibilityResponse
+			err = json.Unmarshal(res.Body.Bytes(), &got)
+			if err != nil {
+				t.Fatal("error unmarshal response err:", err)
+			}
+			if got.Eligible != (len(tt.expectedRuleTypes) == 0) {
+				t.Errorf("eligible: got %v, want %v", got.Eligible, len(tt.expectedRuleTypes) == 0)
+			}
+			if len(got.Failures) != len(tt.expectedRuleTypes) {
+				t.Fatalf("failures: got %d, want %d", len(got.Failures), len(tt.expectedRuleTypes))
+			}
+			for i, failure := range got.Failures {
+				if failure.RuleType != tt.expectedRuleTypes[i] {
+					t.Errorf("failures[%d] ruleType: got %s, want %s", i, failure.RuleType, tt.expectedRuleTypes[i])
+				}
+				if failure.Message == "" {
+					t.Errorf("failures[%d] message should not be empty", i)
+				}
+			}
+		})
+	}
+}
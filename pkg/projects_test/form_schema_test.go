@@ -0,0 +1,144 @@
+package projects_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+func TestGetFormSchema(t *testing.T) {
+	tests := []struct {
+		name            string
+		version         string
+		expectedStatus  int
+		expectedVersion int
+	}{
+		{name: "should serve the current schema", expectedStatus: http.StatusOK, expectedVersion: projects.FORM_SCHEMA_VERSION},
+		{name: "should serve a schema by version", version: "1", expectedStatus: http.StatusOK, expectedVersion: 1},
+		{name: "should error when version does not exist", version: "99", expectedStatus: http.StatusNotFound},
+		{name: "should error when version is not a number", version: "latest", expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := projects.NewProjectHandler(&mock.MockProjectStore{}, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/project/form-schema", nil)
+			if tt.version != "" {
+				rctx := chi.NewRouteContext()
+				rctx.URLParams.Add("formSchemaVersion", tt.version)
+				req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+			}
+
+			handler.GetFormSchema(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedStatus != http.StatusOK {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, (&projects.FormSchemaNotFoundError{}).Error())
+				return
+			}
+			var got projects.FormSchema
+			err := json.Unmarshal(res.Body.Bytes(), &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Version != tt.expectedVersion || len(got.Fields) == 0 {
+				t.Errorf("got version %d with %d fields, want version %d", got.Version, len(got.Fields), tt.expectedVersion)
+			}
+		})
+	}
+}
+
+func TestFormSchemaRulesHaveMessages(t *testing.T) {
+	schema, found := projects.GetFormSchema(projects.FORM_SCHEMA_VERSION)
+	if !found {
+		t.Fatal("current form schema not found")
+	}
+	var walk func(prefix string, fields []projects.FormField)
+	walk = func(prefix string, fields []projects.FormField) {
+		for _, f := range fields {
+			path := prefix + "/" + f.Path
+			for _, r := range f.Rules {
+				// custom rules report the message of the Go check they run
+				if r.Rule != "custom" && r.Message == "" {
+					t.Errorf("%s: %s rule has no message", path, r.Rule)
+				}
+				if f.Label == "" {
+					t.Errorf("%s: field with rules has no label", path)
+				}
+				if r.MessageTh == "" && schema.Messages[r.Rule+"."+f.Type] == "" && schema.Messages[r.Rule] == "" {
+					t.Errorf("%s: %s rule has no Thai message", path, r.Rule)
+				}
+			}
+			walk(path, f.Fields)
+			walk(path+"[]", f.Items)
+		}
+	}
+	walk("", schema.Fields)
+}
+
+// assertFormSchemaError checks the first failure is the schema rule for path, the message is read from the schema
+func assertFormSchemaError(t testing.TB, errBody ErrorBody, path, code string) {
+	t.Helper()
+	assertErrorMessage(t, errBody.Name, path)
+	if len(errBody.Errors) == 0 {
+		t.Fatalf("got no field errors, want %s at %s", code, path)
+	}
+	assertErrorMessage(t, errBody.Errors[0].Path, path)
+	assertErrorMessage(t, errBody.Errors[0].Code, code)
+	if errBody.Errors[0].Message == "" {
+		t.Errorf("%s: %s has no Thai message", path, code)
+	}
+	message, found := formSchemaRuleMessage(path, code)
+	if !found {
+		t.Fatalf("%s rule not found at %s in the form schema", code, path)
+	}
+	assertErrorMessage(t, errBody.Message, message)
+}
+
+// formSchemaRuleMessage finds the message of the rule a JSON pointer failed on
+func formSchemaRuleMessage(path, code string) (string, bool) {
+	schema, _ := projects.GetFormSchema(projects.FORM_SCHEMA_VERSION)
+	var find func(fields []projects.FormField, keys []string, index string) (string, bool)
+	find = func(fields []projects.FormField, keys []string, index string) (string, bool) {
+		for _, f := range fields {
+			rest := keys
+			if f.Path != "" {
+				fieldKeys := strings.Split(f.Path, ".")
+				if len(rest) < len(fieldKeys) || !reflect.DeepEqual(rest[:len(fieldKeys)], fieldKeys) {
+					continue
+				}
+				rest = rest[len(fieldKeys):]
+			}
+			if len(rest) == 0 {
+				for _, r := range f.Rules {
+					if r.Rule == code {
+						return strings.ReplaceAll(r.Message, "{index}", index), true
+					}
+				}
+				continue
+			}
+			if message, found := find(f.Fields, rest, index); found {
+				return message, true
+			}
+			if _, err := strconv.Atoi(rest[0]); err == nil {
+				if message, found := find(f.Items, rest[1:], rest[0]); found {
+					return message, true
+				}
+			}
+		}
+		return "", false
+	}
+	return find(schema.Fields, strings.Split(strings.TrimPrefix(path, "/"), "/"), "")
+}
@@ -0,0 +1,131 @@
+package projects_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+func TestGetProjectFullDetails(t *testing.T) {
+	tests := []struct {
+		name             string
+		userRole         string
+		version          string
+		detailsErr       error
+		expectedStatus   int
+		expectedError    error
+		expectedVersion  int
+		expectedMessages int
+	}{
+		{
+			name:           "should deny applicants",
+			userRole:       "applicant",
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errors.New("access denied. No permission"),
+		},
+		{
+			name:           "should deny requests without a role",
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errors.New("access denied. No permission"),
+		},
+		{
+			name:           "should error when version is not a number",
+			userRole:       "admin",
+			version:        "latest",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectVersionInvalidError{},
+		},
+		{
+			name:           "should error when version is zero",
+			userRole:       "admin",
+			version:        "0",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectVersionInvalidError{},
+		},
+		{
+			name:           "should error when version does not exist",
+			userRole:       "reviewer",
+			version:        "9",
+			detailsErr:     &projects.ProjectNotFoundError{},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  &projects.ProjectNotFoundError{},
+		},
+		{
+			name:            "should serve the latest version to reviewers without messages",
+			userRole:        "reviewer",
+			expectedStatus:  http.StatusOK,
+			expectedVersion: 0,
+		},
+		{
+			name:             "should serve a version to admins with messages",
+			userRole:         "admin",
+			version:          "2",
+			expectedStatus:   http.StatusOK,
+			expectedVersion:  2,
+			expectedMessages: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotVersion := -1
+			store := &mock.MockProjectStore{
+				GetProjectFullDetailsFunc: func(projectCode string, version int) (projects.ProjectFullDetailsResponse, error) {
+					gotVersion = version
+					if tt.detailsErr != nil {
+						return projects.ProjectFullDetailsResponse{}, tt.detailsErr
+					}
+					return projects.ProjectFullDetailsResponse{ProjectCode: projectCode, ProjectVersion: 2}, nil
+				},
+				GetProjectMessagesFunc: func(projectCode string) ([]projects.ProjectMessage, error) {
+					if tt.userRole != "admin" {
+						t.Error("messages should only be loaded for admins")
+					}
+					return []projects.ProjectMessage{{Id: 1, ProjectCode: projectCode, Body: "hello"}}, nil
+				},
+			}
+			handler := projects.NewProjectHandler(store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			target := "/project/full-details/APR67_0501"
+			if tt.version != "" {
+				target += "?version=" + tt.version
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			req.Header.Set("userRole", tt.userRole)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("projectCode", "APR67_0501")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			handler.GetProjectFullDetails(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+				if res.Code != http.StatusNotFound && gotVersion != -1 {
+					t.Errorf("store should not be called, got version %d", gotVersion)
+				}
+				return
+			}
+			if gotVersion != tt.expectedVersion {
+				t.Errorf("got version %d, want %d", gotVersion, tt.expectedVersion)
+			}
+			var got projects.ProjectFullDetailsResponse
+			err := json.Unmarshal(res.Body.Bytes(), &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got.Messages) != tt.expectedMessages {
+				t.Errorf("got %d messages, want %d", len(got.Messages), tt.expectedMessages)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package projects_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+)
+
+// These tests need a migrated Postgres database, e.g.
+// TEST_DSN="host=localhost port=5432 user=poomipat password=running_fund_dev dbname=running_fund_dev sslmode=disable" go test ./...
+func openTestDB(t *testing.T) *sql.DB {
+	dsn := os.Getenv("TEST_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DSN is not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Ping(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func resetProjectCodeCounter(t *testing.T, db *sql.DB, date string) {
+	_, err := db.Exec("DELETE FROM project_code_counter WHERE code_date = $1", date)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func allocateProjectCode(db *sql.DB, now time.Time, commit bool) (string, error) {
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+	code, err := projects.NextProjectCode(ctx, tx, now)
+	if err != nil {
+		return "", err
+	}
+	if commit {
+		return code, tx.Commit()
+	}
+	return code, nil
+}
+
+func TestNextProjectCodeConcurrent(t *testing.T) {
+	db := openTestDB(t)
+	loc, _ := time.LoadLocation(projects.TIMEZONE)
+	// far in the future so real projects never share the counter
+	now := time.Date(2099, time.January, 2, 10, 0, 0, 0, loc)
+	resetProjectCodeCounter(t, db, "2099-01-02")
+	t.Cleanup(func() { resetProjectCodeCounter(t, db, "2099-01-02") })
+
+	const n = 30
+	var wg sync.WaitGroup
+	codes := make(chan string, n)
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			code, err := allocateProjectCode(db, now, true)
+			if err != nil {
+				errs <- err
+				return
+			}
+			codes <- code
+		}()
+	}
+	wg.Wait()
+	close(codes)
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	seen := map[string]bool{}
+	for code := range codes {
+		if seen[code] {
+			t.Errorf("project code %s was allocated twice", code)
+		}
+		seen[code] = true
+	}
+	for i := 1; i <= n; i++ {
+		want := fmt.Sprintf("JAN42_02%02d", i)
+		if !seen[want] {
+			t.Errorf("project code %s was not allocated", want)
+		}
+	}
+}
+
+func TestNextProjectCodeRollback(t *testing.T) {
+	db := openTestDB(t)
+	loc, _ := time.LoadLocation(projects.TIMEZONE)
+	// 23:30 in Bangkok is already the next day in UTC, the local day must win
+	now := time.Date(2099, time.February, 3, 23, 30, 0, 0, loc)
+	resetProjectCodeCounter(t, db, "2099-02-03")
+	t.Cleanup(func() { resetProjectCodeCounter(t, db, "2099-02-03") })
+
+	first, err := allocateProjectCode(db, now, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProjectCode(t, first, "FEB42_0301")
+
+	rolledBack, err := allocateProjectCode(db, now, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProjectCode(t, rolledBack, "FEB42_0302")
+
+	next, err := allocateProjectCode(db, now, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProjectCode(t, next, "FEB42_0302")
+}
+
+func assertProjectCode(t *testing.T, got, want string) {
+	t.Helper()
+	if got != want {
+		t.Errorf("project code: got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,174 @@
+package projects_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+type AddProjectMessageTestCase struct {
+	name           string
+	userId         string
+	userRole       string
+	payload        projects.AddProjectMessageRequest
+	attachments    int
+	store          *mock.MockProjectStore
+	expectedStatus int
+	expectedError  error
+}
+
+func projectOwnedBy(userId int) func(projectCode string) (projects.ProjectOwner, error) {
+	return func(projectCode string) (projects.ProjectOwner, error) {
+		return projects.ProjectOwner{UserId: userId, ProjectName: "วิ่งเพื่อสุขภาพ"}, nil
+	}
+}
+
+func TestAddProjectMessage(t *testing.T) {
+	tests := []AddProjectMessageTestCase{
+		{
+			name:           "should error when user is a reviewer",
+			userId:         "5",
+			userRole:       "reviewer",
+			payload:        projects.AddProjectMessageRequest{Body: "hello"},
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:     "should error when project is not found",
+			userId:   "2",
+			userRole: "applicant",
+			payload:  projects.AddProjectMessageRequest{Body: "hello"},
+			store: &mock.MockProjectStore{
+				GetProjectOwnerFunc: func(projectCode string) (projects.ProjectOwner, error) {
+					return projects.ProjectOwner{}, &projects.ProjectNotFoundError{}
+				},
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  &projects.ProjectNotFoundError{},
+		},
+		{
+			name:     "should error when applicant does not own the project",
+			userId:   "3",
+			userRole: "applicant",
+			payload:  projects.AddProjectMessageRequest{Body: "hello"},
+			store: &mock.MockProjectStore{
+				GetProjectOwnerFunc: projectOwnedBy(2),
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  &projects.ProjectNotFoundError{},
+		},
+		{
+			name:     "should error when body and attachments are empty",
+			userId:   "2",
+			userRole: "applicant",
+			payload:  projects.AddProjectMessageRequest{Body: " "},
+			store: &mock.MockProjectStore{
+				GetProjectOwnerFunc: projectOwnedBy(2),
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectMessageRequiredError{},
+		},
+		{
+			name:     "should error when body is too long",
+			userId:   "2",
+			userRole: "applicant",
+			payload:  projects.AddProjectMessageRequest{Body: strings.Repeat("ข", 4001)},
+			store: &mock.MockProjectStore{
+				GetProjectOwnerFunc: projectOwnedBy(2),
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectMessageTooLongError{Length: 4001},
+		},
+		{
+			name:        "should error when there are too many attachments",
+			userId:      "1",
+			userRole:    "admin",
+			attachments: 6,
+			store: &mock.MockProjectStore{
+				GetProjectOwnerFunc: projectOwnedBy(2),
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectMessageTooManyAttachmentsError{},
+		},
+		{
+			name:        "should add applicant message with attachment",
+			userId:      "2",
+			userRole:    "applicant",
+			attachments: 1,
+			store: &mock.MockProjectStore{
+				GetProjectOwnerFunc: projectOwnedBy(2),
+				AddProjectMessageFunc: func(param projects.AddProjectMessageParam, attachments []*multipart.FileHeader) (int, error) {
+					if param.SenderRole != "applicant" || param.SenderId != 2 || param.OwnerId != 2 || len(attachments) != 1 {
+						t.Errorf("unexpected add message args %+v attachments %d", param, len(attachments))
+					}
+					return 4, nil
+				},
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:     "should add admin message to another user's project",
+			userId:   "1",
+			userRole: "admin",
+			payload:  projects.AddProjectMessageRequest{Body: "please send the route map again"},
+			store: &mock.MockProjectStore{
+				GetProjectOwnerFunc: projectOwnedBy(2),
+				AddProjectMessageFunc: func(param projects.AddProjectMessageParam, attachments []*multipart.FileHeader) (int, error) {
+					if param.SenderRole != "admin" || param.SenderId != 1 || param.OwnerId != 2 {
+						t.Errorf("unexpected add message args %+v", param)
+					}
+					return 5, nil
+				},
+			},
+			expectedStatus: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := projects.NewProjectHandler(tt.store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			body := &bytes.Buffer{}
+			multipartWriter := multipart.NewWriter(body)
+			form, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Error("error marshal payload err:", err)
+			}
+			multipartWriter.WriteField("form", string(form))
+			for i := 0; i < tt.attachments; i++ {
+				fw, err := multipartWriter.CreateFormFile("attachments", "test.png")
+				if err != nil {
+					t.Error(err)
+				}
+				fw.Write([]byte("png"))
+			}
+			multipartWriter.Close()
+
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/project/CR67_0101_01/messages", body)
+			req.Header.Add("content-type", multipartWriter.FormDataContentType())
+			req.Header.Set("userId", tt.userId)
+			req.Header.Set("userRole", tt.userRole)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("projectCode", "CR67_0101_01")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			handler.AddProjectMessage(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
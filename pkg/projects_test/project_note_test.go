@@ -0,0 +1,211 @@
+package projects_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+type AddProjectNoteTestCase struct {
+	name           string
+	payload        projects.AddProjectNoteRequest
+	store          *mock.MockProjectStore
+	expectedStatus int
+	expectedError  error
+}
+
+func TestAddProjectNote(t *testing.T) {
+	tests := []AddProjectNoteTestCase{
+		{
+			name:           "should error when body is empty",
+			payload:        projects.AddProjectNoteRequest{Body: "  "},
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectNoteBodyRequiredError{},
+		},
+		{
+			name:           "should error when body is too long",
+			payload:        projects.AddProjectNoteRequest{Body: strings.Repeat("ก", 4001)},
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectNoteTooLongError{Length: 4001},
+		},
+		{
+			name:    "should error when parent note is in another project",
+			payload: projects.AddProjectNoteRequest{Body: "agree", ParentId: newIntPtr(3)},
+			store: &mock.MockProjectStore{
+				AddProjectNoteFunc: func(projectCode string, parentId *int, body string, userId int, createdAt time.Time) (int, error) {
+					return 0, &projects.ProjectNoteParentNotFoundError{}
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectNoteParentNotFoundError{},
+		},
+		{
+			name:    "should error when project is not found",
+			payload: projects.AddProjectNoteRequest{Body: "needs site visit"},
+			store: &mock.MockProjectStore{
+				AddProjectNoteFunc: func(projectCode string, parentId *int, body string, userId int, createdAt time.Time) (int, error) {
+					return 0, &projects.ProjectNotFoundError{}
+				},
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  &projects.ProjectNotFoundError{},
+		},
+		{
+			name:    "should add note",
+			payload: projects.AddProjectNoteRequest{Body: "called the organiser", ParentId: newIntPtr(3)},
+			store: &mock.MockProjectStore{
+				AddProjectNoteFunc: func(projectCode string, parentId *int, body string, userId int, createdAt time.Time) (int, error) {
+					if projectCode != "CR67_0101_01" || *parentId != 3 || userId != 1 {
+						t.Errorf("unexpected add note args %s %d %d", projectCode, *parentId, userId)
+					}
+					return 10, nil
+				},
+			},
+			expectedStatus: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := projects.NewProjectHandler(tt.store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			body, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Error("error marshal payload err:", err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/project/CR67_0101_01/notes", bytes.NewReader(body))
+			req.Header.Set("userId", "1")
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("projectCode", "CR67_0101_01")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			handler.AddProjectNote(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
+
+type UpdateProjectTagsTestCase struct {
+	name           string
+	payload        projects.UpdateProjectTagsRequest
+	store          *mock.MockProjectStore
+	expectedStatus int
+	expectedError  error
+	expectedTags   []string
+}
+
+func TestUpdateProjectTags(t *testing.T) {
+	tooMany := []string{}
+	for i := 0; i < 21; i++ {
+		tooMany = append(tooMany, strings.Repeat("a", i+1))
+	}
+	tests := []UpdateProjectTagsTestCase{
+		{
+			name:           "should error when tag is blank",
+			payload:        projects.UpdateProjectTagsRequest{Tags: []string{"repeat organiser", " "}},
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectTagInvalidError{Tag: " "},
+		},
+		{
+			name:           "should error when tag is too long",
+			payload:        projects.UpdateProjectTagsRequest{Tags: []string{strings.Repeat("x", 65)}},
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectTagInvalidError{Tag: strings.Repeat("x", 65)},
+		},
+		{
+			name:           "should error when there are too many tags",
+			payload:        projects.UpdateProjectTagsRequest{Tags: tooMany},
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectTooManyTagsError{},
+		},
+		{
+			name:    "should error when project is not found",
+			payload: projects.UpdateProjectTagsRequest{Tags: []string{"vip"}},
+			store: &mock.MockProjectStore{
+				ReplaceProjectTagsFunc: func(projectCode string, tags []string, userId int) error {
+					return &projects.ProjectNotFoundError{}
+				},
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  &projects.ProjectNotFoundError{},
+		},
+		{
+			name:    "should normalise and dedupe tags",
+			payload: projects.UpdateProjectTagsRequest{Tags: []string{" Needs  Site Visit", "needs site visit", "Repeat organiser", "งานวิ่งซ้ำ"}},
+			store: &mock.MockProjectStore{
+				ReplaceProjectTagsFunc: func(projectCode string, tags []string, userId int) error {
+					return nil
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedTags:   []string{"needs site visit", "repeat organiser", "งานวิ่งซ้ำ"},
+		},
+		{
+			name:    "should clear tags",
+			payload: projects.UpdateProjectTagsRequest{Tags: []string{}},
+			store: &mock.MockProjectStore{
+				ReplaceProjectTagsFunc: func(projectCode string, tags []string, userId int) error {
+					return nil
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedTags:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var storedTags []string
+			if tt.store.ReplaceProjectTagsFunc != nil {
+				replace := tt.store.ReplaceProjectTagsFunc
+				tt.store.ReplaceProjectTagsFunc = func(projectCode string, tags []string, userId int) error {
+					storedTags = tags
+					return replace(projectCode, tags, userId)
+				}
+			}
+			handler := projects.NewProjectHandler(tt.store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			body, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Error("error marshal payload err:", err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, "/admin/project/CR67_0101_01/tags", bytes.NewReader(body))
+			req.Header.Set("userId", "1")
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("projectCode", "CR67_0101_01")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			handler.UpdateProjectTags(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+			}
+			if tt.expectedTags != nil && !reflect.DeepEqual(storedTags, tt.expectedTags) {
+				t.Errorf("got tags %v want %v", storedTags, tt.expectedTags)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package projects_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/poomipat-k/running-fund/pkg/projects"
+)
+
+type fakeReviseDeadlineStore struct {
+	config        projects.ReviseDeadlineConfig
+	candidates    []projects.ReviseReminderCandidate
+	claimed       map[int]bool
+	claims        []int
+	sent          []string
+	sendErr       error
+	released      []int
+	overdueStatus *string
+	overdueCalled bool
+}
+
+func (s *fakeReviseDeadlineStore) GetReviseDeadlineConfig() (projects.ReviseDeadlineConfig, error) {
+	return s.config, nil
+}
+
+func (s *fakeReviseDeadlineStore) GetReviseReminderCandidates(now time.Time, withinDays int) ([]projects.ReviseReminderCandidate, error) {
+	return s.candidates, nil
+}
+
+func (s *fakeReviseDeadlineStore) ClaimReviseReminder(projectHistoryId int, dueAt time.Time, daysBefore int, now time.Time) (bool, error) {
+	if s.claimed[daysBefore] {
+		return false, nil
+	}
+	s.claimed[daysBefore] = true
+	s.claims = append(s.claims, daysBefore)
+	return true, nil
+}
+
+func (s *fakeReviseDeadlineStore) ReleaseReviseReminders(projectHistoryId int, dueAt time.Time, daysBefore []int) error {
+	for _, d := range daysBefore {
+		delete(s.claimed, d)
+	}
+	s.released = append(s.released, daysBefore...)
+	return nil
+}
+
+func (s *fakeReviseDeadlineStore) SendReviseReminderEmail(candidate projects.ReviseReminderCandidate) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.sent = append(s.sent, candidate.ProjectCode)
+	return nil
+}
+
+func (s *fakeReviseDeadlineStore) MarkOverdueRevisions(now time.Time, overdueStatus *string) ([]string, error) {
+	s.overdueCalled = true
+	s.overdueStatus = overdueStatus
+	return nil, nil
+}
+
+func TestReviseDeadlineJob(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	candidate := func(dueIn time.Duration) []projects.ReviseReminderCandidate {
+		return []projects.ReviseReminderCandidate{{ProjectHistoryId: 1, ProjectCode: "CR69_1001_01", DueAt: now.Add(dueIn)}}
+	}
+
+	tests := []struct {
+		name                  string
+		store                 *fakeReviseDeadlineStore
+		expectedSent          int
+		expectedClaims        []int
+		expectedReleased      []int
+		expectedOverdueStatus *string
+	}{
+		{
+			name: "should send the week reminder when the deadline is five days away",
+			store: &fakeReviseDeadlineStore{
+				config:     projects.ReviseDeadlineConfig{ReminderDays: []int{7, 1}},
+				candidates: candidate(5 * 24 * time.Hour),
+				claimed:    map[int]bool{},
+			},
+			expectedSent:   1,
+			expectedClaims: []int{7},
+		},
+		{
+			name: "should send only one reminder when the deadline is closer than every reminder",
+			store: &fakeReviseDeadlineStore{
+				config:     projects.ReviseDeadlineConfig{ReminderDays: []int{7, 1}},
+				candidates: candidate(12 * time.Hour),
+				claimed:    map[int]bool{},
+			},
+			expectedSent:   1,
+			expectedClaims: []int{1, 7},
+		},
+		{
+			name: "should not send a reminder twice",
+			store: &fakeReviseDeadlineStore{
+				config:     projects.ReviseDeadlineConfig{ReminderDays: []int{7, 1}},
+				candidates: candidate(5 * 24 * time.Hour),
+				claimed:    map[int]bool{7: true},
+			},
+			expectedSent: 0,
+		},
+		{
+			name: "should release the claims when the reminder can not be sent",
+			store: &fakeReviseDeadlineStore{
+				config:     projects.ReviseDeadlineConfig{ReminderDays: []int{7, 1}},
+				candidates: candidate(12 * time.Hour),
+				claimed:    map[int]bool{},
+				sendErr:    errors.New("smtp unavailable"),
+			},
+			expectedSent:     0,
+			expectedClaims:   []int{1, 7},
+			expectedReleased: []int{1, 7},
+		},
+		{
+			name: "should only release the claims made by this run",
+			store: &fakeReviseDeadlineStore{
+				config:     projects.ReviseDeadlineConfig{ReminderDays: []int{7, 1}},
+				candidates: candidate(12 * time.Hour),
+				claimed:    map[int]bool{7: true},
+				sendErr:    errors.New("smtp unavailable"),
+			},
+			expectedSent:     0,
+			expectedClaims:   []int{1},
+			expectedReleased: []int{1},
+		},
+		{
+			name: "should move overdue projects to the configured status",
+			store: &fakeReviseDeadlineStore{
+				config:  projects.ReviseDeadlineConfig{OverdueStatus: newString("NotApproved")},
+				claimed: map[int]bool{},
+			},
+			expectedOverdueStatus: newString("NotApproved"),
+		},
+		{
+			name: "should only flag overdue projects when the configured status is invalid",
+			store: &fakeReviseDeadlineStore{
+				config:  projects.ReviseDeadlineConfig{OverdueStatus: newString("Revise")},
+				claimed: map[int]bool{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := projects.NewReviseDeadlineJob(tt.store).RunOnce(now)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if len(tt.store.sent) != tt.expectedSent {
+				t.Errorf("sent %d reminders, want %d", len(tt.store.sent), tt.expectedSent)
+			}
+			if len(tt.store.claims) != len(tt.expectedClaims) {
+				t.Fatalf("claims %v, want %v", tt.store.claims, tt.expectedClaims)
+			}
+			for i := range tt.expectedClaims {
+				if tt.store.claims[i] != tt.expectedClaims[i] {
+					t.Errorf("claims %v, want %v", tt.store.claims, tt.expectedClaims)
+				}
+			}
+			if len(tt.store.released) != len(tt.expectedReleased) {
+				t.Fatalf("released %v, want %v", tt.store.released, tt.expectedReleased)
+			}
+			for i := range tt.expectedReleased {
+				if tt.store.released[i] != tt.expectedReleased[i] {
+					t.Errorf("released %v, want %v", tt.store.released, tt.expectedReleased)
+				}
+			}
+			if !tt.store.overdueCalled {
+				t.Error("overdue projects were not checked")
+			}
+			if (tt.store.overdueStatus == nil) != (tt.expectedOverdueStatus == nil) ||
+				(tt.expectedOverdueStatus != nil && *tt.store.overdueStatus != *tt.expectedOverdueStatus) {
+				t.Errorf("overdue status %v, want %v", tt.store.overdueStatus, tt.expectedOverdueStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package projects_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/poomipat-k/running-fund/pkg/projects"
+)
+
+const gpxTenKm = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+  <trk>
+    <name>10K</name>
+    <trkseg>
+      <trkpt lat="0" lon="0"><ele>10</ele></trkpt>
+      <trkpt lat="0" lon="0.045"><ele>25</ele></trkpt>
+      <trkpt lat="0" lon="0.09"><ele>20</ele></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+const kmlInFolder = `<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.2">
+  <Document>
+    <Folder>
+      <name>Routes</name>
+      <Placemark>
+        <name>Half</name>
+        <LineString>
+          <coordinates>
+            100.0,13.0,0 100.0,13.19,0
+          </coordinates>
+        </LineString>
+      </Placemark>
+      <Placemark>
+        <name>Start</name>
+        <Point><coordinates>100.0,13.0,0</coordinates></Point>
+      </Placemark>
+    </Folder>
+  </Document>
+</kml>`
+
+func TestParseRouteFile(t *testing.T) {
+	t.Run("should measure a gpx track", func(t *testing.T) {
+		tracks, err := projects.ParseRouteFile("route.gpx", strings.NewReader(gpxTenKm))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tracks) != 1 {
+			t.Fatalf("got %d tracks want 1", len(tracks))
+		}
+		track := tracks[0]
+		if track.Name != "10K" || track.FileName != "route.gpx" {
+			t.Errorf("got name %q file %q", track.Name, track.FileName)
+		}
+		if track.LengthKm < 10.0 || track.LengthKm > 10.02 {
+			t.Errorf("got length %v km want about 10.01", track.LengthKm)
+		}
+		if track.ElevationGainM == nil || *track.ElevationGainM != 15 {
+			t.Errorf("got elevation gain %v want 15", track.ElevationGainM)
+		}
+		if track.BoundingBox != (projects.RouteBoundingBox{MinLat: 0, MinLon: 0, MaxLat: 0, MaxLon: 0.09}) {
+			t.Errorf("got bounding box %+v", track.BoundingBox)
+		}
+	})
+
+	t.Run("should find line placemarks nested in kml folders", func(t *testing.T) {
+		tracks, err := projects.ParseRouteFile("route.KML", strings.NewReader(kmlInFolder))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tracks) != 1 {
+			t.Fatalf("got %d tracks want 1", len(tracks))
+		}
+		if tracks[0].Name != "Half" {
+			t.Errorf("got name %q want Half", tracks[0].Name)
+		}
+		if tracks[0].LengthKm < 21.0 || tracks[0].LengthKm > 21.2 {
+			t.Errorf("got length %v km want about 21.1", tracks[0].LengthKm)
+		}
+		if tracks[0].ElevationGainM == nil || *tracks[0].ElevationGainM != 0 {
+			t.Errorf("got elevation gain %v want 0", tracks[0].ElevationGainM)
+		}
+	})
+
+	t.Run("should reject a broken file", func(t *testing.T) {
+		_, err := projects.ParseRouteFile("route.gpx", strings.NewReader("<gpx><trk>"))
+		var invalid *projects.RouteFileInvalidError
+		if !errors.As(err, &invalid) {
+			t.Errorf("got %v want RouteFileInvalidError", err)
+		}
+	})
+
+	t.Run("should reject coordinates that are not on the globe", func(t *testing.T) {
+		files := map[string]string{
+			"nan.gpx":       `<gpx><trk><trkseg><trkpt lat="NaN" lon="0"/><trkpt lat="0" lon="0.1"/></trkseg></trk></gpx>`,
+			"inf.gpx":       `<gpx><trk><trkseg><trkpt lat="0" lon="+Inf"/><trkpt lat="0" lon="0.1"/></trkseg></trk></gpx>`,
+			"range.gpx":     `<gpx><trk><trkseg><trkpt lat="95" lon="400"/><trkpt lat="0" lon="0.1"/></trkseg></trk></gpx>`,
+			"elevation.gpx": `<gpx><trk><trkseg><trkpt lat="0" lon="0"><ele>NaN</ele></trkpt><trkpt lat="0" lon="0.1"/></trkseg></trk></gpx>`,
+			"range.kml":     `<kml><Placemark><LineString><coordinates>100,13 181,13</coordinates></LineString></Placemark></kml>`,
+		}
+		for fileName, content := range files {
+			_, err := projects.ParseRouteFile(fileName, strings.NewReader(content))
+			var invalid *projects.RouteFileInvalidError
+			if !errors.As(err, &invalid) {
+				t.Errorf("%s: got %v want RouteFileInvalidError", fileName, err)
+			}
+		}
+	})
+
+	t.Run("should cut long names to the column size", func(t *testing.T) {
+		longName := strings.Repeat("ก", 300)
+		content := `<gpx><trk><name>` + longName + `</name><trkseg><trkpt lat="0" lon="0"/><trkpt lat="0" lon="0.1"/></trkseg></trk></gpx>`
+		tracks, err := projects.ParseRouteFile(longName+".gpx", strings.NewReader(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := utf8.RuneCountInString(tracks[0].Name); got != projects.ROUTE_TRACK_NAME_MAX_LENGTH {
+			t.Errorf("got name of %d characters want %d", got, projects.ROUTE_TRACK_NAME_MAX_LENGTH)
+		}
+		if got := utf8.RuneCountInString(tracks[0].FileName); got != projects.ROUTE_TRACK_NAME_MAX_LENGTH {
+			t.Errorf("got file name of %d characters want %d", got, projects.ROUTE_TRACK_NAME_MAX_LENGTH)
+		}
+	})
+
+	t.Run("should reject a file without a line", func(t *testing.T) {
+		_, err := projects.ParseRouteFile("route.gpx", strings.NewReader(`<gpx><trk><trkseg><trkpt lat="1" lon="1"/></trkseg></trk></gpx>`))
+		var noTrack *projects.RouteFileNoTrackError
+		if !errors.As(err, &noTrack) {
+			t.Errorf("got %v want RouteFileNoTrackError", err)
+		}
+	})
+}
+
+func TestCheckRouteDistances(t *testing.T) {
+	distances := []projects.DistanceAndFee{
+		{Checked: true, Type: "mini", Fee: newFloat64(400), Dynamic: newFalse()},
+		{Checked: true, Type: "half", Fee: newFloat64(800), Dynamic: newFalse()},
+		{Checked: true, Type: "5 km", Fee: newFloat64(300), Dynamic: newTrue()},
+		{Checked: true, Type: "iron man", Fee: newFloat64(1000), Dynamic: newTrue()},
+		{Checked: false, Type: "full", Fee: newFloat64(1000), Dynamic: newFalse()},
+	}
+
+	t.Run("should leave distances unverified without tracks", func(t *testing.T) {
+		check := projects.CheckRouteDistances(distances, []projects.RouteTrack{})
+		if check.HasMismatch {
+			t.Error("expected no mismatch without tracks")
+		}
+		if len(check.Distances) != 4 {
+			t.Fatalf("got %d distances want 4 checked ones", len(check.Distances))
+		}
+		for _, d := range check.Distances {
+			if d.Status != projects.DISTANCE_CHECK_UNVERIFIED {
+				t.Errorf("got %s status %s want unverified", d.Type, d.Status)
+			}
+		}
+	})
+
+	t.Run("should match tracks within the tolerance and flag the rest", func(t *testing.T) {
+		check := projects.CheckRouteDistances(distances, []projects.RouteTrack{
+			{Name: "10K", LengthKm: 10.2},
+			{Name: "5K", LengthKm: 4.9},
+			{Name: "Long", LengthKm: 30},
+		})
+		if !check.HasMismatch {
+			t.Error("expected a mismatch")
+		}
+		wantMatched := []*string{newString("mini"), newString("5 km"), nil}
+		for i, track := range check.Tracks {
+			if (track.MatchedDistance == nil) != (wantMatched[i] == nil) ||
+				(track.MatchedDistance != nil && *track.MatchedDistance != *wantMatched[i]) {
+				t.Errorf("track %s got matched %v want %v", track.Name, track.MatchedDistance, wantMatched[i])
+			}
+			if track.Mismatch != (wantMatched[i] == nil) {
+				t.Errorf("track %s got mismatch %v", track.Name, track.Mismatch)
+			}
+		}
+		wantStatus := map[string]string{
+			"mini":     projects.DISTANCE_CHECK_MATCHED,
+			"half":     projects.DISTANCE_CHECK_MISMATCH,
+			"5 km":     projects.DISTANCE_CHECK_MATCHED,
+			"iron man": projects.DISTANCE_CHECK_UNVERIFIED,
+		}
+		for _, d := range check.Distances {
+			if d.Status != wantStatus[d.Type] {
+				t.Errorf("distance %s got %s want %s", d.Type, d.Status, wantStatus[d.Type])
+			}
+		}
+	})
+}
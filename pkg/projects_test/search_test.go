@@ -0,0 +1,124 @@
+package projects_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+type SearchProjectsTestCase struct {
+	name           string
+	payload        projects.SearchProjectsRequest
+	expectedStatus int
+	expectedError  error
+}
+
+func searchPayload(query, dashboard string) projects.SearchProjectsRequest {
+	return projects.SearchProjectsRequest{
+		Query:     query,
+		Dashboard: dashboard,
+		FromYear:  2024,
+		FromMonth: 1,
+		FromDay:   1,
+		ToYear:    2024,
+		ToMonth:   12,
+		ToDay:     31,
+		PageNo:    1,
+		PageSize:  10,
+	}
+}
+
+func TestSearchProjects(t *testing.T) {
+	tests := []SearchProjectsTestCase{
+		{
+			name:           "should error when query is too short",
+			payload:        searchPayload(" ว ", "request"),
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.SearchQueryLengthError{},
+		},
+		{
+			name:           "should error when query is too long",
+			payload:        searchPayload(strings.Repeat("ก", 256), "request"),
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.SearchQueryLengthError{},
+		},
+		{
+			name:           "should error when dashboard is invalid",
+			payload:        searchPayload("วิ่ง", "all"),
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.DashboardTypeInvalidError{},
+		},
+		{
+			name:           "should search request dashboard",
+			payload:        searchPayload("  วิ่งเทรล  ", "request"),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "should search started dashboard",
+			payload:        searchPayload("APR67", "started"),
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mock.MockProjectStore{
+				SearchProjectsFunc: func(dashboardType string, query string, fromDate, toDate time.Time, limit, offset int, filter projects.AdminDashboardFilter) ([]projects.ProjectSearchRow, error) {
+					if dashboardType != tt.payload.Dashboard {
+						t.Errorf("got dashboard %s, want %s", dashboardType, tt.payload.Dashboard)
+					}
+					if query != strings.TrimSpace(tt.payload.Query) {
+						t.Errorf("query was not trimmed, got %q", query)
+					}
+					return []projects.ProjectSearchRow{}, nil
+				},
+			}
+			handler := projects.NewProjectHandler(store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			body, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Error("error marshal payload err:", err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/dashboard/search", bytes.NewReader(body))
+			req.Header.Set("userId", "1")
+
+			handler.SearchProjects(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
+
+func TestSearchTokensSplitsThaiIntoBigrams(t *testing.T) {
+	db := openTestDB(t)
+	var tokens string
+	err := db.QueryRow("SELECT search_tokens($1)", "วิ่ง Trail-2024").Scan(&tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "วิ ิ่ ่ง trail 2024"
+	if tokens != want {
+		t.Errorf("got %q, want %q", tokens, want)
+	}
+
+	var matched bool
+	err = db.QueryRow("SELECT to_tsvector('simple', search_tokens($1)) @@ search_query($2)", "งานวิ่งการกุศล", "วิ่ง").Scan(&matched)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !matched {
+		t.Error("expected a Thai word inside a longer phrase to match")
+	}
+}
@@ -26,6 +26,7 @@ type UpdateProjectTestCase struct {
 }
 
 func TestAdminUpdateProject(t *testing.T) {
+	reviseDueAt := time.Now().Add(14 * 24 * time.Hour).Truncate(time.Second)
 	tests := []UpdateProjectTestCase{
 		{
 			name:           "should error when projectStatusPrimary is missing",
@@ -678,6 +679,98 @@ func TestAdminUpdateProject(t *testing.T) {
 				AdminApprovedAt:    nil,
 			},
 		},
+		{
+			name: "should error when reviseDueAt is set without Revise status",
+			payload: projects.AdminUpdateProjectRequest{
+				ProjectStatusPrimary:   "CurrentBeforeApprove",
+				ProjectStatusSecondary: "Reviewed",
+				ReviseDueAt:            newTime(reviseDueAt),
+			},
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ReviseDueAtNotAllowedError{},
+		},
+		{
+			name: "should error when reviseDueAt is in the past",
+			payload: projects.AdminUpdateProjectRequest{
+				ProjectStatusPrimary:   "CurrentBeforeApprove",
+				ProjectStatusSecondary: "Revise",
+				ReviseDueAt:            newTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ReviseDueAtInPastError{},
+		},
+		{
+			name: "should save reviseDueAt when status changes to Revise",
+			payload: projects.AdminUpdateProjectRequest{
+				ProjectStatusPrimary:   "CurrentBeforeApprove",
+				ProjectStatusSecondary: "Revise",
+				ReviseDueAt:            newTime(reviseDueAt),
+			},
+			store: &mock.MockProjectStore{
+				GetProjectStatusByProjectCodeFunc: func(projectCode string) (projects.AdminUpdateParam, error) {
+					return projects.AdminUpdateParam{ProjectHistoryId: 1, ProjectStatus: "Reviewed"}, nil
+				},
+			},
+			expectedStatus: http.StatusCreated,
+			expectedUpdatedData: projects.AdminUpdateParam{
+				ProjectHistoryId: 1,
+				ProjectStatus:    "Revise",
+				ReviseDueAt:      newTime(reviseDueAt),
+			},
+		},
+		{
+			name: "should keep reviseDueAt when a Revise project is updated without a new deadline",
+			payload: projects.AdminUpdateProjectRequest{
+				ProjectStatusPrimary:   "CurrentBeforeApprove",
+				ProjectStatusSecondary: "Revise",
+				AdminComment:           newString("please attach the route map"),
+			},
+			store: &mock.MockProjectStore{
+				GetProjectStatusByProjectCodeFunc: func(projectCode string) (projects.AdminUpdateParam, error) {
+					return projects.AdminUpdateParam{ProjectHistoryId: 1, ProjectStatus: "Revise", ReviseDueAt: newTime(reviseDueAt)}, nil
+				},
+			},
+			expectedStatus: http.StatusCreated,
+			expectedUpdatedData: projects.AdminUpdateParam{
+				ProjectHistoryId: 1,
+				ProjectStatus:    "Revise",
+				AdminComment:     newString("please attach the route map"),
+				ReviseDueAt:      newTime(reviseDueAt),
+			},
+		},
+		{
+			name: "should clear reviseDueAt when project leaves Revise",
+			payload: projects.AdminUpdateProjectRequest{
+				ProjectStatusPrimary:   "CurrentBeforeApprove",
+				ProjectStatusSecondary: "Reviewing",
+			},
+			store: &mock.MockProjectStore{
+				GetProjectStatusByProjectCodeFunc: func(projectCode string) (projects.AdminUpdateParam, error) {
+					return projects.AdminUpdateParam{ProjectHistoryId: 1, ProjectStatus: "Revise", ReviseDueAt: newTime(reviseDueAt)}, nil
+				},
+			},
+			expectedStatus: http.StatusCreated,
+			expectedUpdatedData: projects.AdminUpdateParam{
+				ProjectHistoryId: 1,
+				ProjectStatus:    "Reviewing",
+			},
+		},
+		{
+			name: "should error when a started project goes back before approval",
+			payload: projects.AdminUpdateProjectRequest{
+				ProjectStatusPrimary:   "CurrentBeforeApprove",
+				ProjectStatusSecondary: "Reviewed",
+			},
+			store: &mock.MockProjectStore{
+				GetProjectStatusByProjectCodeFunc: func(projectCode string) (projects.AdminUpdateParam, error) {
+					return projects.AdminUpdateParam{ProjectHistoryId: 1, ProjectStatus: "Start"}, nil
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectStatusTransitionInvalidError{From: "Start", To: "Reviewed"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,203 @@
+package projects_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/projects"
+	s3Service "github.com/poomipat-k/running-fund/pkg/s3-service"
+)
+
+type WithdrawProjectTestCase struct {
+	name           string
+	payload        projects.WithdrawProjectRequest
+	store          *mock.MockProjectStore
+	expectedStatus int
+	expectedError  error
+}
+
+func TestWithdrawProject(t *testing.T) {
+	tests := []WithdrawProjectTestCase{
+		{
+			name:           "should error when reason is missing",
+			payload:        projects.WithdrawProjectRequest{},
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.WithdrawReasonRequiredError{},
+		},
+		{
+			name:           "should error when reason is too long",
+			payload:        projects.WithdrawProjectRequest{Reason: strings.Repeat("ก", 513)},
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.StatusReasonTooLongError{Length: 513},
+		},
+		{
+			name:    "should error when project is not found",
+			payload: projects.WithdrawProjectRequest{Reason: "event cancelled"},
+			store: &mock.MockProjectStore{
+				GetProjectStatusByProjectCodeFunc: func(projectCode string) (projects.AdminUpdateParam, error) {
+					return projects.AdminUpdateParam{}, errors.New("sql: no rows in result set")
+				},
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  &projects.ProjectNotFoundError{},
+		},
+		{
+			name:    "should error when project is owned by another applicant",
+			payload: projects.WithdrawProjectRequest{Reason: "event cancelled"},
+			store: &mock.MockProjectStore{
+				GetProjectStatusByProjectCodeFunc: func(projectCode string) (projects.AdminUpdateParam, error) {
+					return projects.AdminUpdateParam{CreatedBy: 2, ProjectHistoryId: 1, ProjectStatus: "Reviewing"}, nil
+				},
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  &projects.ProjectNotFoundError{},
+		},
+		{
+			name:    "should error when project is already approved",
+			payload: projects.WithdrawProjectRequest{Reason: "event cancelled"},
+			store: &mock.MockProjectStore{
+				GetProjectStatusByProjectCodeFunc: func(projectCode string) (projects.AdminUpdateParam, error) {
+					return projects.AdminUpdateParam{CreatedBy: 1, ProjectHistoryId: 1, ProjectStatus: "Approved"}, nil
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectNotWithdrawableError{},
+		},
+		{
+			name:    "should withdraw project when status is Revise",
+			payload: projects.WithdrawProjectRequest{Reason: "event cancelled"},
+			store: &mock.MockProjectStore{
+				GetProjectStatusByProjectCodeFunc: func(projectCode string) (projects.AdminUpdateParam, error) {
+					return projects.AdminUpdateParam{CreatedBy: 1, ProjectHistoryId: 1, ProjectStatus: "Revise"}, nil
+				},
+				WithdrawProjectFunc: func(projectHistoryId int, reason string, withdrawnAt time.Time) error {
+					return nil
+				},
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userStore := &mock.MockUserStore{}
+			handler := projects.NewProjectHandler(tt.store, userStore, s3Service.S3Service{})
+
+			body, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Error("error marshal payload err:", err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/project/withdraw/APR67_0501", bytes.NewReader(body))
+			req.Header.Set("userId", "1")
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("projectCode", "APR67_0501")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			handler.WithdrawProject(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
+
+type CancelProjectTestCase struct {
+	name           string
+	payload        projects.CancelProjectRequest
+	store          *mock.MockProjectStore
+	expectedStatus int
+	expectedError  error
+}
+
+func TestAdminCancelProject(t *testing.T) {
+	tests := []CancelProjectTestCase{
+		{
+			name:           "should error when reason is missing",
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.CancelReasonRequiredError{},
+		},
+		{
+			name:           "should error when reason is blank",
+			payload:        projects.CancelProjectRequest{Reason: "   "},
+			store:          &mock.MockProjectStore{},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.CancelReasonRequiredError{},
+		},
+		{
+			name:    "should error when project is not approved yet",
+			payload: projects.CancelProjectRequest{Reason: "event cancelled"},
+			store: &mock.MockProjectStore{
+				GetProjectStatusByProjectCodeFunc: func(projectCode string) (projects.AdminUpdateParam, error) {
+					return projects.AdminUpdateParam{CreatedBy: 1, ProjectHistoryId: 1, ProjectStatus: "Reviewing"}, nil
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectNotCancellableError{},
+		},
+		{
+			name:    "should error when project is already withdrawn",
+			payload: projects.CancelProjectRequest{Reason: "event cancelled"},
+			store: &mock.MockProjectStore{
+				GetProjectStatusByProjectCodeFunc: func(projectCode string) (projects.AdminUpdateParam, error) {
+					return projects.AdminUpdateParam{CreatedBy: 1, ProjectHistoryId: 1, ProjectStatus: "Withdrawn"}, nil
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &projects.ProjectNotCancellableError{},
+		},
+		{
+			name:    "should cancel project when status is Start",
+			payload: projects.CancelProjectRequest{Reason: "event cancelled"},
+			store: &mock.MockProjectStore{
+				GetProjectStatusByProjectCodeFunc: func(projectCode string) (projects.AdminUpdateParam, error) {
+					return projects.AdminUpdateParam{CreatedBy: 1, ProjectHistoryId: 1, ProjectStatus: "Start"}, nil
+				},
+				CancelProjectFunc: func(projectHistoryId int, reason string, cancelledAt time.Time) error {
+					if projectHistoryId != 1 || reason != "event cancelled" {
+						return errors.New("unexpected cancel params")
+					}
+					return nil
+				},
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := projects.NewProjectHandler(tt.store, &mock.MockUserStore{}, s3Service.S3Service{})
+
+			body, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Error("error marshal payload err:", err)
+			}
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/project/cancel/APR67_0501", bytes.NewReader(body))
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("projectCode", "APR67_0501")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			handler.AdminCancelProject(res, req)
+			assertStatus(t, res.Code, tt.expectedStatus)
+			if tt.expectedError != nil {
+				errBody := getErrorResponse(t, res)
+				assertErrorMessage(t, errBody.Message, tt.expectedError.Error())
+			}
+		})
+	}
+}
@@ -4,27 +4,30 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
-	"os"
-	"strconv"
+	"time"
 
+	fundingRound "github.com/poomipat-k/running-fund/pkg/funding-round"
 	"github.com/poomipat-k/running-fund/pkg/users"
 	"github.com/poomipat-k/running-fund/pkg/utils"
 )
 
 type reviewStore interface {
-	AddReview(payload AddReviewRequest, userId int, criteriaList []ProjectReviewCriteriaMinimal) (int, error)
+	AddReview(payload AddReviewRequest, userId int, criteriaList []ProjectReviewCriteriaMinimal, reviewerThreshold int) (int, error)
 	GetProjectCriteriaMinimalDetails(cv int) ([]ProjectReviewCriteriaMinimal, error)
+	GetProjectFundingRoundId(projectHistoryId int) (int, error)
 }
 
 type ReviewHandler struct {
-	store  reviewStore
-	uStore users.UserStore
+	store      reviewStore
+	uStore     users.UserStore
+	roundStore fundingRound.FundingRoundStore
 }
 
-func NewProjectHandler(s reviewStore, uStore users.UserStore) *ReviewHandler {
+func NewProjectHandler(s reviewStore, uStore users.UserStore, roundStore fundingRound.FundingRoundStore) *ReviewHandler {
 	return &ReviewHandler{
-		store:  s,
-		uStore: uStore,
+		store:      s,
+		uStore:     uStore,
+		roundStore: roundStore,
 	}
 }
 
@@ -44,7 +47,24 @@ func (h *ReviewHandler) AddReview(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	criteriaList, err := h.getCriteriaList()
+	// window, criteria and threshold come from the funding round of the project
+	roundId, err := h.store.GetProjectFundingRoundId(payload.ProjectHistoryId)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, errors.New("project is not found"), "projectHistoryId", http.StatusNotFound)
+		return
+	}
+	round, err := h.roundStore.GetFundingRoundById(roundId)
+	if err != nil {
+		slog.Error(err.Error())
+		utils.ErrorJSON(w, err, "fundingRoundId", http.StatusNotFound)
+		return
+	}
+	if !round.IsReviewOpen(time.Now()) {
+		utils.ErrorJSON(w, &fundingRound.ReviewWindowClosedError{RoundName: round.Name}, "fundingRoundId", http.StatusBadRequest)
+		return
+	}
+	criteriaList, err := h.store.GetProjectCriteriaMinimalDetails(round.ReviewerCriteriaVersion)
 	if err != nil {
 		utils.ErrorJSON(w, err, "")
 		return
@@ -58,7 +78,7 @@ func (h *ReviewHandler) AddReview(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	id, err := h.store.AddReview(payload, userId, criteriaList)
+	id, err := h.store.AddReview(payload, userId, criteriaList, round.ReviewerThreshold)
 	if err != nil {
 		slog.Error(err.Error())
 		utils.ErrorJSON(w, err, "")
@@ -66,16 +86,3 @@ func (h *ReviewHandler) AddReview(w http.ResponseWriter, r *http.Request) {
 	}
 	utils.WriteJSON(w, http.StatusOK, id)
 }
-
-func (h *ReviewHandler) getCriteriaList() ([]ProjectReviewCriteriaMinimal, error) {
-	cv := os.Getenv("CRITERIA_VERSION")
-	v, err := strconv.Atoi(cv)
-	if err != nil {
-		return nil, errors.New("failed to convert CRITERIA_VERSION to int")
-	}
-	criteriaList, err := h.store.GetProjectCriteriaMinimalDetails(v)
-	if err != nil {
-		return nil, err
-	}
-	return criteriaList, nil
-}
@@ -0,0 +1,107 @@
+package review_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	fundingRound "github.com/poomipat-k/running-fund/pkg/funding-round"
+	"github.com/poomipat-k/running-fund/pkg/mock"
+	"github.com/poomipat-k/running-fund/pkg/review"
+)
+
+type ErrorBody struct {
+	Error   bool
+	Message string
+	Name    string
+}
+
+const okReviewBody = `{"projectHistoryId":7,"ip":{"isInterestedPerson":false},"review":{"reviewSummary":"ok","scores":{"q_2_1":4}}}`
+
+func TestAddReview(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name              string
+		round             fundingRound.FundingRound
+		roundIdErr        error
+		expectedStatus    int
+		expectedError     error
+		expectedThreshold int
+	}{
+		{
+			name:           "should error when project has no round",
+			roundIdErr:     errors.New("sql: no rows in result set"),
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errors.New("project is not found"),
+		},
+		{
+			name:           "should error before the review window opens",
+			round:          fundingRound.FundingRound{Id: 3, Name: "รอบที่ 2", ReviewFromDate: now.Add(time.Hour), ReviewToDate: now.AddDate(0, 1, 0)},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &fundingRound.ReviewWindowClosedError{RoundName: "รอบที่ 2"},
+		},
+		{
+			name:           "should error after the review window closes",
+			round:          fundingRound.FundingRound{Id: 3, Name: "รอบที่ 2", ReviewFromDate: now.AddDate(0, -1, 0), ReviewToDate: now.Add(-time.Hour)},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &fundingRound.ReviewWindowClosedError{RoundName: "รอบที่ 2"},
+		},
+		{
+			name:              "should add a review with the round's criteria and threshold",
+			round:             fundingRound.FundingRound{Id: 3, Name: "รอบที่ 2", ReviewFromDate: now.AddDate(0, -1, 0), ReviewToDate: now.AddDate(0, 1, 0), ReviewerCriteriaVersion: 2, ReviewerThreshold: 3},
+			expectedStatus:    http.StatusOK,
+			expectedThreshold: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mock.MockReviewStore{
+				GetProjectFundingRoundIdFunc: func(projectHistoryId int) (int, error) {
+					return tt.round.Id, tt.roundIdErr
+				},
+				GetProjectCriteriaMinimalDetailsFunc: func(cv int) ([]review.ProjectReviewCriteriaMinimal, error) {
+					if cv != 2 {
+						t.Errorf("got criteria version %d, want 2", cv)
+					}
+					return []review.ProjectReviewCriteriaMinimal{{CriteriaId: 11, CriteriaVersion: 2, OrderNumber: 1}}, nil
+				},
+				AddReviewFunc: func(payload review.AddReviewRequest, userId int, criteriaList []review.ProjectReviewCriteriaMinimal, reviewerThreshold int) (int, error) {
+					if reviewerThreshold != tt.expectedThreshold {
+						t.Errorf("got threshold %d, want %d", reviewerThreshold, tt.expectedThreshold)
+					}
+					return 5, nil
+				},
+			}
+			roundStore := &mock.MockFundingRoundStore{
+				GetFundingRoundByIdFunc: func(roundId int) (fundingRound.FundingRound, error) {
+					return tt.round, nil
+				},
+			}
+			handler := review.NewProjectHandler(store, &mock.MockUserStore{}, roundStore)
+
+			res := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/project/review", strings.NewReader(okReviewBody))
+			req.Header.Set("userId", "4")
+
+			handler.AddReview(res, req)
+			if res.Code != tt.expectedStatus {
+				t.Errorf("did not get correct status, got %d, want %d", res.Code, tt.expectedStatus)
+			}
+			if tt.expectedError != nil {
+				var body ErrorBody
+				err := json.Unmarshal(res.Body.Bytes(), &body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if body.Message != tt.expectedError.Error() {
+					t.Errorf("did not get correct error, got %v, want %v", body.Message, tt.expectedError.Error())
+				}
+			}
+		})
+	}
+}
@@ -5,9 +5,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"log/slog"
-	"os"
-	"strconv"
 	"strings"
 	"time"
 )
@@ -26,7 +23,7 @@ func NewStore(db *sql.DB) *store {
 	}
 }
 
-func (s *store) AddReview(payload AddReviewRequest, userId int, criteriaList []ProjectReviewCriteriaMinimal) (int, error) {
+func (s *store) AddReview(payload AddReviewRequest, userId int, criteriaList []ProjectReviewCriteriaMinimal, reviewerThreshold int) (int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
 	defer cancel()
 
@@ -103,13 +100,12 @@ func (s *store) AddReview(payload AddReviewRequest, userId int, criteriaList []P
 	if err != nil {
 		return fail(err)
 	}
-	// ReviewerCountBefore change project status
-	reviewerThresholdRaw := os.Getenv("REVIEWER_THRESHOLD")
-	reviewerThreshold, err := strconv.Atoi(reviewerThresholdRaw)
+	// keep the precomputed average used by the admin dashboards in step
+	_, err = tx.ExecContext(ctx, updateProjectReviewScoreSQL, payload.ProjectHistoryId)
 	if err != nil {
-		slog.Error("REVIEWER_THRESHOLD is not set")
 		return fail(err)
 	}
+	// ReviewerCountBefore change project status
 	if reviewerThreshold == 0 {
 		reviewerThreshold = hardCodeReviewedCountCriteria
 	}
@@ -133,6 +129,15 @@ func (s *store) AddReview(payload AddReviewRequest, userId int, criteriaList []P
 	return reviewId, nil
 }
 
+func (s *store) GetProjectFundingRoundId(projectHistoryId int) (int, error) {
+	var roundId int
+	err := s.db.QueryRow(getProjectFundingRoundIdSQL, projectHistoryId).Scan(&roundId)
+	if err != nil {
+		return 0, err
+	}
+	return roundId, nil
+}
+
 func (s *store) GetProjectCriteriaMinimalDetails(cv int) ([]ProjectReviewCriteriaMinimal, error) {
 	if cv == 0 {
 		cv = 1
@@ -22,8 +22,31 @@ SELECT id, criteria_version ,order_number FROM review_criteria WHERE criteria_ve
 const updateProjectStatusToReviewed = `
 UPDATE project_history
 SET status = 'Reviewed', updated_at = $2
-WHERE project_history.id = $1 AND (SELECT COUNT(*) as review_count
+WHERE project_history.id = $1 AND project_history.status = 'Reviewing' AND (SELECT COUNT(*) as review_count
 FROM review INNER JOIN project_history ON project_history.id = review.project_history_id
 WHERE project_history.id = $1
 ) = $3 RETURNING id;
 `
+
+const getProjectFundingRoundIdSQL = `
+SELECT project.funding_round_id
+FROM project_history
+INNER JOIN project ON project.project_code = project_history.project_code
+WHERE project_history.id = $1;
+`
+
+const updateProjectReviewScoreSQL = `
+UPDATE project_history
+SET
+avg_review_score = (
+	SELECT ROUND(AVG(sum_score), 2) FROM (
+		SELECT SUM(review_details.score) as sum_score
+		FROM review
+		INNER JOIN review_details ON review.id = review_details.review_id
+		WHERE review.project_history_id = $1
+		GROUP BY review.id
+	) per_review
+),
+review_count = (SELECT COUNT(*) FROM review WHERE review.project_history_id = $1)
+WHERE project_history.id = $1;
+`
@@ -9,6 +9,7 @@ import (
 	"log"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -31,9 +32,10 @@ func NewS3Service(s3Client *s3.Client) *S3Service {
 	}
 }
 
-func (client *S3Service) ZipAndUploadFileToS3(files []*multipart.FileHeader, zipWriters []*zip.Writer, zipFilePrefix string, s3ObjectPrefix string) error {
+// ZipAndUploadFileToS3 accepts GPX/KML files only when allowRouteTracks is set, they are plain text to the type check
+func (client *S3Service) ZipAndUploadFileToS3(files []*multipart.FileHeader, zipWriters []*zip.Writer, zipFilePrefix string, s3ObjectPrefix string, allowRouteTracks bool) error {
 	for _, fileHeader := range files {
-		file, err := OpenFileFromFileHeader(fileHeader)
+		file, err := openFileFromFileHeader(fileHeader, allowRouteTracks)
 		if err != nil {
 			return err
 		}
@@ -145,6 +147,41 @@ func (client *S3Service) ListObjects(bucketName string, prefix string) ([]types.
 	return contents, err
 }
 
+// CopyObjects copies every object under fromPrefix to the same relative key under toPrefix
+func (client *S3Service) CopyObjects(bucketName, fromPrefix, toPrefix string) error {
+	paginator := s3.NewListObjectsV2Paginator(client.S3Client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(fromPrefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return err
+		}
+		for _, obj := range page.Contents {
+			_, err = client.S3Client.CopyObject(context.TODO(), &s3.CopyObjectInput{
+				Bucket:     aws.String(bucketName),
+				CopySource: aws.String(copySource(bucketName, *obj.Key)),
+				Key:        aws.String(toPrefix + strings.TrimPrefix(*obj.Key, fromPrefix)),
+			})
+			if err != nil {
+				log.Printf("Couldn't copy object %v to %v. Here's why: %v\n", *obj.Key, toPrefix, err)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// copySource url encodes every segment of the key, file names are often in Thai
+func copySource(bucketName, key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return bucketName + "/" + strings.Join(segments, "/")
+}
+
 func isDocType(detectedType string, contentType string) bool {
 	if detectedType == "application/octet-stream" && contentType == "application/msword" {
 		return true
@@ -155,6 +192,15 @@ func isDocType(detectedType string, contentType string) bool {
 	return false
 }
 
+// GPX and KML are xml, without the <?xml declaration they are detected as plain text
+func isRouteTrackType(detectedType string, fileName string) bool {
+	ext := strings.ToLower(filepath.Ext(fileName))
+	if ext != ".gpx" && ext != ".kml" {
+		return false
+	}
+	return strings.HasPrefix(detectedType, "text/xml") || strings.HasPrefix(detectedType, "text/plain")
+}
+
 func isAllowedContentType(mimetype string) bool {
 	if mimetype != "image/jpeg" &&
 		mimetype != "image/png" &&
@@ -168,6 +214,10 @@ func isAllowedContentType(mimetype string) bool {
 
 // openFile and validate file type
 func OpenFileFromFileHeader(fileHeader *multipart.FileHeader) (multipart.File, error) {
+	return openFileFromFileHeader(fileHeader, false)
+}
+
+func openFileFromFileHeader(fileHeader *multipart.FileHeader, allowRouteTracks bool) (multipart.File, error) {
 	if fileHeader.Size > MAX_UPLOAD_SIZE {
 		return nil, fmt.Errorf("the uploaded file is too big: %s. Please use an file less than 25MB in size", fileHeader.Filename)
 	}
@@ -186,7 +236,7 @@ func OpenFileFromFileHeader(fileHeader *multipart.FileHeader) (multipart.File, e
 	filetype := http.DetectContentType(buff)
 	headerContentType := fileHeader.Header["Content-Type"][0]
 
-	if !isAllowedContentType(filetype) && !isDocType(filetype, headerContentType) {
+	if !isAllowedContentType(filetype) && !isDocType(filetype, headerContentType) && !(allowRouteTracks && isRouteTrackType(filetype, fileHeader.Filename)) {
 		return nil, fmt.Errorf("the provided file format is not allowed. got %s", filetype)
 	}
 
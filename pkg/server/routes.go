@@ -19,7 +19,11 @@ import (
 	"github.com/poomipat-k/running-fund/pkg/assist"
 	"github.com/poomipat-k/running-fund/pkg/captcha"
 	"github.com/poomipat-k/running-fund/pkg/cms"
+	completionReport "github.com/poomipat-k/running-fund/pkg/completion-report"
+	"github.com/poomipat-k/running-fund/pkg/disbursement"
 	appEmail "github.com/poomipat-k/running-fund/pkg/email"
+	"github.com/poomipat-k/running-fund/pkg/events"
+	fundingRound "github.com/poomipat-k/running-fund/pkg/funding-round"
 	mw "github.com/poomipat-k/running-fund/pkg/middleware"
 	operationConfig "github.com/poomipat-k/running-fund/pkg/operation-config"
 	"github.com/poomipat-k/running-fund/pkg/projects"
@@ -85,11 +89,14 @@ func (app *Server) Routes(db *sql.DB) http.Handler {
 	userStore := users.NewStore(db, emailService)
 	userHandler := users.NewUserHandler(userStore)
 
+	fundingRoundStore := fundingRound.NewStore(db)
+	fundingRoundHandler := fundingRound.NewFundingRoundHandler(fundingRoundStore)
+
 	reviewStore := review.NewStore(db)
-	reviewHandler := review.NewProjectHandler(reviewStore, userStore)
+	reviewHandler := review.NewProjectHandler(reviewStore, userStore, fundingRoundStore)
 
 	c := cache.New(3*time.Minute, 5*time.Minute)
-	projectStore := projects.NewStore(db, c, serverS3Service)
+	projectStore := projects.NewStore(db, c, serverS3Service, emailService)
 	projectHandler := projects.NewProjectHandler(projectStore, userStore, serverS3Service)
 
 	captchaStore := captcha.NewStore(c)
@@ -103,9 +110,20 @@ func (app *Server) Routes(db *sql.DB) http.Handler {
 	operationConfigStore := operationConfig.NewStore(db)
 	operationConfigHandler := operationConfig.NewOperationConfigHandler(operationConfigStore)
 
+	go projects.NewReviseDeadlineJob(projectStore).Run(context.Background(), projects.REVISE_DEADLINE_JOB_INTERVAL)
+
 	cmsStore := cms.NewStore(db, c, operationConfigStore)
 	cmsHandler := cms.NewCmsHandler(serverS3Service, cmsStore)
 
+	completionReportStore := completionReport.NewStore(db, serverS3Service)
+	completionReportHandler := completionReport.NewCompletionReportHandler(completionReportStore)
+
+	disbursementStore := disbursement.NewStore(db, serverS3Service)
+	disbursementHandler := disbursement.NewDisbursementHandler(disbursementStore)
+
+	eventStore := events.NewStore(db, c)
+	eventHandler := events.NewEventHandler(eventStore)
+
 	mux.Route("/api/v1", func(r chi.Router) {
 		r.Get("/", func(w http.ResponseWriter, r *http.Request) {
 			utils.WriteJSON(w, http.StatusOK, "API landing Page")
@@ -114,21 +132,73 @@ func (app *Server) Routes(db *sql.DB) http.Handler {
 		r.Get("/review/criteria/{criteriaVersion}", mw.IsLoggedIn(projectHandler.GetProjectCriteria))
 		r.Get("/applicant/criteria/{applicantCriteriaVersion}", mw.IsApplicant(projectHandler.GetApplicantCriteria))
 		r.Get("/applicant/project/details/{projectCode}", mw.IsLoggedIn(projectHandler.GetApplicantProjectDetails))
+		r.Get("/project/full-details/{projectCode}", mw.IsLoggedIn(projectHandler.GetProjectFullDetails))
 
 		r.Post("/project/reviewer", mw.IsReviewer(projectHandler.GetReviewerDashboard))
 		r.Post("/project/review/{projectCode}", mw.IsLoggedIn(projectHandler.GetReviewerProjectDetails))
-		r.Post("/project", mw.AllowCreateNewProject(mw.IsApplicant(projectHandler.AddProject), operationConfigStore))
+		r.Post("/project", mw.AllowCreateNewProject(mw.IsApplicant(projectHandler.AddProject), operationConfigStore, fundingRoundStore))
 		r.Post("/project/addition-files", mw.IsLoggedIn(projectHandler.AddProjectAdditionFiles))
 		r.Get("/project/applicant/dashboard", mw.IsApplicant(projectHandler.GetAllProjectDashboardByApplicantId))
-
+		r.Post("/project/withdraw/{projectCode}", mw.IsApplicant(projectHandler.WithdrawProject))
+		r.Get("/project/{projectCode}/messages", mw.IsLoggedIn(projectHandler.GetProjectMessages))
+		r.Post("/project/{projectCode}/messages", mw.IsLoggedIn(projectHandler.AddProjectMessage))
+		r.Post("/project/{projectCode}/messages/read", mw.IsLoggedIn(projectHandler.MarkProjectMessagesRead))
+		r.Get("/project/distance-catalogue", mw.IsLoggedIn(projectHandler.GetDistanceCatalogue))
+		r.Get("/project/form-schema", mw.IsLoggedIn(projectHandler.GetFormSchema))
+		r.Get("/project/form-schema/{formSchemaVersion}", mw.IsLoggedIn(projectHandler.GetFormSchema))
+		r.Post("/project/eligibility", mw.AllowCreateNewProject(mw.IsApplicant(projectHandler.CheckEligibility), operationConfigStore, fundingRoundStore))
+
+		r.Post("/admin/project/bulk", mw.IsAdmin(projectHandler.BulkUpdateProjects))
 		r.Post("/admin/project/{projectCode}", mw.IsAdmin(projectHandler.AdminUpdateProject))
+		r.Post("/admin/project/cancel/{projectCode}", mw.IsAdmin(projectHandler.AdminCancelProject))
+		r.Get("/admin/project/{projectCode}/duplicates", mw.IsAdmin(projectHandler.GetProjectDuplicates))
+		r.Post("/admin/project/duplicate/{duplicateId}/resolve", mw.IsAdmin(projectHandler.ResolveDuplicate))
+		r.Get("/admin/project/{projectCode}/notes", mw.IsAdmin(projectHandler.GetProjectNotes))
+		r.Post("/admin/project/{projectCode}/notes", mw.IsAdmin(projectHandler.AddProjectNote))
+		r.Get("/admin/project/{projectCode}/tags", mw.IsAdmin(projectHandler.GetProjectTags))
+		r.Put("/admin/project/{projectCode}/tags", mw.IsAdmin(projectHandler.UpdateProjectTags))
+		r.Get("/admin/tags", mw.IsAdmin(projectHandler.GetAllProjectTags))
 		r.Post("/admin/dashboard/summary", mw.IsAdmin(projectHandler.GetAdminSummary))
 		r.Post("/admin/dashboard/request", mw.IsAdmin(projectHandler.GetAdminRequestDashboard))
 		r.Post("/admin/dashboard/started", mw.IsAdmin(projectHandler.GetAdminStartedDashboard))
+		r.Post("/admin/dashboard/count", mw.IsAdmin(projectHandler.GetAdminDashboardCount))
+		r.Post("/admin/dashboard/search", mw.IsAdmin(projectHandler.SearchProjects))
 		r.Post("/admin/report", mw.IsAdmin(projectHandler.GenerateAdminReport))
+		r.Post("/admin/distance/map", mw.IsAdmin(projectHandler.MapDistances))
+		r.Post("/admin/report/distance-fees", mw.IsAdmin(projectHandler.GetDistanceFeeAnalytics))
+		r.Get("/admin/eligibility-rule", mw.IsAdmin(projectHandler.GetEligibilityRules))
+		r.Post("/admin/eligibility-rule", mw.IsAdmin(projectHandler.AddEligibilityRule))
+		r.Put("/admin/eligibility-rule/{ruleId}", mw.IsAdmin(projectHandler.UpdateEligibilityRule))
+		r.Get("/admin/applicant-criteria", mw.IsAdmin(projectHandler.GetApplicantCriteriaVersions))
+		r.Post("/admin/applicant-criteria", mw.IsAdmin(projectHandler.AddApplicantCriteriaDraft))
+		r.Get("/admin/applicant-criteria/{criteriaVersion}", mw.IsAdmin(projectHandler.GetApplicantCriteriaPreview))
+		r.Put("/admin/applicant-criteria/{criteriaVersion}", mw.IsAdmin(projectHandler.UpdateApplicantCriteriaDraft))
+		r.Delete("/admin/applicant-criteria/{criteriaVersion}", mw.IsAdmin(projectHandler.DeleteApplicantCriteriaDraft))
+		r.Post("/admin/applicant-criteria/{criteriaVersion}/publish", mw.IsAdmin(projectHandler.PublishApplicantCriteria))
 
 		r.Post("/project/review", mw.IsReviewer(reviewHandler.AddReview))
 
+		r.Post("/project/completion-report/{projectCode}", mw.IsApplicant(completionReportHandler.SubmitReport))
+		r.Get("/project/completion-report/{projectCode}", mw.IsLoggedIn(completionReportHandler.GetReports))
+		r.Post("/admin/completion-report/{reportId}/review", mw.IsAdmin(completionReportHandler.AdminReviewReport))
+
+		r.Get("/funding-round/current", mw.IsLoggedIn(fundingRoundHandler.GetCurrentFundingRound))
+		r.Get("/admin/funding-round", mw.IsAdmin(fundingRoundHandler.GetFundingRounds))
+		r.Post("/admin/funding-round", mw.IsAdmin(fundingRoundHandler.AddFundingRound))
+		r.Put("/admin/funding-round/{roundId}", mw.IsAdmin(fundingRoundHandler.UpdateFundingRound))
+
+		r.Get("/admin/disbursement/{projectCode}", mw.IsAdmin(disbursementHandler.GetLedger))
+		r.Post("/admin/disbursement/{projectCode}", mw.IsAdmin(disbursementHandler.AddEntry))
+		r.Put("/admin/disbursement/entry/{entryId}/paid", mw.IsAdmin(disbursementHandler.MarkEntryPaid))
+		r.Post("/admin/disbursement/report", mw.IsAdmin(disbursementHandler.GenerateReconciliationReport))
+
+		r.Get("/admin/map", mw.IsAdmin(eventHandler.GetEventMap))
+		r.Get("/admin/calendar.ics", mw.IsAdmin(eventHandler.GetCalendar))
+		r.Get("/admin/calendar/token", mw.IsAdmin(eventHandler.GetCalendarFeedToken))
+		r.Post("/admin/calendar/token/rotate", mw.IsAdmin(eventHandler.RotateCalendarFeedToken))
+		r.Get("/calendar/{token}.ics", eventHandler.GetCalendarFeed)
+		r.Get("/events", eventHandler.GetPublicEvents)
+
 		r.Post("/user/activate-email", userHandler.ActivateUser)
 		r.Post("/user/password/forgot", mw.ValidateCaptcha(userHandler.ForgotPassword, captchaStore))
 		r.Post("/user/password/reset", userHandler.ResetPassword)
@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// GetFundingRoundFromRequestHeader reads the open round set by mw.AllowCreateNewProject
+func GetFundingRoundFromRequestHeader(r *http.Request) (int, int, error) {
+	roundId, err := strconv.Atoi(r.Header.Get("fundingRoundId"))
+	if err != nil {
+		return 0, 0, err
+	}
+	applicantCriteriaVersion, err := strconv.Atoi(r.Header.Get("applicantCriteriaVersion"))
+	if err != nil {
+		return 0, 0, err
+	}
+	return roundId, applicantCriteriaVersion, nil
+}
@@ -8,10 +8,36 @@ import (
 )
 
 type jsonResponse struct {
-	Error   bool   `json:"error"`
+	Error   bool         `json:"error"`
+	Message string       `json:"message"`
+	Data    any          `json:"data,omitempty"`
+	Name    string       `json:"name,omitempty"`
+	Errors  []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError is one invalid field of a payload, Path is a JSON pointer into the request body
+type FieldError struct {
+	Path    string `json:"path"`
+	Code    string `json:"code"`
 	Message string `json:"message"`
-	Data    any    `json:"data,omitempty"`
-	Name    string `json:"name,omitempty"`
+}
+
+// ValidationError carries every invalid field, Message is the first failure for clients that only read message
+type ValidationError struct {
+	Message string
+	Errors  []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// Add records a failure, the first one added becomes the error message
+func (e *ValidationError) Add(path, code, message, messageTh string) {
+	if len(e.Errors) == 0 {
+		e.Message = message
+	}
+	e.Errors = append(e.Errors, FieldError{Path: path, Code: code, Message: messageTh})
 }
 
 // readJSON tries to read the body of a request and converts it into JSON
@@ -93,6 +119,10 @@ func ErrorJSON(w http.ResponseWriter, err error, name string, status ...int) err
 	payload.Error = true
 	payload.Message = err.Error()
 	payload.Name = name
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		payload.Errors = validationErr.Errors
+	}
 
 	return WriteJSON(w, statusCode, payload)
 }